@@ -148,7 +148,7 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		liquiditytypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
 		budgettypes.ModuleName:         nil,
-		farmingtypes.ModuleName:        nil,
+		farmingtypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 	}
 )
 
@@ -308,10 +308,14 @@ func NewFarmingApp(
 		app.BankKeeper, app.ModuleAccountAddrs(),
 	)
 
-	app.FarmingKeeper = farmingkeeper.NewKeeper(
+	farmingKeeper := farmingkeeper.NewKeeper(
 		appCodec, keys[farmingtypes.StoreKey], app.GetSubspace(farmingtypes.ModuleName), app.AccountKeeper,
 		app.BankKeeper, app.ModuleAccountAddrs(),
 	)
+	// register the hooks of the modules reacting to the farming lifecycle events here
+	app.FarmingKeeper = *farmingKeeper.SetHooks(
+		farmingtypes.NewMultiFarmingHooks(),
+	)
 
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
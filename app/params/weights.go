@@ -6,7 +6,9 @@ const (
 	DefaultWeightMsgCreateRatioPlan       int = 10
 	DefaultWeightMsgStake                 int = 85
 	DefaultWeightMsgUnstake               int = 30
+	DefaultWeightMsgCancelQueuedStaking   int = 10
 	DefaultWeightMsgHarvest               int = 30
+	DefaultWeightMsgTerminatePrivatePlan  int = 5
 
 	DefaultWeightAddPublicPlanProposal    int = 10
 	DefaultWeightUpdatePublicPlanProposal int = 5
@@ -13,33 +13,35 @@ import (
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	for _, plan := range k.GetPlans(ctx) {
-		if !plan.GetTerminated() && ctx.BlockTime().After(plan.GetEndTime()) {
-			if err := k.TerminatePlan(ctx, plan); err != nil {
-				panic(err)
-			}
+	if err := k.CompleteMatureUnbondings(ctx); err != nil {
+		panic(err)
+	}
+
+	// While the epoch advancement is paused, the last epoch time is kept as it is,
+	// so the elapsed epochs are advanced after the pause is lifted.
+	if !k.IsOperationPaused(ctx, types.OperationEpochAdvancement) {
+		// The queued coins matured by the last epoch advancement are staked little by little,
+		// so that a single block doesn't have to process all of them.
+		if err := k.ProcessMaturedQueuedStakings(ctx, keeper.MaxQueuedStakingsPerBlock); err != nil {
+			panic(err)
+		}
+
+		if err := k.AdvanceElapsedEpochs(ctx); err != nil {
+			panic(err)
 		}
 	}
 
-	// CurrentEpochDays is intialized with the value of NextEpochDays in genesis and
-	// it is used here to prevent from affecting the epoch days for farming rewards allocation.
-	// Suppose NextEpochDays is 7 days and it is proposed to change the value to 1 day through governance proposal.
-	// Although the proposal is passed, farming rewards allocation should continue to proceed with 7 days and then it gets updated.
-	currentEpochDays := k.GetCurrentEpochDays(ctx)
-
-	lastEpochTime, found := k.GetLastEpochTime(ctx)
-	if !found {
-		k.SetLastEpochTime(ctx, ctx.BlockTime())
-	} else {
-		y, m, d := lastEpochTime.AddDate(0, 0, int(currentEpochDays)).Date()
-		y2, m2, d2 := ctx.BlockTime().Date()
-		if !time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC).Before(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)) {
-			if err := k.AdvanceEpoch(ctx); err != nil {
-				panic(err)
-			}
-			if params := k.GetParams(ctx); params.NextEpochDays != currentEpochDays {
-				k.SetCurrentEpochDays(ctx, params.NextEpochDays)
-			}
+	// The ended plans are terminated after the elapsed epochs are advanced, so that a plan
+	// ended in the middle of them still allocates rewards for the epochs before its end time.
+	// For the same reason, the plans ended after the end of the current epoch are kept
+	// while there are elapsed epochs not advanced yet.
+	endTime := ctx.BlockTime()
+	if nextEpochTime, found := k.GetNextEpochTime(ctx); found && nextEpochTime.Before(endTime) {
+		endTime = nextEpochTime
+	}
+	for _, plan := range k.GetEndedPlans(ctx, endTime) {
+		if err := k.TerminatePlan(ctx, plan); err != nil {
+			panic(err)
 		}
 	}
 }
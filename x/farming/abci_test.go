@@ -3,7 +3,11 @@ package farming_test
 import (
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/tendermint/farming/app"
 	"github.com/tendermint/farming/x/farming"
+	"github.com/tendermint/farming/x/farming/keeper"
 	"github.com/tendermint/farming/x/farming/types"
 
 	_ "github.com/stretchr/testify/suite"
@@ -16,7 +20,7 @@ func (suite *ModuleTestSuite) TestEndBlockerEpochDaysTest() {
 		params := suite.keeper.GetParams(suite.ctx)
 		params.NextEpochDays = formerEpochDays
 		suite.keeper.SetParams(suite.ctx, params)
-		suite.keeper.SetCurrentEpochDays(suite.ctx, formerEpochDays)
+		suite.keeper.SetCurrentEpochDuration(suite.ctx, time.Duration(formerEpochDays)*24*time.Hour)
 
 		t := types.ParseTime("2021-08-01T00:00:00Z")
 		suite.ctx = suite.ctx.WithBlockTime(t)
@@ -35,7 +39,7 @@ func (suite *ModuleTestSuite) TestEndBlockerEpochDaysTest() {
 				suite.keeper.SetParams(suite.ctx, params)
 			}
 
-			currentEpochDays := suite.keeper.GetCurrentEpochDays(suite.ctx)
+			currentEpochDays := uint32(suite.keeper.GetCurrentEpochDuration(suite.ctx) / (24 * time.Hour))
 			t2, _ := suite.keeper.GetLastEpochTime(suite.ctx)
 
 			if uint32(i) == formerEpochDays*24 {
@@ -62,3 +66,288 @@ func (suite *ModuleTestSuite) TestEndBlockerEpochDaysTest() {
 	// stay case
 	epochDaysTest(1, 1)
 }
+
+func (suite *ModuleTestSuite) TestEndBlockerPausedEpochAdvancement() {
+	t := types.ParseTime("2021-08-01T00:00:00Z")
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	lastEpochTime, _ := suite.keeper.GetLastEpochTime(suite.ctx)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.PausedOperations = []string{types.OperationEpochAdvancement}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// The epoch is not advanced while it is paused.
+	suite.ctx = suite.ctx.WithBlockTime(t.AddDate(0, 0, 3))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	t2, _ := suite.keeper.GetLastEpochTime(suite.ctx)
+	suite.Require().Equal(lastEpochTime, t2)
+
+	// It is advanced in the first block after the pause is lifted.
+	params.PausedOperations = []string{}
+	suite.keeper.SetParams(suite.ctx, params)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	t2, _ = suite.keeper.GetLastEpochTime(suite.ctx)
+	suite.Require().Equal(suite.ctx.BlockTime(), t2)
+}
+
+func (suite *ModuleTestSuite) TestEndBlockerMultipleElapsedEpochs() {
+	plan := suite.sampleFixedAmtPlans[1] // 2_000_000denom3 per epoch
+	suite.keeper.SetPlan(suite.ctx, plan)
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-04T00:00:00Z"))
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-05T00:00:00Z"))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	suite.Require().True(suite.Rewards(suite.addrs[0]).IsZero())
+
+	// The chain has been halted for 3 days.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-08T00:00:00Z")).WithEventManager(sdk.NewEventManager())
+	farming.EndBlocker(suite.ctx, suite.keeper)
+
+	var epochTimes []string
+	for _, ev := range suite.ctx.EventManager().Events() {
+		if ev.Type == types.EventTypeAdvanceEpoch {
+			epochTimes = append(epochTimes, string(ev.Attributes[0].Value))
+		}
+	}
+	suite.Require().Equal([]string{
+		types.ParseTime("2021-08-06T00:00:00Z").String(),
+		types.ParseTime("2021-08-07T00:00:00Z").String(),
+		types.ParseTime("2021-08-08T00:00:00Z").String(),
+	}, epochTimes)
+
+	lastEpochTime, _ := suite.keeper.GetLastEpochTime(suite.ctx)
+	suite.Require().Equal(suite.ctx.BlockTime(), lastEpochTime)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 6_000_000)), suite.Rewards(suite.addrs[0])))
+}
+
+func (suite *ModuleTestSuite) TestEndBlockerMultipleElapsedEpochsLockedStakings() {
+	plan := suite.sampleFixedAmtPlans[1] // 2_000_000denom3 per epoch
+	suite.keeper.SetPlan(suite.ctx, plan)
+
+	lockDuration := 60 * time.Hour
+	params := suite.keeper.GetParams(suite.ctx)
+	params.LockMultipliers = []types.LockMultiplier{{LockDuration: lockDuration, Multiplier: sdk.NewDec(2)}}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-04T00:00:00Z"))
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	// Locked until 2021-08-06T12:00:00Z.
+	err := suite.keeper.LockStake(suite.ctx, suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), lockDuration)
+	suite.Require().NoError(err)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-05T00:00:00Z"))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	// Locked until 2021-08-07T12:00:00Z, and its queued coins are staked by the next epoch.
+	err = suite.keeper.LockStake(suite.ctx, suite.addrs[2], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), lockDuration)
+	suite.Require().NoError(err)
+
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[2], denom3)
+
+	// The chain has been halted for 2.5 days, and the epochs ending at 2021-08-06T00:00:00Z and
+	// 2021-08-07T12:00:00Z are advanced in a single block. The locks must be boosted and unlocked
+	// at the epoch times, not at the block time.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-07T12:00:00Z"))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+
+	// 2021-08-06T00:00:00Z: 2_000_000 * 1/3, 2021-08-07T12:00:00Z: 2_000_000 * 1/5
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_066_666)), suite.Rewards(suite.addrs[0])))
+	// The rewards accumulated with the boost are withdrawn when the locks are unlocked.
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[2], denom3)
+	suite.Require().True(sdk.NewInt(800_000).Equal(balance.Amount.Sub(balanceBefore.Amount)))
+	suite.Require().Empty(suite.keeper.GetLockedStakingsByFarmer(suite.ctx, suite.addrs[1], denom1))
+	suite.Require().Empty(suite.keeper.GetLockedStakingsByFarmer(suite.ctx, suite.addrs[2], denom1))
+}
+
+func (suite *ModuleTestSuite) TestEndBlockerPlanEndedWhileHalted() {
+	plan := suite.sampleFixedAmtPlans[1] // 2_000_000denom3 per epoch
+	suite.Require().NoError(plan.SetEndTime(types.ParseTime("2021-08-06T12:00:00Z")))
+	suite.keeper.SetPlan(suite.ctx, plan)
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-04T00:00:00Z"))
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-05T00:00:00Z"))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	suite.Require().True(suite.Rewards(suite.addrs[0]).IsZero())
+
+	// The chain has been halted for 3 days, and the plan has ended in the meantime.
+	// It still allocates rewards for the epoch ended before its end time.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-08T00:00:00Z"))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_000_000)), suite.Rewards(suite.addrs[0])))
+
+	plan, _ = suite.keeper.GetPlan(suite.ctx, plan.GetId())
+	suite.Require().True(plan.GetTerminated())
+	suite.Require().Equal(types.ParseTime("2021-08-06T00:00:00Z"), *plan.GetLastDistributionTime())
+}
+
+func (suite *ModuleTestSuite) TestEndBlockerPlanEndedWhileHaltedMaxEpochsPerBlock() {
+	plan := suite.sampleFixedAmtPlans[1] // 2_000_000denom3 per epoch
+	suite.Require().NoError(plan.SetEndTime(types.ParseTime("2021-08-18T12:00:00Z")))
+	suite.keeper.SetPlan(suite.ctx, plan)
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-04T00:00:00Z"))
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-05T00:00:00Z"))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+
+	// The chain has been halted for 15 days, so the elapsed epochs are advanced in two blocks.
+	// The plan is not terminated until the epochs before its end time are advanced.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-20T00:00:00Z"))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	plan, _ = suite.keeper.GetPlan(suite.ctx, plan.GetId())
+	suite.Require().False(plan.GetTerminated())
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 20_000_000)), suite.Rewards(suite.addrs[0])))
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-20T00:00:05Z"))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	plan, _ = suite.keeper.GetPlan(suite.ctx, plan.GetId())
+	suite.Require().True(plan.GetTerminated())
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 26_000_000)), suite.Rewards(suite.addrs[0])))
+}
+
+func (suite *ModuleTestSuite) TestEndBlockerMaxEpochsPerBlock() {
+	t := types.ParseTime("2021-08-01T00:00:00Z")
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+
+	countEpochs := func() (n int) {
+		for _, ev := range suite.ctx.EventManager().Events() {
+			if ev.Type == types.EventTypeAdvanceEpoch {
+				n++
+			}
+		}
+		return
+	}
+
+	// The chain has been halted for 15 days.
+	t = t.AddDate(0, 0, 15)
+	suite.ctx = suite.ctx.WithBlockTime(t).WithEventManager(sdk.NewEventManager())
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	suite.Require().Equal(keeper.MaxEpochsPerBlock, countEpochs())
+	lastEpochTime, _ := suite.keeper.GetLastEpochTime(suite.ctx)
+	suite.Require().Equal(types.ParseTime("2021-08-11T00:00:00Z"), lastEpochTime)
+
+	// The rest of the elapsed epochs are advanced in the next block.
+	t = t.Add(5 * time.Second)
+	suite.ctx = suite.ctx.WithBlockTime(t).WithEventManager(sdk.NewEventManager())
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	suite.Require().Equal(5, countEpochs())
+	lastEpochTime, _ = suite.keeper.GetLastEpochTime(suite.ctx)
+	suite.Require().Equal(t, lastEpochTime)
+
+	// No more epochs are advanced until the current epoch ends.
+	t = t.Add(5 * time.Second)
+	suite.ctx = suite.ctx.WithBlockTime(t).WithEventManager(sdk.NewEventManager())
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	suite.Require().Equal(0, countEpochs())
+}
+
+func (suite *ModuleTestSuite) TestEndBlockerSubDayEpochs() {
+	t := types.ParseTime("2021-08-01T12:00:00Z")
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.NextEpochDuration = time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// The current epoch still lasts for a day, even across the UTC date boundary.
+	suite.ctx = suite.ctx.WithBlockTime(t.Add(23 * time.Hour))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	lastEpochTime, _ := suite.keeper.GetLastEpochTime(suite.ctx)
+	suite.Require().Equal(t, lastEpochTime)
+
+	t = t.Add(24 * time.Hour)
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	lastEpochTime, _ = suite.keeper.GetLastEpochTime(suite.ctx)
+	suite.Require().Equal(t, lastEpochTime)
+	suite.Require().Equal(time.Hour, suite.keeper.GetCurrentEpochDuration(suite.ctx))
+
+	// From now on, the epochs last for an hour.
+	suite.ctx = suite.ctx.WithBlockTime(t.Add(59 * time.Minute))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	lastEpochTime, _ = suite.keeper.GetLastEpochTime(suite.ctx)
+	suite.Require().Equal(t, lastEpochTime)
+
+	t = t.Add(time.Hour)
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	lastEpochTime, _ = suite.keeper.GetLastEpochTime(suite.ctx)
+	suite.Require().Equal(t, lastEpochTime)
+}
+
+func (suite *ModuleTestSuite) TestEndBlockerProcessQueuedStakings() {
+	t := types.ParseTime("2021-08-01T00:00:00Z")
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+
+	numFarmers := keeper.MaxQueuedStakingsPerBlock + 10
+	farmers := simapp.AddTestAddrs(suite.app, suite.ctx, numFarmers, sdk.ZeroInt())
+	for _, farmer := range farmers {
+		err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, farmer, sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000)))
+		suite.Require().NoError(err)
+		suite.Stake(farmer, sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000)))
+	}
+
+	// Only the first page of the queued stakings is processed in the block advancing the epoch.
+	t = t.Add(24 * time.Hour)
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	_, found := suite.keeper.GetQueuedStakingsCursor(suite.ctx)
+	suite.Require().True(found)
+	totalStakings, _ := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(sdk.NewInt(keeper.MaxQueuedStakingsPerBlock * 1_000).Equal(totalStakings.Amount))
+
+	// The rest are processed in the next block.
+	suite.ctx = suite.ctx.WithBlockTime(t.Add(5 * time.Second))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	_, found = suite.keeper.GetQueuedStakingsCursor(suite.ctx)
+	suite.Require().False(found)
+	totalStakings, _ = suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(sdk.NewInt(int64(numFarmers) * 1_000).Equal(totalStakings.Amount))
+}
+
+func (suite *ModuleTestSuite) TestEndBlockerElapsedEpochsWaitForQueuedStakings() {
+	t := types.ParseTime("2021-08-01T00:00:00Z")
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+
+	numFarmers := keeper.MaxQueuedStakingsPerBlock + 10
+	farmers := simapp.AddTestAddrs(suite.app, suite.ctx, numFarmers, sdk.ZeroInt())
+	for _, farmer := range farmers {
+		err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, farmer, sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000)))
+		suite.Require().NoError(err)
+		suite.Stake(farmer, sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000)))
+	}
+
+	// The chain has been halted for 3 days. The next epochs are not advanced
+	// until all the queued stakings matured by the first one are processed.
+	t = t.AddDate(0, 0, 3)
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	lastEpochTime, _ := suite.keeper.GetLastEpochTime(suite.ctx)
+	suite.Require().Equal(types.ParseTime("2021-08-02T00:00:00Z"), lastEpochTime)
+	_, found := suite.keeper.GetQueuedStakingsCursor(suite.ctx)
+	suite.Require().True(found)
+
+	// The rest of the queued stakings are processed in the next block, and then the epochs are advanced.
+	t = t.Add(5 * time.Second)
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	lastEpochTime, _ = suite.keeper.GetLastEpochTime(suite.ctx)
+	suite.Require().Equal(t, lastEpochTime)
+	_, found = suite.keeper.GetQueuedStakingsCursor(suite.ctx)
+	suite.Require().False(found)
+	totalStakings, _ := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(sdk.NewInt(int64(numFarmers) * 1_000).Equal(totalStakings.Amount))
+}
@@ -12,6 +12,11 @@ const (
 	FlagTerminationAddr  = "termination-addr"
 	FlagStakingCoinDenom = "staking-coin-denom"
 	FlagAll              = "all"
+
+	FlagRewardVestingDuration = "reward-vesting-duration"
+	FlagLockDuration          = "lock-duration"
+	FlagMintReceipt           = "mint-receipt"
+	FlagAdditionalStaking     = "additional-staking"
 )
 
 func flagSetPlans() *flag.FlagSet {
@@ -41,6 +46,23 @@ func flagSetRewards() *flag.FlagSet {
 	return fs
 }
 
+func flagSetApr() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagAdditionalStaking, "", "A hypothetical amount staked on top of the current total stakings")
+
+	return fs
+}
+
+func flagSetStake() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Duration(FlagLockDuration, 0, "The duration to lock the staking coins for boosted rewards; it must be one of the lock durations in params")
+	fs.Bool(FlagMintReceipt, false, "Mint transferable receipt coins of the staking coins; the staking follows the ownership of the receipts")
+
+	return fs
+}
+
 func flagSetHarvest() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -48,3 +70,11 @@ func flagSetHarvest() *flag.FlagSet {
 
 	return fs
 }
+
+func flagSetCreatePrivatePlan() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Duration(FlagRewardVestingDuration, 0, "The duration over which the rewards from the plan vest linearly after harvest; zero disables vesting")
+
+	return fs
+}
@@ -34,9 +34,18 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryPlans(),
 		GetCmdQueryPlan(),
+		GetCmdQueryPlanFarmers(),
 		GetCmdQueryStakings(),
 		GetCmdQueryTotalStakings(),
 		GetCmdQueryRewards(),
+		GetCmdQueryPlanRewards(),
+		GetCmdQueryApr(),
+		GetCmdQueryVestingRewards(),
+		GetCmdQueryUnbondingStakings(),
+		GetCmdQueryRewardWithdrawAddress(),
+		GetCmdQueryAutoCompoundFarmers(),
+		GetCmdQueryRewardsDust(),
+		GetCmdQueryEpochInfo(),
 		GetCmdQueryCurrentEpochDays(),
 	)
 
@@ -190,6 +199,57 @@ $ %s query %s plan
 	return cmd
 }
 
+func GetCmdQueryPlanFarmers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan-farmers [plan-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the farmers in the allowlist of a plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the farmers in the allowlist of a restricted plan.
+Only the farmers in the allowlist are eligible for the rewards of the plan.
+
+Example:
+$ %s query %s plan-farmers 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			planId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "plan-id %s is not valid", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.PlanFarmers(cmd.Context(), &types.QueryPlanFarmersRequest{
+				PlanId:     planId,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "plan-farmers")
+
+	return cmd
+}
+
 func GetCmdQueryStakings() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
 
@@ -336,13 +396,382 @@ $ %s query %s rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --staking-coin-d
 	return cmd
 }
 
+func GetCmdQueryPlanRewards() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "plan-rewards [farmer] [plan-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query rewards for a farmer allocated by a plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query rewards for a farmer allocated by a plan.
+
+Optionally restrict rewards for a staking coin denom.
+
+Example:
+$ %s query %s plan-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 1
+$ %s query %s plan-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 1 --staking-coin-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			farmerAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			planId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "plan-id %s is not valid", args[1])
+			}
+
+			stakingCoinDenom, _ := cmd.Flags().GetString(FlagStakingCoinDenom)
+
+			resp, err := queryClient.PlanRewards(cmd.Context(), &types.QueryPlanRewardsRequest{
+				Farmer:           farmerAcc.String(),
+				PlanId:           planId,
+				StakingCoinDenom: stakingCoinDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetRewards())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryApr() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apr [staking-coin-denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the projected rewards per staked unit for a staking coin denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the projected rewards per staked unit per epoch and per year for a staking coin denom.
+The projection is based on the active plans and the balances of their farming pools at the moment.
+
+Optionally add a hypothetical staking amount on top of the current total stakings to see the dilution.
+
+Example:
+$ %s query %s apr poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+$ %s query %s apr poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --additional-staking 1000000
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			stakingCoinDenom := args[0]
+			if err := sdk.ValidateDenom(stakingCoinDenom); err != nil {
+				return err
+			}
+
+			additionalStaking, _ := cmd.Flags().GetString(FlagAdditionalStaking)
+
+			resp, err := queryClient.Apr(cmd.Context(), &types.QueryAprRequest{
+				StakingCoinDenom:        stakingCoinDenom,
+				AdditionalStakingAmount: additionalStaking,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetApr())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryVestingRewards() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "vesting-rewards [farmer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query vesting rewards for a farmer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query locked and unlocked vesting rewards for a farmer.
+
+Example:
+$ %s query %s vesting-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			farmerAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			resp, err := queryClient.VestingRewards(cmd.Context(), &types.QueryVestingRewardsRequest{
+				Farmer: farmerAcc.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryUnbondingStakings() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "unbonding-stakings [farmer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query unbonding stakings for a farmer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the unbonding entries of the coins unstaked by a farmer.
+The coins are paid out to the farmer at the completion time of each entry.
+
+Example:
+$ %s query %s unbonding-stakings %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			farmerAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			resp, err := queryClient.UnbondingStakings(cmd.Context(), &types.QueryUnbondingStakingsRequest{
+				Farmer: farmerAcc.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryRewardWithdrawAddress() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "reward-withdraw-address [farmer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the reward withdraw address of a farmer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the address that the farming rewards of a farmer are sent to.
+
+Example:
+$ %s query %s reward-withdraw-address %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			farmerAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			resp, err := queryClient.RewardWithdrawAddress(cmd.Context(), &types.QueryRewardWithdrawAddressRequest{
+				Farmer: farmerAcc.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryAutoCompoundFarmers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-compound-farmers",
+		Args:  cobra.NoArgs,
+		Short: "Query the farmers who have enabled auto-compounding",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the farmers who have enabled auto-compounding of their rewards.
+
+Example:
+$ %s query %s auto-compound-farmers
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.AutoCompoundFarmers(cmd.Context(), &types.QueryAutoCompoundFarmersRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "auto-compound-farmers")
+
+	return cmd
+}
+
+func GetCmdQueryRewardsDust() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards-dust",
+		Args:  cobra.NoArgs,
+		Short: "Query the rewards dust left over from truncation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fractional rewards left over from truncation of withdrawn rewards.
+The integral part of the dust is swept to the farming fee collector at each epoch.
+
+Example:
+$ %s query %s rewards-dust
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.RewardsDust(cmd.Context(), &types.QueryRewardsDustRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryEpochInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-info",
+		Args:  cobra.NoArgs,
+		Short: "Query the epoch information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the last epoch time, the current epoch duration, the next epoch time
+and the current epoch of the rewards of each staking coin denom.
+
+Example:
+$ %s query %s epoch-info
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.EpochInfo(cmd.Context(), &types.QueryEpochInfoRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epoch-info")
+
+	return cmd
+}
+
 func GetCmdQueryCurrentEpochDays() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "current-epoch-days",
 		Args:  cobra.NoArgs,
-		Short: "Query the value of current epoch days",
+		Short: "Query the value of current epoch days and duration",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query the value set as current epoch days.
+			fmt.Sprintf(`Query the value set as current epoch duration, along with the legacy current epoch days.
+The current epoch days are zero if the current epoch is shorter than a day.
 
 Example:
 $ %s query %s current-epoch-days
@@ -5,6 +5,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -35,9 +36,22 @@ func GetTxCmd() *cobra.Command {
 	farmingTxCmd.AddCommand(
 		NewCreateFixedAmountPlanCmd(),
 		NewCreateRatioPlanCmd(),
+		NewCreateDecayingPlanCmd(),
+		NewCreateSchedulePlanCmd(),
 		NewStakeCmd(),
 		NewUnstakeCmd(),
+		NewCancelQueuedStakingCmd(),
+		NewEmergencyUnstakeCmd(),
 		NewHarvestCmd(),
+		NewClaimVestedRewardsCmd(),
+		NewTerminatePrivatePlanCmd(),
+		NewUpdatePrivatePlanCmd(),
+		NewAddPlanFarmersCmd(),
+		NewRemovePlanFarmersCmd(),
+		NewSetRewardWithdrawAddressCmd(),
+		NewTransferStakingCmd(),
+		NewSyncReceiptStakingCmd(),
+		NewSetAutoCompoundCmd(),
 	)
 	if keeper.EnableAdvanceEpoch {
 		farmingTxCmd.AddCommand(NewAdvanceEpochCmd())
@@ -109,10 +123,14 @@ Description for the parameters:
 				plan.EpochAmount,
 			)
 
+			rewardVestingDuration, _ := cmd.Flags().GetDuration(FlagRewardVestingDuration)
+			msg.RewardVestingDuration = rewardVestingDuration
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().AddFlagSet(flagSetCreatePrivatePlan())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -176,10 +194,199 @@ Description for the parameters:
 				plan.EpochRatio,
 			)
 
+			rewardVestingDuration, _ := cmd.Flags().GetDuration(FlagRewardVestingDuration)
+			msg.RewardVestingDuration = rewardVestingDuration
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().AddFlagSet(flagSetCreatePrivatePlan())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCreateDecayingPlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-private-decaying-plan [plan-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create private decaying farming plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create private decaying farming plan.
+The plan details must be provided through a JSON file. 
+		
+Example:
+$ %s tx %s create-private-decaying-plan <path/to/plan.json> --from mykey 
+
+Where plan.json contains:
+
+{
+  "name": "This plan intends to provide incentives for Cosmonauts!",
+  "staking_coin_weights": [
+    {
+      "denom": "poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4",
+      "amount": "1.000000000000000000"
+    }
+  ],
+  "start_time": "2021-08-06T09:00:00Z",
+  "end_time": "2022-08-13T09:00:00Z",
+  "epoch_amount": [
+    {
+      "denom": "uatom",
+      "amount": "1000000"
+    }
+  ],
+  "decay_rate": "0.500000000000000000",
+  "decay_epochs": 30
+}
+
+Description for the parameters:
+
+[name]: specifies the name for the plan 
+[staking_coin_weights]: specifies coin weights for the plan
+[start_time]: specifies the time for the plan to start 
+[end_time]: specifies the time for the plan to end
+[epoch_amount]: specifies an initial amount to distribute for every epoch
+[decay_rate]: specifies a factor that the epoch amount is multiplied by every decay_epochs. 0.500000000000000000 means to halve the amount
+[decay_epochs]: specifies the number of epochs between decays
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			plan, err := ParsePrivateDecayingPlan(args[0])
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse %s file due to %v", args[0], err)
+			}
+
+			msg := types.NewMsgCreateDecayingPlan(
+				plan.Name,
+				clientCtx.GetFromAddress(),
+				plan.StakingCoinWeights,
+				plan.StartTime,
+				plan.EndTime,
+				plan.EpochAmount,
+				plan.DecayRate,
+				plan.DecayEpochs,
+			)
+
+			rewardVestingDuration, _ := cmd.Flags().GetDuration(FlagRewardVestingDuration)
+			msg.RewardVestingDuration = rewardVestingDuration
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetCreatePrivatePlan())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCreateSchedulePlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-private-schedule-plan [plan-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create private schedule-based farming plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create private schedule-based farming plan.
+The plan details must be provided through a JSON file. 
+		
+Example:
+$ %s tx %s create-private-schedule-plan <path/to/plan.json> --from mykey 
+
+Where plan.json contains:
+
+{
+  "name": "This plan intends to provide incentives for Cosmonauts!",
+  "staking_coin_weights": [
+    {
+      "denom": "poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4",
+      "amount": "1.000000000000000000"
+    }
+  ],
+  "start_time": "2021-08-01T00:00:00Z",
+  "end_time": "2022-08-01T00:00:00Z",
+  "phases": [
+    {
+      "start_time": "2021-08-01T00:00:00Z",
+      "end_time": "2021-09-01T00:00:00Z",
+      "epoch_amount": [
+        {
+          "denom": "uatom",
+          "amount": "1000"
+        }
+      ]
+    },
+    {
+      "start_time": "2021-09-01T00:00:00Z",
+      "end_time": "2021-11-01T00:00:00Z",
+      "epoch_amount": [
+        {
+          "denom": "uatom",
+          "amount": "500"
+        }
+      ]
+    },
+    {
+      "start_time": "2021-11-01T00:00:00Z",
+      "end_time": "2022-08-01T00:00:00Z",
+      "epoch_amount": [
+        {
+          "denom": "uatom",
+          "amount": "100"
+        }
+      ]
+    }
+  ]
+}
+
+Description for the parameters:
+
+[name]: specifies the name for the plan 
+[staking_coin_weights]: specifies coin weights for the plan
+[start_time]: specifies the time for the plan to start 
+[end_time]: specifies the time for the plan to end
+[phases]: specifies the ordered, non-overlapping phases within the plan's start and end time.
+Each phase distributes its epoch_amount for every epoch between its start_time and end_time
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			plan, err := ParsePrivateSchedulePlan(args[0])
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse %s file due to %v", args[0], err)
+			}
+
+			msg := types.NewMsgCreateSchedulePlan(
+				plan.Name,
+				clientCtx.GetFromAddress(),
+				plan.StakingCoinWeights,
+				plan.StartTime,
+				plan.EndTime,
+				plan.Phases,
+			)
+
+			rewardVestingDuration, _ := cmd.Flags().GetDuration(FlagRewardVestingDuration)
+			msg.RewardVestingDuration = rewardVestingDuration
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetCreatePrivatePlan())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -194,13 +401,21 @@ func NewStakeCmd() *cobra.Command {
 			fmt.Sprintf(`Stake coins. 
 			
 To get farming rewards, you must stake coins that are defined in available plans on a network. 
+Optionally lock the coins for one of the lock durations defined in params to get boosted rewards;
+locked coins can't be unstaked until the lock duration has passed.
+Alternatively, mint receipt coins (farm/<denom>) of the staking coins; the staking follows the ownership
+of the receipts, and unstaking burns them.
 
 Example:
 $ %s tx %s stake 1000poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --from mykey
 $ %s tx %s stake 500poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4,500pool93E069B333B5ECEBFE24C6E1437E814003248E0DD7FF8B9F82119F4587449BA5 --from mykey
+$ %s tx %s stake 1000poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --lock-duration 720h --from mykey
+$ %s tx %s stake 1000poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --mint-receipt --from mykey
 `,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -218,10 +433,16 @@ $ %s tx %s stake 500poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2
 
 			msg := types.NewMsgStake(farmer, stakingCoins)
 
+			lockDuration, _ := cmd.Flags().GetDuration(FlagLockDuration)
+			msg.LockDuration = lockDuration
+			mintReceipt, _ := cmd.Flags().GetBool(FlagMintReceipt)
+			msg.MintReceipt = mintReceipt
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().AddFlagSet(flagSetStake())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -269,6 +490,93 @@ $ %s tx %s unstake 500poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70
 	return cmd
 }
 
+func NewCancelQueuedStakingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-queued-staking [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel queued coins which are not staked yet",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel queued coins which are not staked yet.
+
+Unlike unstake, the staked coins are never touched, so your accumulated rewards are not withdrawn.
+Queued coins of locked stakings can't be cancelled.
+
+Example:
+$ %s tx %s cancel-queued-staking 500poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			farmer := clientCtx.GetFromAddress()
+
+			queuedCoins, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelQueuedStaking(farmer, queuedCoins)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewEmergencyUnstakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emergency-unstake [staking-coin-denoms]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Withdraw all staked and queued coins while staking or harvesting is paused, forfeiting the rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw all staked and queued coins of the staking coin denoms while staking or harvesting of the module is paused.
+
+The coins are released immediately regardless of the locked stakings and the unstaking period,
+but the accumulated rewards of the stakings are forfeited.
+This is only allowed while staking or harvesting is paused by the paused_operations param.
+
+Example:
+$ %s tx %s emergency-unstake poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --from mykey
+$ %s tx %s emergency-unstake poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4,stake --from mykey
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			farmer := clientCtx.GetFromAddress()
+
+			denoms := strings.Split(args[0], ",")
+			for _, denom := range denoms {
+				if err := sdk.ValidateDenom(denom); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgEmergencyUnstake(farmer, denoms)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewHarvestCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "harvest [staking-coin-denoms]",
@@ -334,6 +642,394 @@ $ %s tx %s harvest --all --from mykey
 	return cmd
 }
 
+func NewClaimVestedRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-vested-rewards",
+		Args:  cobra.NoArgs,
+		Short: "Claim unlocked vesting rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim unlocked vesting rewards.
+Rewards from plans with reward vesting duration are unlocked linearly over the vesting duration after harvest.
+
+Example:
+$ %s tx %s claim-vested-rewards --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimVestedRewards(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewTerminatePrivatePlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terminate-private-plan [plan-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Terminate private farming plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Terminate private farming plan before its end time.
+Only the creator of the plan can terminate it. All remaining coins in the farming pool are sent back to the creator.
+
+Example:
+$ %s tx %s terminate-private-plan 1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			planId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "plan-id %s is not valid", args[0])
+			}
+
+			msg := types.NewMsgTerminatePrivatePlan(clientCtx.GetFromAddress(), planId)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewAddPlanFarmersCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "add-plan-farmers [plan-id] [farmers]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Add farmers to the allowlist of a private farming plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add farmers to the allowlist of a private farming plan.
+Only the creator of the plan can modify its allowlist. Once farmers are added, the plan becomes restricted
+and its rewards are distributed only to the farmers in the allowlist.
+Multiple farmer addresses can be given, separated by commas.
+
+Example:
+$ %s tx %s add-plan-farmers 1 %s1...,%s1... --from mykey
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			planId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "plan-id %s is not valid", args[0])
+			}
+
+			msg := types.NewMsgAddPlanFarmers(clientCtx.GetFromAddress(), planId, strings.Split(args[1], ","))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRemovePlanFarmersCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "remove-plan-farmers [plan-id] [farmers]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove farmers from the allowlist of a private farming plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove farmers from the allowlist of a restricted private farming plan.
+Only the creator of the plan can modify its allowlist. The rewards of the farmers are withdrawn before they are removed.
+Multiple farmer addresses can be given, separated by commas.
+
+Example:
+$ %s tx %s remove-plan-farmers 1 %s1... --from mykey
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			planId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "plan-id %s is not valid", args[0])
+			}
+
+			msg := types.NewMsgRemovePlanFarmers(clientCtx.GetFromAddress(), planId, strings.Split(args[1], ","))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewUpdatePrivatePlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-private-plan [plan-id] [plan-file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Update private farming plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update private farming plan that is not terminated yet.
+Only the creator of the plan can update it. The plan details must be provided through a JSON file.
+
+Example:
+$ %s tx %s update-private-plan 1 <path/to/plan.json> --from mykey
+
+Where plan.json contains:
+
+{
+  "name": "This plan intends to provide incentives for Cosmonauts!",
+  "staking_coin_weights": [
+    {
+      "denom": "poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4",
+      "amount": "1.000000000000000000"
+    }
+  ],
+  "end_time": "2022-08-13T09:00:00Z",
+  "epoch_amount": [
+    {
+      "denom": "uatom",
+      "amount": "1"
+    }
+  ]
+}
+
+Description for the parameters:
+
+[name]: specifies the name for the plan, the name is not changed if it is empty
+[staking_coin_weights]: specifies coin weights for the plan
+[end_time]: specifies the time for the plan to end, the end time is not changed if it is omitted
+[epoch_amount]: specifies an amount to distribute for every epoch
+[epoch_ratio]: specifies a ratio to distribute for every epoch
+[decay_rate]: specifies a factor that the epoch_amount is multiplied by every decay_epochs, the plan becomes a decaying plan if it is provided
+[decay_epochs]: specifies the number of epochs between decays
+[phases]: specifies the emission phases of a schedule plan, they must be provided to update a schedule plan
+only one of epoch_amount, epoch_ratio or phases must be provided
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			planId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "plan-id %s is not valid", args[0])
+			}
+
+			plan, err := ParsePrivatePlanUpdate(args[1])
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse %s file due to %v", args[1], err)
+			}
+
+			msg := types.NewMsgUpdatePrivatePlan(
+				clientCtx.GetFromAddress(),
+				planId,
+				plan.Name,
+				plan.StakingCoinWeights,
+				plan.EndTime,
+				plan.EpochAmount,
+				plan.EpochRatio,
+				plan.DecayRate,
+				plan.DecayEpochs,
+				plan.Phases,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetRewardWithdrawAddressCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-reward-withdraw-address [withdraw-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Change the address that farming rewards are sent to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Change the address that farming rewards are sent to.
+All rewards of the farmer, including the rewards withdrawn automatically on staking and unstaking,
+are sent to the withdraw address. Set the farmer's own address to receive rewards directly again.
+
+Example:
+$ %s tx %s set-reward-withdraw-address %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			withdrawAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRewardWithdrawAddress(clientCtx.GetFromAddress(), withdrawAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewTransferStakingCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "transfer-staking [recipient] [staking-coin-denoms]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Transfer staking positions to another address without unstaking",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the staked and queued coins of the staking coin denoms to another address without unstaking.
+The rewards accumulated so far are withdrawn before the transfer. Locked stakings can't be transferred.
+
+Example:
+$ %s tx %s transfer-staking %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --from mykey
+$ %s tx %s transfer-staking %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4,pool93E069B333B5ECEBFE24C6E1437E814003248E0DD7FF8B9F82119F4587449BA5 --from mykey
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferStaking(clientCtx.GetFromAddress(), recipient, strings.Split(args[1], ","))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSyncReceiptStakingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync-receipt-staking [staking-coin-denoms]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Sync receipt-backed staking with the receipt coins you hold",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sync receipt-backed staking with the receipt coins you hold.
+If you have received receipt coins, the staking backed by them is claimed as your queued coins.
+If you have sent receipt coins away, the staking backed by them is released after withdrawing your rewards.
+All receipt-backed stakings are synced at the end of every epoch as well.
+
+Example:
+$ %s tx %s sync-receipt-staking poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSyncReceiptStaking(clientCtx.GetFromAddress(), strings.Split(args[0], ","))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetAutoCompoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [enabled]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Enable or disable auto-compounding of farming rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable auto-compounding of farming rewards.
+When enabled, the rewards of the farmer are withdrawn at the end of every epoch
+and the reward coins that are staking coins of active plans are staked directly,
+without being queued. The rest of the rewards are sent to the reward withdraw address.
+
+Example:
+$ %s tx %s set-auto-compound true --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "enabled %s is not a valid boolean", args[0])
+			}
+
+			msg := types.NewMsgSetAutoCompound(clientCtx.GetFromAddress(), enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewAdvanceEpochCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "advance-epoch",
@@ -417,6 +1113,8 @@ Where proposal.json contains:
     }
   ]
 }
+
+To add a decaying plan, provide "decay_rate" and "decay_epochs" along with "epoch_amount" in the add request proposal.
 `,
 				version.AppName,
 			),
@@ -29,6 +29,38 @@ type PrivateRatioPlanRequest struct {
 	EpochRatio         sdk.Dec      `json:"epoch_ratio"`
 }
 
+// PrivateDecayingPlanRequest defines CLI request for a private decaying plan.
+type PrivateDecayingPlanRequest struct {
+	Name               string       `json:"name"`
+	StakingCoinWeights sdk.DecCoins `json:"staking_coin_weights"`
+	StartTime          time.Time    `json:"start_time"`
+	EndTime            time.Time    `json:"end_time"`
+	EpochAmount        sdk.Coins    `json:"epoch_amount"`
+	DecayRate          sdk.Dec      `json:"decay_rate"`
+	DecayEpochs        uint32       `json:"decay_epochs"`
+}
+
+// PrivateSchedulePlanRequest defines CLI request for a private schedule plan.
+type PrivateSchedulePlanRequest struct {
+	Name               string                `json:"name"`
+	StakingCoinWeights sdk.DecCoins          `json:"staking_coin_weights"`
+	StartTime          time.Time             `json:"start_time"`
+	EndTime            time.Time             `json:"end_time"`
+	Phases             []types.SchedulePhase `json:"phases"`
+}
+
+// PrivatePlanUpdateRequest defines CLI request for updating a private plan.
+type PrivatePlanUpdateRequest struct {
+	Name               string                `json:"name"`
+	StakingCoinWeights sdk.DecCoins          `json:"staking_coin_weights"`
+	EndTime            *time.Time            `json:"end_time"`
+	EpochAmount        sdk.Coins             `json:"epoch_amount"`
+	EpochRatio         sdk.Dec               `json:"epoch_ratio"`
+	DecayRate          sdk.Dec               `json:"decay_rate"`
+	DecayEpochs        uint32                `json:"decay_epochs"`
+	Phases             []types.SchedulePhase `json:"phases"`
+}
+
 // ParsePrivateFixedPlan reads and parses a PrivateFixedPlanRequest from a file.
 func ParsePrivateFixedPlan(file string) (PrivateFixedPlanRequest, error) {
 	plan := PrivateFixedPlanRequest{}
@@ -61,6 +93,54 @@ func ParsePrivateRatioPlan(file string) (PrivateRatioPlanRequest, error) {
 	return plan, nil
 }
 
+// ParsePrivateDecayingPlan reads and parses a PrivateDecayingPlanRequest from a file.
+func ParsePrivateDecayingPlan(file string) (PrivateDecayingPlanRequest, error) {
+	plan := PrivateDecayingPlanRequest{}
+
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		return plan, err
+	}
+
+	if err = json.Unmarshal(contents, &plan); err != nil {
+		return plan, err
+	}
+
+	return plan, nil
+}
+
+// ParsePrivateSchedulePlan reads and parses a PrivateSchedulePlanRequest from a file.
+func ParsePrivateSchedulePlan(file string) (PrivateSchedulePlanRequest, error) {
+	plan := PrivateSchedulePlanRequest{}
+
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		return plan, err
+	}
+
+	if err = json.Unmarshal(contents, &plan); err != nil {
+		return plan, err
+	}
+
+	return plan, nil
+}
+
+// ParsePrivatePlanUpdate reads and parses a PrivatePlanUpdateRequest from a file.
+func ParsePrivatePlanUpdate(file string) (PrivatePlanUpdateRequest, error) {
+	plan := PrivatePlanUpdateRequest{}
+
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		return plan, err
+	}
+
+	if err = json.Unmarshal(contents, &plan); err != nil {
+		return plan, err
+	}
+
+	return plan, nil
+}
+
 // ParsePublicPlanProposal reads and parses a PublicPlanProposal from a file.
 func ParsePublicPlanProposal(cdc codec.JSONCodec, proposalFile string) (types.PublicPlanProposal, error) {
 	proposal := types.PublicPlanProposal{}
@@ -92,3 +172,27 @@ func (req PrivateRatioPlanRequest) String() string {
 	}
 	return string(result)
 }
+
+func (req PrivateDecayingPlanRequest) String() string {
+	result, err := json.Marshal(&req)
+	if err != nil {
+		panic(err)
+	}
+	return string(result)
+}
+
+func (req PrivateSchedulePlanRequest) String() string {
+	result, err := json.Marshal(&req)
+	if err != nil {
+		panic(err)
+	}
+	return string(result)
+}
+
+func (req PrivatePlanUpdateRequest) String() string {
+	result, err := json.Marshal(&req)
+	if err != nil {
+		panic(err)
+	}
+	return string(result)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/tendermint/farming/app/params"
 	"github.com/tendermint/farming/x/farming/client/cli"
+	"github.com/tendermint/farming/x/farming/types"
 )
 
 func TestParsePrivateFixedPlan(t *testing.T) {
@@ -71,6 +72,147 @@ func TestParsePrivateRatioPlan(t *testing.T) {
 	require.Equal(t, "1.000000000000000000", plan.EpochRatio.String())
 }
 
+func TestParsePrivateDecayingPlan(t *testing.T) {
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "name": "This plan intends to provide incentives for Cosmonauts!",
+  "staking_coin_weights": [
+    {
+      "denom": "PoolCoinDenom",
+      "amount": "1.000000000000000000"
+    }
+  ],
+  "start_time": "2021-07-15T08:41:21Z",
+  "end_time": "2022-07-16T08:41:21Z",
+  "epoch_amount": [
+    {
+      "denom": "uatom",
+      "amount": "1000000"
+    }
+  ],
+  "decay_rate": "0.500000000000000000",
+  "decay_epochs": 30
+}
+`)
+
+	plan, err := cli.ParsePrivateDecayingPlan(okJSON.Name())
+	require.NoError(t, err)
+	require.NotEmpty(t, plan.String())
+
+	require.Equal(t, "This plan intends to provide incentives for Cosmonauts!", plan.Name)
+	require.Equal(t, "1.000000000000000000PoolCoinDenom", plan.StakingCoinWeights.String())
+	require.Equal(t, "2021-07-15T08:41:21Z", plan.StartTime.Format(time.RFC3339))
+	require.Equal(t, "2022-07-16T08:41:21Z", plan.EndTime.Format(time.RFC3339))
+	require.Equal(t, "1000000uatom", plan.EpochAmount.String())
+	require.Equal(t, "0.500000000000000000", plan.DecayRate.String())
+	require.Equal(t, uint32(30), plan.DecayEpochs)
+}
+
+func TestParsePrivateSchedulePlan(t *testing.T) {
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "name": "This plan intends to provide incentives for Cosmonauts!",
+  "staking_coin_weights": [
+    {
+      "denom": "PoolCoinDenom",
+      "amount": "1.000000000000000000"
+    }
+  ],
+  "start_time": "2021-07-15T00:00:00Z",
+  "end_time": "2022-07-15T00:00:00Z",
+  "phases": [
+    {
+      "start_time": "2021-07-15T00:00:00Z",
+      "end_time": "2021-08-15T00:00:00Z",
+      "epoch_amount": [
+        {
+          "denom": "uatom",
+          "amount": "1000"
+        }
+      ]
+    },
+    {
+      "start_time": "2021-08-15T00:00:00Z",
+      "end_time": "2022-07-15T00:00:00Z",
+      "epoch_amount": [
+        {
+          "denom": "uatom",
+          "amount": "500"
+        }
+      ]
+    }
+  ]
+}
+`)
+
+	plan, err := cli.ParsePrivateSchedulePlan(okJSON.Name())
+	require.NoError(t, err)
+	require.NotEmpty(t, plan.String())
+
+	require.Equal(t, "This plan intends to provide incentives for Cosmonauts!", plan.Name)
+	require.Equal(t, "1.000000000000000000PoolCoinDenom", plan.StakingCoinWeights.String())
+	require.Equal(t, "2021-07-15T00:00:00Z", plan.StartTime.Format(time.RFC3339))
+	require.Equal(t, "2022-07-15T00:00:00Z", plan.EndTime.Format(time.RFC3339))
+	require.Len(t, plan.Phases, 2)
+	require.Equal(t, "2021-08-15T00:00:00Z", plan.Phases[0].EndTime.Format(time.RFC3339))
+	require.Equal(t, "1000uatom", plan.Phases[0].EpochAmount.String())
+	require.Equal(t, "2021-08-15T00:00:00Z", plan.Phases[1].StartTime.Format(time.RFC3339))
+	require.Equal(t, "500uatom", plan.Phases[1].EpochAmount.String())
+	require.NoError(t, types.ValidateSchedulePhases(plan.Phases, plan.StartTime, plan.EndTime))
+}
+
+func TestParsePrivatePlanUpdate(t *testing.T) {
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "name": "This plan intends to provide incentives for Cosmonauts!",
+  "staking_coin_weights": [
+    {
+      "denom": "PoolCoinDenom",
+      "amount": "1.000000000000000000"
+    }
+  ],
+  "end_time": "2022-07-16T08:41:21Z",
+  "epoch_ratio": "0.500000000000000000"
+}
+`)
+
+	plan, err := cli.ParsePrivatePlanUpdate(okJSON.Name())
+	require.NoError(t, err)
+	require.NotEmpty(t, plan.String())
+
+	require.Equal(t, "This plan intends to provide incentives for Cosmonauts!", plan.Name)
+	require.Equal(t, "1.000000000000000000PoolCoinDenom", plan.StakingCoinWeights.String())
+	require.Equal(t, "2022-07-16T08:41:21Z", plan.EndTime.Format(time.RFC3339))
+	require.True(t, plan.EpochAmount.IsZero())
+	require.Equal(t, "0.500000000000000000", plan.EpochRatio.String())
+
+	noEndTimeJSON := testutil.WriteToNewTempFile(t, `
+{
+  "staking_coin_weights": [
+    {
+      "denom": "PoolCoinDenom",
+      "amount": "1.000000000000000000"
+    }
+  ],
+  "epoch_amount": [
+    {
+      "denom": "uatom",
+      "amount": "1"
+    }
+  ]
+}
+`)
+
+	plan, err = cli.ParsePrivatePlanUpdate(noEndTimeJSON.Name())
+	require.NoError(t, err)
+	require.NotEmpty(t, plan.String())
+
+	require.Empty(t, plan.Name)
+	require.Nil(t, plan.EndTime)
+	require.Equal(t, "1uatom", plan.EpochAmount.String())
+	require.True(t, plan.EpochRatio.IsNil())
+}
+
 func TestParsePublicPlanProposal(t *testing.T) {
 	encodingConfig := params.MakeTestEncodingConfig()
 
@@ -3,6 +3,7 @@ package testutil
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
@@ -1047,6 +1048,113 @@ func (s *QueryCmdTestSuite) TestCmdQueryRewards() {
 	}
 }
 
+func (s *QueryCmdTestSuite) TestCmdQueryPlanRewards() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryPlanRewardsResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				val.Address.String(),
+				"1",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryPlanRewardsResponse) {
+				s.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin("node0token", 100_000_000)), resp.Rewards))
+			},
+		},
+		{
+			"invalid plan id",
+			[]string{
+				val.Address.String(),
+				"invalid",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryPlanRewards()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryPlanRewardsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryEpochInfo() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryEpochInfoResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryEpochInfoResponse) {
+				s.Require().NotNil(resp.LastEpochTime)
+				s.Require().Equal(24*time.Hour, resp.CurrentEpochDuration)
+				s.Require().Equal(resp.LastEpochTime.Add(24*time.Hour), *resp.NextEpochTime)
+				s.Require().Len(resp.CurrentEpochs, 1)
+				s.Require().Equal(sdk.DefaultBondDenom, resp.CurrentEpochs[0].StakingCoinDenom)
+				s.Require().Equal(uint64(1), resp.CurrentEpochs[0].CurrentEpoch)
+			},
+		},
+		{
+			"with pagination",
+			[]string{
+				fmt.Sprintf("--%s=1", flags.FlagLimit),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryEpochInfoResponse) {
+				s.Require().Len(resp.CurrentEpochs, 1)
+				s.Require().Nil(resp.Pagination.NextKey)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryEpochInfo()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryEpochInfoResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
 func (s *QueryCmdTestSuite) TestCmdQueryCurrentEpochDays() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
@@ -1065,6 +1173,7 @@ func (s *QueryCmdTestSuite) TestCmdQueryCurrentEpochDays() {
 			false,
 			func(resp *farmingtypes.QueryCurrentEpochDaysResponse) {
 				s.Require().Equal(uint32(1), resp.CurrentEpochDays)
+				s.Require().Equal(24*time.Hour, resp.CurrentEpochDuration)
 			},
 		},
 	}
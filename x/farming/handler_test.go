@@ -1,7 +1,10 @@
 package farming_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/tendermint/farming/x/farming"
 	"github.com/tendermint/farming/x/farming/types"
@@ -67,6 +70,76 @@ func (suite *ModuleTestSuite) TestMsgCreateRatioPlan() {
 	suite.Require().Equal(msg.EpochRatio, plan.(*types.RatioPlan).EpochRatio)
 }
 
+func (suite *ModuleTestSuite) TestMsgCreateDecayingPlan() {
+	msg := types.NewMsgCreateDecayingPlan(
+		"handlerTestPlan6",
+		suite.addrs[0],
+		sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec(denom1, sdk.NewDecWithPrec(3, 1)), // 30%
+			sdk.NewDecCoinFromDec(denom2, sdk.NewDecWithPrec(7, 1)), // 70%
+		),
+		types.ParseTime("2021-08-02T00:00:00Z"),
+		types.ParseTime("2021-08-10T00:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 10_000_000)),
+		sdk.NewDecWithPrec(5, 1), // 50%
+		2,
+	)
+
+	handler := farming.NewHandler(suite.keeper)
+	_, err := handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	plan, found := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().Equal(true, found)
+
+	suite.Require().Equal(msg.Name, plan.GetName())
+	suite.Require().Equal(msg.Creator, plan.GetTerminationAddress().String())
+	suite.Require().Equal(msg.StakingCoinWeights, plan.GetStakingCoinWeights())
+	suite.Require().Equal(types.PrivatePlanFarmingPoolAddress(msg.Name, 1), plan.GetFarmingPoolAddress())
+	suite.Require().Equal(types.ParseTime("2021-08-02T00:00:00Z"), plan.GetStartTime())
+	suite.Require().Equal(types.ParseTime("2021-08-10T00:00:00Z"), plan.GetEndTime())
+	suite.Require().Equal(msg.EpochAmount, plan.(*types.DecayingPlan).EpochAmount)
+	suite.Require().Equal(msg.DecayRate, plan.(*types.DecayingPlan).DecayRate)
+	suite.Require().Equal(msg.DecayEpochs, plan.(*types.DecayingPlan).DecayEpochs)
+}
+
+func (suite *ModuleTestSuite) TestMsgCreateSchedulePlan() {
+	phases := []types.SchedulePhase{
+		types.NewSchedulePhase(
+			types.ParseTime("2021-08-02T00:00:00Z"), types.ParseTime("2021-08-05T00:00:00Z"),
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 10_000_000))),
+		types.NewSchedulePhase(
+			types.ParseTime("2021-08-05T00:00:00Z"), types.ParseTime("2021-08-10T00:00:00Z"),
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 5_000_000))),
+	}
+	msg := types.NewMsgCreateSchedulePlan(
+		"handlerTestPlan7",
+		suite.addrs[0],
+		sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec(denom1, sdk.NewDecWithPrec(3, 1)), // 30%
+			sdk.NewDecCoinFromDec(denom2, sdk.NewDecWithPrec(7, 1)), // 70%
+		),
+		types.ParseTime("2021-08-02T00:00:00Z"),
+		types.ParseTime("2021-08-10T00:00:00Z"),
+		phases,
+	)
+
+	handler := farming.NewHandler(suite.keeper)
+	_, err := handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	plan, found := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().Equal(true, found)
+
+	suite.Require().Equal(msg.Name, plan.GetName())
+	suite.Require().Equal(msg.Creator, plan.GetTerminationAddress().String())
+	suite.Require().Equal(msg.StakingCoinWeights, plan.GetStakingCoinWeights())
+	suite.Require().Equal(types.PrivatePlanFarmingPoolAddress(msg.Name, 1), plan.GetFarmingPoolAddress())
+	suite.Require().Equal(types.ParseTime("2021-08-02T00:00:00Z"), plan.GetStartTime())
+	suite.Require().Equal(types.ParseTime("2021-08-10T00:00:00Z"), plan.GetEndTime())
+	suite.Require().Equal(msg.Phases, plan.(*types.SchedulePlan).Phases)
+}
+
 func (suite *ModuleTestSuite) TestMsgStake() {
 	msg := types.NewMsgStake(
 		suite.addrs[0],
@@ -90,6 +163,32 @@ func (suite *ModuleTestSuite) TestMsgStake() {
 	suite.Require().Equal(msg.StakingCoins, queuedCoins)
 }
 
+func (suite *ModuleTestSuite) TestMsgStake_MintReceipt() {
+	msg := types.NewMsgStake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 10_000_000)))
+	msg.MintReceipt = true
+
+	handler := farming.NewHandler(suite.keeper)
+	_, err := handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	receipt := suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[0], types.ReceiptDenom(denom1))
+	suite.Require().True(receipt.Amount.Equal(sdk.NewInt(10_000_000)))
+
+	// The receipt-backed staking follows the receipts.
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, suite.addrs[0], suite.addrs[1], sdk.NewCoins(receipt))
+	suite.Require().NoError(err)
+	_, err = handler(suite.ctx, types.NewMsgSyncReceiptStaking(suite.addrs[0], []string{denom1}))
+	suite.Require().NoError(err)
+	_, err = handler(suite.ctx, types.NewMsgSyncReceiptStaking(suite.addrs[1], []string{denom1}))
+	suite.Require().NoError(err)
+
+	_, found := suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().False(found)
+	staking, found := suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().True(staking.Amount.Equal(sdk.NewInt(10_000_000)))
+}
+
 func (suite *ModuleTestSuite) TestMsgUnstake() {
 	stakeCoin := sdk.NewInt64Coin(denom1, 10_000_000)
 	suite.Stake(suite.addrs[0], sdk.NewCoins(stakeCoin))
@@ -110,18 +209,68 @@ func (suite *ModuleTestSuite) TestMsgUnstake() {
 	suite.Require().True(coinsEq(balancesBefore.Add(unstakeCoin), balancesAfter))
 }
 
+func (suite *ModuleTestSuite) TestMsgCancelQueuedStaking() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 10_000_000)))
+	suite.ProcessQueuedStakings()
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 5_000_000)))
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+
+	handler := farming.NewHandler(suite.keeper)
+
+	// Staked coins can't be cancelled.
+	_, err := handler(suite.ctx, types.NewMsgCancelQueuedStaking(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 6_000_000))))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	cancelCoin := sdk.NewInt64Coin(denom1, 5_000_000)
+	_, err = handler(suite.ctx, types.NewMsgCancelQueuedStaking(suite.addrs[0], sdk.NewCoins(cancelCoin)))
+	suite.Require().NoError(err)
+
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(balancesBefore.Add(cancelCoin), balancesAfter))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 10_000_000)),
+		suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+}
+
+func (suite *ModuleTestSuite) TestMsgEmergencyUnstake() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 10_000_000)))
+	suite.ProcessQueuedStakings()
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 5_000_000)))
+
+	handler := farming.NewHandler(suite.keeper)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.PausedOperations = []string{types.OperationStaking}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// Unstaking is rejected while staking is paused.
+	_, err := handler(suite.ctx, types.NewMsgUnstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 5_000_000))))
+	suite.Require().ErrorIs(err, types.ErrOperationPaused)
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+
+	_, err = handler(suite.ctx, types.NewMsgEmergencyUnstake(suite.addrs[0], []string{denom1}))
+	suite.Require().NoError(err)
+
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(balancesBefore.Add(sdk.NewInt64Coin(denom1, 15_000_000)), balancesAfter))
+	suite.Require().True(suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+	suite.Require().True(suite.keeper.GetAllQueuedStakedCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+}
+
 func (suite *ModuleTestSuite) TestMsgHarvest() {
 	for _, plan := range suite.samplePlans {
 		suite.keeper.SetPlan(suite.ctx, plan)
 	}
 
 	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 10_000_000)))
-	suite.keeper.ProcessQueuedCoins(suite.ctx)
+	suite.ProcessQueuedStakings()
 
 	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
 
 	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-05T00:00:00Z"))
-	err := suite.keeper.AllocateRewards(suite.ctx)
+	err := suite.keeper.AllocateRewards(suite.ctx, suite.ctx.BlockTime())
 	suite.Require().NoError(err)
 
 	rewards := suite.Rewards(suite.addrs[0])
@@ -137,3 +286,175 @@ func (suite *ModuleTestSuite) TestMsgHarvest() {
 	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.keeper.GetRewardsReservePoolAcc(suite.ctx)).IsZero())
 	suite.Require().True(suite.Rewards(suite.addrs[0]).IsZero())
 }
+
+func (suite *ModuleTestSuite) TestMsgClaimVestedRewards() {
+	for _, plan := range suite.samplePlans {
+		_ = plan.SetRewardVestingDuration(24 * time.Hour)
+		suite.keeper.SetPlan(suite.ctx, plan)
+	}
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 10_000_000)))
+	suite.ProcessQueuedStakings()
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-05T00:00:00Z"))
+	err := suite.keeper.AllocateRewards(suite.ctx, suite.ctx.BlockTime())
+	suite.Require().NoError(err)
+
+	rewards := suite.Rewards(suite.addrs[0])
+
+	handler := farming.NewHandler(suite.keeper)
+	_, err = handler(suite.ctx, types.NewMsgHarvest(suite.addrs[0], []string{denom2}))
+	suite.Require().NoError(err)
+
+	// All the rewards are vesting, so nothing is sent to the farmer yet.
+	suite.Require().True(coinsEq(balancesBefore, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+
+	msg := types.NewMsgClaimVestedRewards(suite.addrs[0])
+	_, err = handler(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrRewardNotExists)
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-06T00:00:00Z"))
+	_, err = handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(balancesBefore.Add(rewards...), balancesAfter))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, types.VestingRewardsAcc).IsZero())
+}
+
+func (suite *ModuleTestSuite) TestMsgSetRewardWithdrawAddress() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 10_000_000)))
+	suite.ProcessQueuedStakings()
+
+	handler := farming.NewHandler(suite.keeper)
+	_, err := handler(suite.ctx, types.NewMsgSetRewardWithdrawAddress(suite.addrs[0], suite.addrs[1]))
+	suite.Require().NoError(err)
+
+	farmerBalancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	withdrawBalancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-05T00:00:00Z"))
+	err = suite.keeper.AllocateRewards(suite.ctx, suite.ctx.BlockTime())
+	suite.Require().NoError(err)
+
+	rewards := suite.Rewards(suite.addrs[0])
+
+	_, err = handler(suite.ctx, types.NewMsgHarvest(suite.addrs[0], []string{denom2}))
+	suite.Require().NoError(err)
+
+	suite.Require().True(coinsEq(farmerBalancesBefore, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(withdrawBalancesBefore.Add(rewards...), suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])))
+
+	// Module accounts can't be the withdraw address.
+	_, err = handler(suite.ctx, types.NewMsgSetRewardWithdrawAddress(suite.addrs[0], suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (suite *ModuleTestSuite) TestMsgTransferStaking() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 10_000_000)))
+	suite.ProcessQueuedStakings()
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 5_000_000)))
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-05T00:00:00Z"))
+	err := suite.keeper.AllocateRewards(suite.ctx, suite.ctx.BlockTime())
+	suite.Require().NoError(err)
+
+	rewards := suite.Rewards(suite.addrs[0])
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+
+	handler := farming.NewHandler(suite.keeper)
+	_, err = handler(suite.ctx, types.NewMsgTransferStaking(suite.addrs[0], suite.addrs[1], []string{denom2}))
+	suite.Require().NoError(err)
+
+	// The sender's rewards are settled before the transfer.
+	suite.Require().True(coinsEq(balancesBefore.Add(rewards...), suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+
+	_, found := suite.keeper.GetStaking(suite.ctx, denom2, suite.addrs[0])
+	suite.Require().False(found)
+	_, found = suite.keeper.GetQueuedStaking(suite.ctx, denom2, suite.addrs[0])
+	suite.Require().False(found)
+
+	staking, found := suite.keeper.GetStaking(suite.ctx, denom2, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().True(staking.Amount.Equal(sdk.NewInt(10_000_000)))
+	queuedStaking, found := suite.keeper.GetQueuedStaking(suite.ctx, denom2, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().True(queuedStaking.Amount.Equal(sdk.NewInt(5_000_000)))
+
+	// Nothing is left to transfer.
+	_, err = handler(suite.ctx, types.NewMsgTransferStaking(suite.addrs[0], suite.addrs[1], []string{denom2}))
+	suite.Require().ErrorIs(err, types.ErrStakingNotExists)
+}
+
+func (suite *ModuleTestSuite) TestMsgTerminatePrivatePlan() {
+	createMsg := types.NewMsgCreateFixedAmountPlan(
+		"handlerTestPlan3",
+		suite.addrs[0],
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+		types.ParseTime("2021-08-02T00:00:00Z"),
+		types.ParseTime("2021-08-10T00:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 10_000_000)),
+	)
+
+	handler := farming.NewHandler(suite.keeper)
+	_, err := handler(suite.ctx, createMsg)
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgTerminatePrivatePlan(suite.addrs[1], 1)
+	_, err = handler(suite.ctx, msg)
+	suite.Require().Error(err)
+
+	msg = types.NewMsgTerminatePrivatePlan(suite.addrs[0], 1)
+	_, err = handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	plan, found := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().True(plan.GetTerminated())
+}
+
+func (suite *ModuleTestSuite) TestMsgUpdatePrivatePlan() {
+	createMsg := types.NewMsgCreateFixedAmountPlan(
+		"handlerTestPlan4",
+		suite.addrs[0],
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+		types.ParseTime("2021-08-02T00:00:00Z"),
+		types.ParseTime("2021-08-10T00:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 10_000_000)),
+	)
+
+	handler := farming.NewHandler(suite.keeper)
+	_, err := handler(suite.ctx, createMsg)
+	suite.Require().NoError(err)
+
+	newWeights := sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom2, sdk.OneDec()))
+	newEndTime := types.ParseTime("2021-08-20T00:00:00Z")
+
+	msg := types.NewMsgUpdatePrivatePlan(suite.addrs[1], 1, "", newWeights, &newEndTime, nil, sdk.NewDecWithPrec(1, 1), sdk.Dec{}, 0, nil)
+	_, err = handler(suite.ctx, msg)
+	suite.Require().Error(err)
+
+	msg = types.NewMsgUpdatePrivatePlan(suite.addrs[0], 1, "handlerTestPlan5", newWeights, &newEndTime, nil, sdk.NewDecWithPrec(1, 1), sdk.Dec{}, 0, nil)
+	_, err = handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	plan, found := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal("handlerTestPlan5", plan.GetName())
+	suite.Require().Equal(newEndTime, plan.GetEndTime())
+	suite.Require().Equal(newWeights, plan.GetStakingCoinWeights())
+	suite.Require().Equal(sdk.NewDecWithPrec(1, 1), plan.(*types.RatioPlan).EpochRatio)
+}
+
+func (suite *ModuleTestSuite) TestMsgSetAutoCompound() {
+	handler := farming.NewHandler(suite.keeper)
+	_, err := handler(suite.ctx, types.NewMsgSetAutoCompound(suite.addrs[0], true))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.keeper.IsAutoCompound(suite.ctx, suite.addrs[0]))
+
+	_, err = handler(suite.ctx, types.NewMsgSetAutoCompound(suite.addrs[0], false))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.keeper.IsAutoCompound(suite.ctx, suite.addrs[0]))
+}
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// IsAutoCompound returns whether the farmer has enabled auto-compounding of rewards.
+func (k Keeper) IsAutoCompound(ctx sdk.Context, farmerAcc sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoCompoundKey(farmerAcc))
+}
+
+// SetAutoCompound marks the farmer as having auto-compounding enabled.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, farmerAcc sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoCompoundKey(farmerAcc), []byte{})
+}
+
+// DeleteAutoCompound removes the auto-compounding mark of the farmer.
+func (k Keeper) DeleteAutoCompound(ctx sdk.Context, farmerAcc sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoCompoundKey(farmerAcc))
+}
+
+// IterateAutoCompoundFarmers iterates through all farmers who have enabled
+// auto-compounding and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateAutoCompoundFarmers(ctx sdk.Context, cb func(farmerAcc sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoCompoundKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		farmerAcc := types.ParseAutoCompoundKey(iter.Key())
+		if cb(farmerAcc) {
+			break
+		}
+	}
+}
+
+// SetAutoCompoundSetting enables or disables auto-compounding of the farmer's rewards.
+func (k Keeper) SetAutoCompoundSetting(ctx sdk.Context, farmerAcc sdk.AccAddress, enabled bool) {
+	if enabled {
+		k.SetAutoCompound(ctx, farmerAcc)
+	} else {
+		k.DeleteAutoCompound(ctx, farmerAcc)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	})
+}
+
+// StakableDenoms returns the set of staking coin denoms of all active plans.
+func (k Keeper) StakableDenoms(ctx sdk.Context) map[string]bool {
+	denoms := map[string]bool{}
+	k.IterateActivePlans(ctx, func(plan types.PlanI) (stop bool) {
+		if types.IsPlanActiveAt(plan, ctx.BlockTime()) {
+			for _, weight := range plan.GetStakingCoinWeights() {
+				denoms[weight.Denom] = true
+			}
+		}
+		return false
+	})
+	return denoms
+}
+
+// CompoundRewards withdraws all rewards of the farmer and stakes the reward coins
+// that are staking coins of active plans directly, without queueing them.
+// The reward coins must satisfy the staking params to be compounded, as in Stake.
+// The rest of the rewards are sent to the farmer's reward withdraw address.
+// It returns the compounded coins.
+func (k Keeper) CompoundRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) (sdk.Coins, error) {
+	_, withdrawn, err := k.withdrawAllRewards(ctx, farmerAcc)
+	if err != nil {
+		return nil, err
+	}
+
+	stakableDenoms := k.StakableDenoms(ctx)
+	compounded := sdk.NewCoins()
+	remaining := sdk.NewCoins()
+	for _, coin := range withdrawn {
+		if stakableDenoms[coin.Denom] && k.ValidateStakingCoins(ctx, sdk.NewCoins(coin)) == nil {
+			compounded = compounded.Add(coin)
+		} else {
+			remaining = remaining.Add(coin)
+		}
+	}
+
+	if !remaining.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, k.GetRewardsReservePoolAcc(ctx), k.GetRewardWithdrawAddr(ctx, farmerAcc), remaining); err != nil {
+			return nil, err
+		}
+	}
+
+	if compounded.IsZero() {
+		return compounded, nil
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, k.GetRewardsReservePoolAcc(ctx), k.GetStakingReservePoolAcc(ctx), compounded); err != nil {
+		return nil, err
+	}
+
+	for _, coin := range compounded {
+		staking, found := k.GetStaking(ctx, coin.Denom, farmerAcc)
+		if found {
+			// The rewards of the staking have just been withdrawn above, so
+			// its starting epoch already points to the current epoch.
+			staking.Amount = staking.Amount.Add(coin.Amount)
+		} else {
+			staking = types.Staking{
+				Amount:        coin.Amount,
+				StartingEpoch: k.GetCurrentEpoch(ctx, coin.Denom),
+				BoostAmount:   sdk.ZeroInt(),
+			}
+		}
+		k.SetStaking(ctx, coin.Denom, farmerAcc, staking)
+
+		k.IncreaseTotalStakings(ctx, coin.Denom, coin.Amount)
+		k.IncreasePlanTotalStakingsByFarmer(ctx, farmerAcc, coin.Denom, coin.Amount)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCompoundRewards,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyCompoundedCoins, compounded.String()),
+		),
+	})
+
+	k.AfterStake(ctx, farmerAcc, compounded)
+
+	return compounded, nil
+}
+
+// CompoundAllRewards compounds the rewards of all farmers who have enabled auto-compounding.
+func (k Keeper) CompoundAllRewards(ctx sdk.Context) error {
+	var farmerAccs []sdk.AccAddress
+	k.IterateAutoCompoundFarmers(ctx, func(farmerAcc sdk.AccAddress) (stop bool) {
+		farmerAccs = append(farmerAccs, farmerAcc)
+		return false
+	})
+
+	for _, farmerAcc := range farmerAccs {
+		if _, err := k.CompoundRewards(ctx, farmerAcc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/keeper"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+func (suite *KeeperTestSuite) TestAutoCompound() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom1: 1000000, denom3: 500000})
+
+	suite.keeper.SetAutoCompoundSetting(suite.ctx, suite.addrs[0], true)
+	suite.Require().True(suite.keeper.IsAutoCompound(suite.ctx, suite.addrs[0]))
+	suite.Require().False(suite.keeper.IsAutoCompound(suite.ctx, suite.addrs[1]))
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.AdvanceEpoch()
+
+	// Rewards in the staking coin denom are staked directly, and the rest are sent to the farmer.
+	staking, found := suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(2000000), staking.Amount))
+	suite.Require().Equal(suite.keeper.GetCurrentEpoch(suite.ctx, denom1), staking.StartingEpoch)
+	_, found = suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().False(found)
+	totalStakings, _ := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(intEq(sdk.NewInt(2000000), totalStakings.Amount))
+	suite.Require().True(suite.keeper.AllRewards(suite.ctx, suite.addrs[0]).IsZero())
+	suite.Require().True(coinsEq(
+		balancesBefore.Add(sdk.NewInt64Coin(denom3, 500000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+
+	var compoundEvent *sdk.Event
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeCompoundRewards {
+			event := event
+			compoundEvent = &event
+		}
+	}
+	suite.Require().NotNil(compoundEvent)
+	for _, attr := range compoundEvent.Attributes {
+		switch string(attr.Key) {
+		case types.AttributeKeyFarmer:
+			suite.Require().Equal(suite.addrs[0].String(), string(attr.Value))
+		case types.AttributeKeyCompoundedCoins:
+			suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)).String(), string(attr.Value))
+		}
+	}
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	// Compounded coins earn rewards from the next epoch.
+	suite.AdvanceEpoch()
+	staking, _ = suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().True(intEq(sdk.NewInt(3000000), staking.Amount))
+
+	// Compounded coins can be unstaked as usual.
+	err := suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 3000000)))
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().False(found)
+
+	_, broken = keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestAutoCompound_Disabled() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom1: 1000000})
+
+	suite.keeper.SetAutoCompoundSetting(suite.ctx, suite.addrs[0], true)
+	suite.keeper.SetAutoCompoundSetting(suite.ctx, suite.addrs[0], false)
+	suite.Require().False(suite.keeper.IsAutoCompound(suite.ctx, suite.addrs[0]))
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	staking, _ := suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().True(intEq(sdk.NewInt(1000000), staking.Amount))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)),
+		suite.keeper.AllRewards(suite.ctx, suite.addrs[0])))
+}
+
+func (suite *KeeperTestSuite) TestAutoCompound_NewStakingDenom() {
+	// Rewards of the first plan are the staking coins of the second plan.
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom2: 1000000})
+	suite.SetFixedAmountPlan(2, suite.addrs[4], map[string]string{denom2: "1"}, map[string]int64{denom3: 1000000})
+	suite.Require().NoError(suite.keeper.SetRewardWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[5]))
+	suite.keeper.SetAutoCompoundSetting(suite.ctx, suite.addrs[0], true)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	staking, found := suite.keeper.GetStaking(suite.ctx, denom2, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(1000000), staking.Amount))
+	suite.Require().Equal(suite.keeper.GetCurrentEpoch(suite.ctx, denom2), staking.StartingEpoch)
+
+	withdrawBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[5])
+
+	// Rewards which can't be staked are sent to the reward withdraw address.
+	suite.AdvanceEpoch()
+	staking, _ = suite.keeper.GetStaking(suite.ctx, denom2, suite.addrs[0])
+	suite.Require().True(intEq(sdk.NewInt(2000000), staking.Amount))
+	suite.Require().True(coinsEq(
+		withdrawBalances.Add(sdk.NewInt64Coin(denom3, 1000000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[5])))
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestAutoCompound_StakingParams() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom1: 1000000})
+	suite.Require().NoError(suite.keeper.SetRewardWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[5]))
+	suite.keeper.SetAutoCompoundSetting(suite.ctx, suite.addrs[0], true)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MaxTotalStakings = sdk.NewCoins(sdk.NewInt64Coin(denom1, 1500000))
+	suite.keeper.SetParams(suite.ctx, params)
+
+	withdrawBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[5])
+
+	// Rewards exceeding the max total staking are sent to the reward withdraw address instead.
+	suite.AdvanceEpoch()
+	staking, _ := suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().True(intEq(sdk.NewInt(1000000), staking.Amount))
+	suite.Require().True(intEq(sdk.NewInt(1000000), suite.keeper.GetTotalStakingCoins(suite.ctx, denom1)))
+	suite.Require().True(coinsEq(
+		withdrawBalances.Add(sdk.NewInt64Coin(denom1, 1000000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[5])))
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestAutoCompound_Genesis() {
+	suite.keeper.SetAutoCompoundSetting(suite.ctx, suite.addrs[0], true)
+	suite.keeper.SetAutoCompoundSetting(suite.ctx, suite.addrs[1], true)
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genState.AutoCompoundFarmers, 2)
+
+	bz, err := suite.app.AppCodec().MarshalJSON(genState)
+	suite.Require().NoError(err)
+	var genState2 types.GenesisState
+	suite.Require().NoError(suite.app.AppCodec().UnmarshalJSON(bz, &genState2))
+	suite.Require().NoError(types.ValidateGenesis(genState2))
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, genState2)
+	})
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+	suite.Require().True(suite.keeper.IsAutoCompound(suite.ctx, suite.addrs[1]))
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/tendermint/farming/app"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// setupQueuedStakingsBenchmark returns a context in which numFarmers farmers have
+// both staked coins with rewards to be withdrawn and queued coins to be staked.
+func setupQueuedStakingsBenchmark(b *testing.B, numFarmers int) (*simapp.FarmingApp, sdk.Context) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(types.ParseTime("2022-01-01T00:00:00Z"))
+	k := app.FarmingKeeper
+
+	farmingPoolAcc := simapp.AddTestAddrs(app, ctx, 1, sdk.ZeroInt())[0]
+	if err := simapp.FundAccount(app.BankKeeper, ctx, farmingPoolAcc, sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000_000_000))); err != nil {
+		b.Fatal(err)
+	}
+	k.SetPlan(ctx, types.NewFixedAmountPlan(
+		types.NewBasePlan(
+			1, "plan1", types.PlanTypePublic, farmingPoolAcc.String(), farmingPoolAcc.String(),
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+			types.ParseTime("0001-01-01T00:00:00Z"), types.ParseTime("9999-12-31T00:00:00Z"),
+		),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000_000)),
+	))
+
+	farmers := simapp.AddTestAddrs(app, ctx, numFarmers, sdk.ZeroInt())
+	for _, farmer := range farmers {
+		if err := simapp.FundAccount(app.BankKeeper, ctx, farmer, sdk.NewCoins(sdk.NewInt64Coin(denom1, 2_000_000))); err != nil {
+			b.Fatal(err)
+		}
+		if err := k.Stake(ctx, farmer, sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000))); err != nil {
+			b.Fatal(err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if err := k.AdvanceEpoch(ctx); err != nil {
+			b.Fatal(err)
+		}
+		if err := k.ProcessMaturedQueuedStakings(ctx, 0); err != nil {
+			b.Fatal(err)
+		}
+	}
+	for _, farmer := range farmers {
+		if err := k.Stake(ctx, farmer, sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000))); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	return app, ctx
+}
+
+// BenchmarkStartProcessingQueuedStakings measures processing the queued stakings
+// on an epoch advancement, where at most MaxQueuedStakingsPerBlock of them are processed.
+func BenchmarkStartProcessingQueuedStakings(b *testing.B) {
+	for _, numFarmers := range []int{1000, 3000} {
+		b.Run(fmt.Sprintf("farmers=%d", numFarmers), func(b *testing.B) {
+			app, ctx := setupQueuedStakingsBenchmark(b, numFarmers)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				cacheCtx, _ := ctx.CacheContext()
+				if err := app.FarmingKeeper.StartProcessingQueuedStakings(cacheCtx, ctx.BlockTime()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
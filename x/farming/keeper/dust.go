@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// GetRewardsDust returns the fractional rewards left over from truncation
+// which are not swept yet.
+func (k Keeper) GetRewardsDust(ctx sdk.Context) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RewardsDustKey)
+	if bz == nil {
+		return sdk.DecCoins{}
+	}
+	var dust types.RewardsDust
+	k.cdc.MustUnmarshal(bz, &dust)
+	return dust.Amount
+}
+
+// SetRewardsDust sets the rewards dust.
+func (k Keeper) SetRewardsDust(ctx sdk.Context, amount sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	if amount.IsZero() {
+		store.Delete(types.RewardsDustKey)
+		return
+	}
+	bz := k.cdc.MustMarshal(&types.RewardsDust{Amount: amount})
+	store.Set(types.RewardsDustKey, bz)
+}
+
+// AddRewardsDust adds the fractional part of the rewards which is left over
+// after paying out the coins to the rewards dust.
+func (k Keeper) AddRewardsDust(ctx sdk.Context, rewards sdk.DecCoins, paid sdk.Coins) {
+	dust := sdk.DecCoins{}
+	for _, reward := range rewards {
+		// Vested rewards are truncated per vesting duration, so the paid coins
+		// can exceed the rewards by the smallest unit. Such denoms are ignored.
+		if amt := reward.Amount.Sub(paid.AmountOf(reward.Denom).ToDec()); amt.IsPositive() {
+			dust = dust.Add(sdk.NewDecCoinFromDec(reward.Denom, amt))
+		}
+	}
+	if dust.IsZero() {
+		return
+	}
+	k.SetRewardsDust(ctx, k.GetRewardsDust(ctx).Add(dust...))
+}
+
+// SweepRewardsDust sends the integral part of the rewards dust from the rewards
+// reserve pool to the farming fee collector, keeping the fractional part.
+// Only the coins not reserved for the outstanding rewards are swept.
+func (k Keeper) SweepRewardsDust(ctx sdk.Context) error {
+	dust := k.GetRewardsDust(ctx)
+	swept, change := dust.TruncateDecimal()
+	if swept.IsZero() {
+		return nil
+	}
+
+	totalOutstandingRewards := sdk.DecCoins{}
+	k.IterateOutstandingRewards(ctx, func(stakingCoinDenom string, rewards types.OutstandingRewards) (stop bool) {
+		totalOutstandingRewards = totalOutstandingRewards.Add(rewards.Rewards...)
+		return false
+	})
+	rewardsReserveAcc := k.GetRewardsReservePoolAcc(ctx)
+	for _, coin := range swept {
+		reserved := totalOutstandingRewards.AmountOf(coin.Denom).Add(change.AmountOf(coin.Denom)).Ceil().TruncateInt()
+		spendable := k.bankKeeper.GetBalance(ctx, rewardsReserveAcc, coin.Denom).Amount.Sub(reserved)
+		if coin.Amount.GT(spendable) {
+			// Keep the dust which cannot be swept without touching the outstanding rewards.
+			swept = swept.Sub(sdk.NewCoins(coin))
+			change = change.Add(sdk.NewDecCoinFromCoin(coin))
+		}
+	}
+	if swept.IsZero() {
+		return nil
+	}
+
+	feeCollectorAcc, err := sdk.AccAddressFromBech32(k.GetParams(ctx).FarmingFeeCollector)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, rewardsReserveAcc, feeCollectorAcc, swept); err != nil {
+		return err
+	}
+	k.SetRewardsDust(ctx, change)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSweepRewardsDust,
+			sdk.NewAttribute(types.AttributeKeyAmount, swept.String()),
+		),
+	})
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/keeper"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+func (suite *KeeperTestSuite) TestRewardsDust() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000})
+
+	// Three farmers stake same amount of coins, so the rewards cannot be divided evenly.
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Stake(suite.addrs[2], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	// The remainder of the truncated unit rewards is the dust.
+	suite.Require().True(decCoinsEq(
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.MustNewDecFromStr("0.000000000001"))),
+		suite.keeper.GetRewardsDust(suite.ctx)))
+
+	feeCollectorAcc, _ := sdk.AccAddressFromBech32(suite.keeper.GetParams(suite.ctx).FarmingFeeCollector)
+	feeCollectorBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollectorAcc)
+
+	// The dust less than a coin is not swept.
+	suite.Require().NoError(suite.keeper.SweepRewardsDust(suite.ctx))
+	suite.Require().True(coinsEq(feeCollectorBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollectorAcc)))
+
+	// Each farmer leaves 0.333...denom3 behind.
+	for _, farmerAcc := range suite.addrs[:3] {
+		suite.Harvest(farmerAcc, []string{denom1})
+	}
+	suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 1)), suite.keeper.GetRewardsDust(suite.ctx)))
+
+	// The integral part of the dust is swept to the farming fee collector.
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(
+		feeCollectorBalances.Add(sdk.NewInt64Coin(denom3, 1)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollectorAcc)))
+	dust := suite.keeper.GetRewardsDust(suite.ctx)
+	suite.Require().True(dust.AmountOf(denom3).LT(sdk.OneDec()))
+	suite.Require().True(dust.AmountOf(denom3).IsPositive())
+
+	suite.Require().NoError(suite.keeper.ValidateOutstandingRewards(suite.ctx))
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	// The rewards of the farmers are not affected by the sweep.
+	for _, farmerAcc := range suite.addrs[:3] {
+		suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 333)), suite.keeper.AllRewards(suite.ctx, farmerAcc)))
+	}
+}
+
+func (suite *KeeperTestSuite) TestSweepRewardsDust_OutstandingRewards() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000})
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	// The dust cannot be swept if it would be paid out of the outstanding rewards.
+	suite.keeper.SetRewardsDust(suite.ctx, sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.NewDecWithPrec(15, 1))))
+	err := suite.keeper.SweepRewardsDust(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(decCoinsEq(
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.NewDecWithPrec(15, 1))),
+		suite.keeper.GetRewardsDust(suite.ctx)))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000)), suite.keeper.AllRewards(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, types.RewardsReserveAcc)))
+}
+
+func (suite *KeeperTestSuite) TestRewardsDust_ReserveBalance() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000})
+	suite.SetFixedAmountPlan(2, suite.addrs[4], map[string]string{denom1: "0.3", denom2: "0.7"}, map[string]int64{denom3: 777})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000001), sdk.NewInt64Coin(denom2, 333333)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 2000003)))
+	suite.Stake(suite.addrs[2], sdk.NewCoins(sdk.NewInt64Coin(denom2, 700007)))
+
+	// The rewards reserve pool holds exactly the outstanding rewards and the dust,
+	// across allocations, withdrawals and sweeps.
+	for i := 0; i < 10; i++ {
+		suite.AdvanceEpoch()
+		if i%3 == 2 {
+			suite.Harvest(suite.addrs[i%2], []string{denom1})
+		}
+
+		total := suite.keeper.GetRewardsDust(suite.ctx)
+		suite.keeper.IterateOutstandingRewards(suite.ctx, func(_ string, rewards types.OutstandingRewards) (stop bool) {
+			total = total.Add(rewards.Rewards...)
+			return false
+		})
+		suite.Require().True(decCoinsEq(
+			sdk.NewDecCoinsFromCoins(suite.app.BankKeeper.GetAllBalances(suite.ctx, types.RewardsReserveAcc)...),
+			total))
+	}
+	suite.Require().True(suite.keeper.GetRewardsDust(suite.ctx).IsAllPositive())
+
+	// No outstanding rewards are left behind once all the rewards are withdrawn.
+	for _, farmerAcc := range suite.addrs[:3] {
+		_, err := suite.keeper.WithdrawAllRewards(suite.ctx, farmerAcc)
+		suite.Require().NoError(err)
+	}
+	for _, denom := range []string{denom1, denom2} {
+		_, found := suite.keeper.GetOutstandingRewards(suite.ctx, denom)
+		suite.Require().False(found)
+	}
+}
@@ -35,37 +35,158 @@ func (k Keeper) SetLastEpochTime(ctx sdk.Context, t time.Time) {
 	store.Set(types.LastEpochTimeKey, bz)
 }
 
+// MaxEpochsPerBlock is the maximum number of epochs advanced in a block.
+// The rest of the elapsed epochs are advanced in the following blocks.
+const MaxEpochsPerBlock = 10
+
+// AdvanceElapsedEpochs advances the epochs which have elapsed since the last epoch time,
+// up to MaxEpochsPerBlock epochs. When the chain has been halted for several epochs,
+// each of them is advanced separately, so that the plans allocate rewards for every epoch.
+func (k Keeper) AdvanceElapsedEpochs(ctx sdk.Context) error {
+	lastEpochTime, found := k.GetLastEpochTime(ctx)
+	if !found {
+		k.SetLastEpochTime(ctx, ctx.BlockTime())
+		return nil
+	}
+
+	for i := 0; i < MaxEpochsPerBlock; i++ {
+		// The queued coins matured by the last epoch advancement must all be staked before
+		// the rewards are allocated, so the epoch is advanced after they are processed
+		// in the following blocks.
+		if _, found := k.GetQueuedStakingsCursor(ctx); found {
+			break
+		}
+
+		// CurrentEpochDuration is intialized with the epoch duration of the params in genesis and
+		// it is used here to prevent from affecting the epoch duration for farming rewards allocation.
+		// Suppose NextEpochDays is 7 days and it is proposed to change the value to 1 day through governance proposal.
+		// Although the proposal is passed, farming rewards allocation should continue to proceed with 7 days and then it gets updated.
+		currentEpochDuration := k.GetCurrentEpochDuration(ctx)
+		epochEndTime := lastEpochTime.Add(currentEpochDuration)
+		if ctx.BlockTime().Before(epochEndTime) {
+			break
+		}
+
+		nextEpochDuration := k.GetParams(ctx).EpochDuration()
+		// The last epoch time is usually the block time. But if the following epoch
+		// has ended as well, the epoch ends at its own end time instead, so that the
+		// following epoch can be advanced separately.
+		epochTime := ctx.BlockTime()
+		if !ctx.BlockTime().Before(epochEndTime.Add(nextEpochDuration)) {
+			epochTime = epochEndTime
+		}
+
+		if err := k.advanceEpoch(ctx, epochTime); err != nil {
+			return err
+		}
+		if nextEpochDuration != currentEpochDuration {
+			k.SetCurrentEpochDuration(ctx, nextEpochDuration)
+		}
+		lastEpochTime = epochTime
+	}
+
+	return nil
+}
+
+// AdvanceEpoch advances the epoch by one, with the block time as the last epoch time.
+// Since it doesn't wait for the matured queued coins to be processed across blocks,
+// all of them are staked at once before the epoch is advanced.
 func (k Keeper) AdvanceEpoch(ctx sdk.Context) error {
-	if err := k.AllocateRewards(ctx); err != nil {
+	if err := k.ProcessMaturedQueuedStakings(ctx, 0); err != nil {
 		return err
 	}
-	k.ProcessQueuedCoins(ctx)
-	k.SetLastEpochTime(ctx, ctx.BlockTime())
+	return k.advanceEpoch(ctx, ctx.BlockTime())
+}
+
+func (k Keeper) advanceEpoch(ctx sdk.Context, epochTime time.Time) error {
+	k.BeforeAdvanceEpoch(ctx)
+
+	if err := k.AllocateRewards(ctx, epochTime); err != nil {
+		return err
+	}
+	if err := k.SyncAllReceiptStakings(ctx); err != nil {
+		return err
+	}
+	// Auto-compounding withdraws rewards, so it is skipped while harvesting is paused.
+	if !k.IsOperationPaused(ctx, types.OperationHarvesting) {
+		if err := k.CompoundAllRewards(ctx); err != nil {
+			return err
+		}
+	}
+	if err := k.StartProcessingQueuedStakings(ctx, epochTime); err != nil {
+		return err
+	}
+	if err := k.ProcessExpiredLockedStakings(ctx, epochTime); err != nil {
+		return err
+	}
+	if err := k.SweepRewardsDust(ctx); err != nil {
+		return err
+	}
+	k.SetLastEpochTime(ctx, epochTime)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAdvanceEpoch,
+			sdk.NewAttribute(types.AttributeKeyEpochTime, epochTime.String()),
+		),
+	})
+
+	k.AfterAdvanceEpoch(ctx)
 
 	return nil
 }
 
-// GetCurrentEpochDays returns the  current epoch days.
-func (k Keeper) GetCurrentEpochDays(ctx sdk.Context) uint32 {
-	var epochDays uint32
+// GetNextEpochTime returns the time from which the current epoch is advanced
+// in the end blocker. It returns false if the last epoch time is not set yet.
+func (k Keeper) GetNextEpochTime(ctx sdk.Context) (time.Time, bool) {
+	lastEpochTime, found := k.GetLastEpochTime(ctx)
+	if !found {
+		return time.Time{}, false
+	}
+	return lastEpochTime.Add(k.GetCurrentEpochDuration(ctx)), true
+}
+
+// GetCurrentEpochDuration returns the current epoch duration.
+func (k Keeper) GetCurrentEpochDuration(ctx sdk.Context) time.Duration {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.CurrentEpochDaysKey)
+	bz := store.Get(types.CurrentEpochDurationKey)
 	if bz == nil {
-		// initialize with next epoch days
-		epochDays = k.GetParams(ctx).NextEpochDays
-	} else {
-		val := gogotypes.UInt32Value{}
-		if err := k.cdc.Unmarshal(bz, &val); err != nil {
-			panic(err)
-		}
-		epochDays = val.GetValue()
+		// initialize with the next epoch duration
+		return k.GetParams(ctx).EpochDuration()
+	}
+	val := gogotypes.Duration{}
+	if err := k.cdc.Unmarshal(bz, &val); err != nil {
+		panic(err)
+	}
+	epochDuration, err := gogotypes.DurationFromProto(&val)
+	if err != nil {
+		panic(err)
+	}
+	return epochDuration
+}
+
+// SetCurrentEpochDuration sets the current epoch duration.
+func (k Keeper) SetCurrentEpochDuration(ctx sdk.Context, epochDuration time.Duration) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(gogotypes.DurationProto(epochDuration))
+	store.Set(types.CurrentEpochDurationKey, bz)
+}
+
+// GetEpochNumber returns the number of epochs advanced so far.
+func (k Keeper) GetEpochNumber(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EpochNumberKey)
+	if bz == nil {
+		return 0
 	}
-	return epochDays
+	var val gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
 }
 
-// SetCurrentEpochDays sets the  current epoch days.
-func (k Keeper) SetCurrentEpochDays(ctx sdk.Context, epochDays uint32) {
+// SetEpochNumber sets the number of epochs advanced so far.
+func (k Keeper) SetEpochNumber(ctx sdk.Context, epochNumber uint64) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&gogotypes.UInt32Value{Value: epochDays})
-	store.Set(types.CurrentEpochDaysKey, bz)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: epochNumber})
+	store.Set(types.EpochNumberKey, bz)
 }
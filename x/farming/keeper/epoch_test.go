@@ -23,10 +23,8 @@ func (suite *KeeperTestSuite) TestLastEpochTime() {
 }
 
 func (suite *KeeperTestSuite) TestFirstEpoch() {
-	// The first epoch may run very quickly depending on when
-	// the farming module is deployed,
-	// meaning that (block time) - (last epoch time) may be smaller
-	// than epoch_days parameter on the first epoch.
+	// The first epoch lasts for the whole epoch duration
+	// regardless of when the farming module is deployed.
 
 	params := suite.keeper.GetParams(suite.ctx)
 	suite.Require().Equal(uint32(1), params.NextEpochDays)
@@ -39,6 +37,11 @@ func (suite *KeeperTestSuite) TestFirstEpoch() {
 	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-12T00:00:00Z"))
 	farming.EndBlocker(suite.ctx, suite.keeper)
 	t, _ := suite.keeper.GetLastEpochTime(suite.ctx)
+	suite.Require().Equal(lastEpochTime, t) // Indicating that the epoch didn't advance.
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-12T23:59:59Z"))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	t, _ = suite.keeper.GetLastEpochTime(suite.ctx)
 	suite.Require().True(t.After(lastEpochTime)) // Indicating that the epoch advanced.
 }
 
@@ -56,7 +59,7 @@ func (suite *KeeperTestSuite) TestEpochDays() {
 			farming.EndBlocker(suite.ctx, suite.keeper)
 
 			lastEpochTime, _ := suite.keeper.GetLastEpochTime(suite.ctx)
-			currentEpochDays := suite.keeper.GetCurrentEpochDays(suite.ctx)
+			currentEpochDuration := suite.keeper.GetCurrentEpochDuration(suite.ctx)
 
 			for i := 0; i < 10000; i++ {
 				t = t.Add(5 * time.Minute)
@@ -65,7 +68,7 @@ func (suite *KeeperTestSuite) TestEpochDays() {
 
 				t2, _ := suite.keeper.GetLastEpochTime(suite.ctx)
 				if t2.After(lastEpochTime) {
-					suite.Require().GreaterOrEqual(t2.Sub(lastEpochTime).Hours(), float64(currentEpochDays*24))
+					suite.Require().GreaterOrEqual(t2.Sub(lastEpochTime), currentEpochDuration)
 					lastEpochTime = t2
 				}
 			}
@@ -86,13 +89,12 @@ func (suite *KeeperTestSuite) TestDelayedBlockTime() {
 	suite.Require().Equal(t, lastEpochTime)
 }
 
-func (suite *KeeperTestSuite) TestCurrentEpochDays() {
-	currentEpochDays := suite.keeper.GetCurrentEpochDays(suite.ctx)
-	suite.Require().Equal(uint32(1), currentEpochDays)
+func (suite *KeeperTestSuite) TestCurrentEpochDuration() {
+	currentEpochDuration := suite.keeper.GetCurrentEpochDuration(suite.ctx)
+	suite.Require().Equal(24*time.Hour, currentEpochDuration)
 
-	nextEpochDays := uint32(3)
-	suite.keeper.SetCurrentEpochDays(suite.ctx, nextEpochDays)
+	suite.keeper.SetCurrentEpochDuration(suite.ctx, 3*time.Hour)
 
-	currentEpochDays = suite.keeper.GetCurrentEpochDays(suite.ctx)
-	suite.Require().Equal(uint32(3), currentEpochDays)
+	currentEpochDuration = suite.keeper.GetCurrentEpochDuration(suite.ctx)
+	suite.Require().Equal(3*time.Hour, currentEpochDuration)
 }
@@ -18,8 +18,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	ctx, writeCache := ctx.CacheContext()
 
 	k.SetParams(ctx, genState.Params)
-	// TODO: what if CurrentEpochDays field was empty?
-	k.SetCurrentEpochDays(ctx, genState.CurrentEpochDays)
+	k.SetCurrentEpochDuration(ctx, genState.EpochDuration())
 	moduleAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	k.accountKeeper.SetModuleAccount(ctx, moduleAcc)
 
@@ -41,22 +40,59 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
-		k.SetStaking(ctx, record.StakingCoinDenom, farmerAcc, record.Staking)
+		// The reference counts of the historical rewards are imported as they are.
+		k.setStaking(ctx, record.StakingCoinDenom, farmerAcc, record.Staking)
 
 		amt, ok := totalStakings[record.StakingCoinDenom]
 		if !ok {
 			amt = sdk.ZeroInt()
 		}
-		amt = amt.Add(record.Staking.Amount)
+		amt = amt.Add(record.Staking.BoostedAmount())
 		totalStakings[record.StakingCoinDenom] = amt
 	}
 
+	k.SetEpochNumber(ctx, genState.EpochNumber)
+	maturedQueuedStakingFound := false
 	for _, record := range genState.QueuedStakingRecords {
 		farmerAcc, err := sdk.AccAddressFromBech32(record.Farmer)
 		if err != nil {
 			panic(err)
 		}
 		k.SetQueuedStaking(ctx, record.StakingCoinDenom, farmerAcc, record.QueuedStaking)
+		if record.QueuedStaking.IsMaturedAt(genState.EpochNumber) {
+			maturedQueuedStakingFound = true
+		}
+	}
+
+	for _, record := range genState.LockedStakingRecords {
+		farmerAcc, err := sdk.AccAddressFromBech32(record.Farmer)
+		if err != nil {
+			panic(err)
+		}
+		k.SetLockedStaking(ctx, record.StakingCoinDenom, farmerAcc, record.LockedStaking)
+	}
+
+	type planDenom struct {
+		planId           uint64
+		stakingCoinDenom string
+	}
+	planTotalStakings := map[planDenom]sdk.Int{}
+
+	for _, record := range genState.PlanFarmerRecords {
+		farmerAcc, err := sdk.AccAddressFromBech32(record.Farmer)
+		if err != nil {
+			panic(err)
+		}
+		k.SetPlanFarmer(ctx, record.PlanId, farmerAcc)
+		k.IterateStakingsByFarmer(ctx, farmerAcc, func(stakingCoinDenom string, staking types.Staking) (stop bool) {
+			key := planDenom{record.PlanId, stakingCoinDenom}
+			amt, ok := planTotalStakings[key]
+			if !ok {
+				amt = sdk.ZeroInt()
+			}
+			planTotalStakings[key] = amt.Add(staking.BoostedAmount())
+			return false
+		})
 	}
 
 	for _, record := range genState.HistoricalRewardsRecords {
@@ -71,14 +107,81 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		k.SetCurrentEpoch(ctx, record.StakingCoinDenom, record.CurrentEpoch)
 	}
 
+	for _, record := range genState.RewardVestingRecords {
+		farmerAcc, err := sdk.AccAddressFromBech32(record.Farmer)
+		if err != nil {
+			panic(err)
+		}
+		k.SetRewardVesting(ctx, farmerAcc, record.RewardVesting)
+	}
+
+	for _, ubd := range genState.UnbondingStakings {
+		farmerAcc, err := sdk.AccAddressFromBech32(ubd.Farmer)
+		if err != nil {
+			panic(err)
+		}
+		k.SetUnbondingStaking(ctx, ubd)
+		for _, entry := range ubd.Entries {
+			k.InsertUnbondingQueue(ctx, entry.CompletionTime, farmerAcc)
+		}
+	}
+
+	for _, record := range genState.RewardWithdrawAddressRecords {
+		farmerAcc, err := sdk.AccAddressFromBech32(record.Farmer)
+		if err != nil {
+			panic(err)
+		}
+		withdrawAcc, err := sdk.AccAddressFromBech32(record.WithdrawAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetRewardWithdrawAddr(ctx, farmerAcc, withdrawAcc)
+	}
+
+	for _, record := range genState.ReceiptStakingRecords {
+		farmerAcc, err := sdk.AccAddressFromBech32(record.Farmer)
+		if err != nil {
+			panic(err)
+		}
+		k.SetReceiptStaking(ctx, record.StakingCoinDenom, farmerAcc, record.Amount)
+	}
+
+	for _, record := range genState.UnclaimedReceiptStakingRecords {
+		k.SetUnclaimedReceiptStaking(ctx, record.StakingCoinDenom, record.Amount)
+	}
+
+	for _, farmer := range genState.AutoCompoundFarmers {
+		farmerAcc, err := sdk.AccAddressFromBech32(farmer)
+		if err != nil {
+			panic(err)
+		}
+		k.SetAutoCompound(ctx, farmerAcc)
+	}
+
+	k.SetRewardsDust(ctx, genState.RewardsDust)
+
 	if genState.LastEpochTime != nil {
 		k.SetLastEpochTime(ctx, *genState.LastEpochTime)
 	}
 
+	// The matured queued coins which were not processed yet on export are
+	// processed again from the first queued staking.
+	if maturedQueuedStakingFound {
+		cursor := types.QueuedStakingsCursor{NextKey: types.QueuedStakingKeyPrefix}
+		if genState.LastEpochTime != nil {
+			cursor.EpochTime = *genState.LastEpochTime
+		}
+		k.SetQueuedStakingsCursor(ctx, cursor)
+	}
+
 	for stakingCoinDenom, amt := range totalStakings {
 		k.SetTotalStakings(ctx, stakingCoinDenom, types.TotalStakings{Amount: amt})
 	}
 
+	for key, amt := range planTotalStakings {
+		k.SetPlanTotalStakings(ctx, key.planId, key.stakingCoinDenom, types.TotalStakings{Amount: amt})
+	}
+
 	err := k.ValidateRemainingRewardsAmount(ctx)
 	if err != nil {
 		panic(err)
@@ -103,6 +206,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		panic(err)
 	}
 
+	if err := k.ValidateReceiptSupply(ctx); err != nil {
+		panic(err)
+	}
+
+	if err := k.ValidateHistoricalRewardsReferenceCount(ctx); err != nil {
+		panic(err)
+	}
+
+	err = k.ValidateVestingRewardsAmount(ctx)
+	if err != nil {
+		panic(err)
+	}
+	vestingRewardsCoins := k.bankKeeper.GetAllBalances(ctx, types.VestingRewardsAcc)
+	if !genState.VestingRewardsCoins.IsEqual(vestingRewardsCoins) {
+		panic(fmt.Sprintf("VestingRewardsCoins differs from the actual value; have %s, expected %s",
+			vestingRewardsCoins, genState.VestingRewardsCoins))
+	}
+
 	writeCache()
 }
 
@@ -142,6 +263,25 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
+	lockedStakings := []types.LockedStakingRecord{}
+	k.IterateLockedStakings(ctx, func(stakingCoinDenom string, farmerAcc sdk.AccAddress, lock types.LockedStaking) (stop bool) {
+		lockedStakings = append(lockedStakings, types.LockedStakingRecord{
+			StakingCoinDenom: stakingCoinDenom,
+			Farmer:           farmerAcc.String(),
+			LockedStaking:    lock,
+		})
+		return false
+	})
+
+	planFarmers := []types.PlanFarmerRecord{}
+	k.IteratePlanFarmers(ctx, func(planId uint64, farmerAcc sdk.AccAddress) (stop bool) {
+		planFarmers = append(planFarmers, types.PlanFarmerRecord{
+			PlanId: planId,
+			Farmer: farmerAcc.String(),
+		})
+		return false
+	})
+
 	historicalRewards := []types.HistoricalRewardsRecord{}
 	k.IterateHistoricalRewards(ctx, func(stakingCoinDenom string, epoch uint64, rewards types.HistoricalRewards) (stop bool) {
 		historicalRewards = append(historicalRewards, types.HistoricalRewardsRecord{
@@ -170,6 +310,55 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
+	rewardVestings := []types.RewardVestingRecord{}
+	k.IterateRewardVestings(ctx, func(farmerAcc sdk.AccAddress, vesting types.RewardVesting) (stop bool) {
+		rewardVestings = append(rewardVestings, types.RewardVestingRecord{
+			Farmer:        farmerAcc.String(),
+			RewardVesting: vesting,
+		})
+		return false
+	})
+
+	unbondingStakings := []types.UnbondingStaking{}
+	k.IterateUnbondingStakings(ctx, func(ubd types.UnbondingStaking) (stop bool) {
+		unbondingStakings = append(unbondingStakings, ubd)
+		return false
+	})
+
+	rewardWithdrawAddrs := []types.RewardWithdrawAddressRecord{}
+	k.IterateRewardWithdrawAddrs(ctx, func(farmerAcc, withdrawAcc sdk.AccAddress) (stop bool) {
+		rewardWithdrawAddrs = append(rewardWithdrawAddrs, types.RewardWithdrawAddressRecord{
+			Farmer:          farmerAcc.String(),
+			WithdrawAddress: withdrawAcc.String(),
+		})
+		return false
+	})
+
+	receiptStakings := []types.ReceiptStakingRecord{}
+	k.IterateReceiptStakings(ctx, func(stakingCoinDenom string, farmerAcc sdk.AccAddress, amt sdk.Int) (stop bool) {
+		receiptStakings = append(receiptStakings, types.ReceiptStakingRecord{
+			StakingCoinDenom: stakingCoinDenom,
+			Farmer:           farmerAcc.String(),
+			Amount:           amt,
+		})
+		return false
+	})
+
+	unclaimedReceiptStakings := []types.UnclaimedReceiptStakingRecord{}
+	k.IterateUnclaimedReceiptStakings(ctx, func(stakingCoinDenom string, amt sdk.Int) (stop bool) {
+		unclaimedReceiptStakings = append(unclaimedReceiptStakings, types.UnclaimedReceiptStakingRecord{
+			StakingCoinDenom: stakingCoinDenom,
+			Amount:           amt,
+		})
+		return false
+	})
+
+	autoCompoundFarmers := []string{}
+	k.IterateAutoCompoundFarmers(ctx, func(farmerAcc sdk.AccAddress) (stop bool) {
+		autoCompoundFarmers = append(autoCompoundFarmers, farmerAcc.String())
+		return false
+	})
+
 	var epochTime *time.Time
 	tempEpochTime, found := k.GetLastEpochTime(ctx)
 	if found {
@@ -187,6 +376,17 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.bankKeeper.GetAllBalances(ctx, types.StakingReserveAcc),
 		k.bankKeeper.GetAllBalances(ctx, types.RewardsReserveAcc),
 		epochTime,
-		k.GetCurrentEpochDays(ctx),
+		k.GetCurrentEpochDuration(ctx),
+		rewardVestings,
+		k.bankKeeper.GetAllBalances(ctx, types.VestingRewardsAcc),
+		lockedStakings,
+		planFarmers,
+		unbondingStakings,
+		rewardWithdrawAddrs,
+		receiptStakings,
+		unclaimedReceiptStakings,
+		autoCompoundFarmers,
+		k.GetRewardsDust(ctx),
+		k.GetEpochNumber(ctx),
 	)
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -52,7 +54,7 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Stake(suite.addrs[1], sdk.NewCoins(
 		sdk.NewInt64Coin(denom1, 1_000_000),
 		sdk.NewInt64Coin(denom2, 1_000_000)))
-	suite.keeper.ProcessQueuedCoins(suite.ctx)
+	suite.processQueuedStakings()
 
 	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-07-31T00:00:00Z"))
 
@@ -130,6 +132,13 @@ func (suite *KeeperTestSuite) TestInitGenesisPanics() {
 			},
 			true,
 		},
+		{
+			"invalid historical rewards reference count",
+			func(genState *types.GenesisState) {
+				genState.HistoricalRewardsRecords[0].HistoricalRewards.ReferenceCount++
+			},
+			true,
+		},
 		{
 			"invalid reward pool coins",
 			func(genState *types.GenesisState) {
@@ -152,6 +161,13 @@ func (suite *KeeperTestSuite) TestInitGenesisPanics() {
 			},
 			true,
 		},
+		{
+			"invalid rewards dust",
+			func(genState *types.GenesisState) {
+				genState.RewardsDust = sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 1000000))
+			},
+			true,
+		},
 	} {
 		suite.Run(tc.name, func() {
 			genState := suite.keeper.ExportGenesis(cacheCtx)
@@ -170,6 +186,40 @@ func (suite *KeeperTestSuite) TestInitGenesisPanics() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestInitGenesisDecayingPlan() {
+	farmingPoolAcc := suite.addrs[4]
+	suite.SetDecayingPlan(1, farmingPoolAcc, map[string]string{denom1: "1.0"}, map[string]int64{denom3: 1000000}, "0.5", 2)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	var genState *types.GenesisState
+	suite.Require().NotPanics(func() {
+		genState = suite.keeper.ExportGenesis(suite.ctx)
+	})
+
+	bz, err := suite.app.AppCodec().MarshalJSON(genState)
+	suite.Require().NoError(err)
+	var genState2 types.GenesisState
+	err = suite.app.AppCodec().UnmarshalJSON(bz, &genState2)
+	suite.Require().NoError(err)
+	suite.Require().NoError(types.ValidateGenesis(genState2))
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, genState2)
+	})
+
+	plan, found := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().True(found)
+	decayingPlan, ok := plan.(*types.DecayingPlan)
+	suite.Require().True(ok)
+	suite.Require().Equal(uint64(2), decayingPlan.AllocatedEpochs)
+	suite.Require().True(sdk.NewDecWithPrec(5, 1).Equal(decayingPlan.DecayRate))
+	suite.Require().Equal(uint32(2), decayingPlan.DecayEpochs)
+}
+
 func (suite *KeeperTestSuite) TestMarshalUnmarshalDefaultGenesis() {
 	genState := suite.keeper.ExportGenesis(suite.ctx)
 	bz, err := suite.app.AppCodec().MarshalJSON(genState)
@@ -188,13 +238,37 @@ func (suite *KeeperTestSuite) TestMarshalUnmarshalDefaultGenesis() {
 	suite.Require().Equal(genState2, *genState3)
 }
 
+func (suite *KeeperTestSuite) TestInitGenesisMaturedQueuedStakings() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)))
+	suite.startQueuedStakingsPass()
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)))
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Equal(uint64(1), genState.EpochNumber)
+
+	suite.keeper.SetEpochNumber(suite.ctx, 0)
+	suite.keeper.DeleteQueuedStakingsCursor(suite.ctx)
+	suite.keeper.InitGenesis(suite.ctx, *genState)
+	suite.Require().Equal(uint64(1), suite.keeper.GetEpochNumber(suite.ctx))
+
+	// The matured queued coins left unprocessed on export are processed after import.
+	_, found := suite.keeper.GetQueuedStakingsCursor(suite.ctx)
+	suite.Require().True(found)
+	err := suite.keeper.ProcessMaturedQueuedStakings(suite.ctx, 0)
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)), suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)), suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[1])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)), suite.keeper.GetAllQueuedStakedCoinsByFarmer(suite.ctx, suite.addrs[1])))
+}
+
 func (suite *KeeperTestSuite) TestExportGenesis() {
 	for i := range suite.sampleFixedAmtPlans {
 		plan := suite.sampleFixedAmtPlans[len(suite.sampleFixedAmtPlans)-i-1]
 		suite.keeper.SetPlan(suite.ctx, plan)
 	}
 
-	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-04T23:00:00Z"))
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-04T00:00:00Z"))
 	farming.EndBlocker(suite.ctx, suite.keeper)
 	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000), sdk.NewInt64Coin(denom2, 800000)))
 	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000), sdk.NewInt64Coin(denom2, 700000)))
@@ -304,11 +378,11 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 					switch record.StakingCoinDenom {
 					case denom1:
 						suite.Require().True(decCoinsEq(
-							sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 2300000)),
+							sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.MustNewDecFromStr("2299999.9999999999995"))),
 							record.OutstandingRewards.Rewards))
 					case denom2:
 						suite.Require().True(decCoinsEq(
-							sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 700000)),
+							sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.MustNewDecFromStr("699999.999999999999"))),
 							record.OutstandingRewards.Rewards))
 					}
 				}
@@ -350,6 +424,7 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 			"CurrentEpochDays",
 			func() {
 				suite.Require().Equal(uint32(1), genState.CurrentEpochDays)
+				suite.Require().Equal(24*time.Hour, genState.CurrentEpochDuration)
 			},
 		},
 	} {
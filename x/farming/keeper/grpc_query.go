@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 	"strconv"
+	"time"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -25,9 +27,7 @@ var _ types.QueryServer = Querier{}
 // Params queries the parameters of the farming module.
 func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.Keeper.paramSpace.GetParamSet(ctx, &params)
-	return &types.QueryParamsResponse{Params: params}, nil
+	return &types.QueryParamsResponse{Params: k.Keeper.GetParams(ctx)}, nil
 }
 
 // Plans queries all plans.
@@ -77,6 +77,9 @@ func (k Querier) Plans(c context.Context, req *types.QueryPlansRequest) (*types.
 		if err != nil {
 			return false, err
 		}
+		if plan, ok := plan.(*types.SchedulePlan); ok {
+			plan.SetCurrentPhaseAt(ctx.BlockTime())
+		}
 		any, err := codectypes.NewAnyWithValue(plan)
 		if err != nil {
 			return false, err
@@ -137,6 +140,9 @@ func (k Querier) Plan(c context.Context, req *types.QueryPlanRequest) (*types.Qu
 	if !found {
 		return nil, status.Errorf(codes.NotFound, "plan %d not found", req.PlanId)
 	}
+	if plan, ok := plan.(*types.SchedulePlan); ok {
+		plan.SetCurrentPhaseAt(ctx.BlockTime())
+	}
 
 	any, err := codectypes.NewAnyWithValue(plan)
 	if err != nil {
@@ -146,6 +152,32 @@ func (k Querier) Plan(c context.Context, req *types.QueryPlanRequest) (*types.Qu
 	return &types.QueryPlanResponse{Plan: any}, nil
 }
 
+// PlanFarmers queries the farmers in the allowlist of a plan.
+func (k Querier) PlanFarmers(c context.Context, req *types.QueryPlanFarmersRequest) (*types.QueryPlanFarmersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.Keeper.GetPlan(ctx, req.PlanId); !found {
+		return nil, status.Errorf(codes.NotFound, "plan %d not found", req.PlanId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	planFarmerStore := prefix.NewStore(store, types.GetPlanFarmersPrefix(req.PlanId))
+
+	var farmers []string
+	pageRes, err := query.Paginate(planFarmerStore, req.Pagination, func(key, _ []byte) error {
+		farmers = append(farmers, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPlanFarmersResponse{Farmers: farmers, Pagination: pageRes}, nil
+}
+
 func (k Querier) Stakings(c context.Context, req *types.QueryStakingsRequest) (*types.QueryStakingsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -168,13 +200,24 @@ func (k Querier) Stakings(c context.Context, req *types.QueryStakingsRequest) (*
 		StakedCoins: sdk.NewCoins(),
 		QueuedCoins: sdk.NewCoins(),
 	}
+	// The queued coins matured by the last epoch advancement but not processed yet
+	// are reported as staked coins, since they have already been staked in effect.
+	epochNumber := k.Keeper.GetEpochNumber(ctx)
+	addQueuedStaking := func(stakingCoinDenom string, queuedStaking types.QueuedStaking) {
+		coin := sdk.NewCoin(stakingCoinDenom, queuedStaking.Amount)
+		if queuedStaking.IsMaturedAt(epochNumber) {
+			resp.StakedCoins = resp.StakedCoins.Add(coin)
+		} else {
+			resp.QueuedCoins = resp.QueuedCoins.Add(coin)
+		}
+	}
 	if req.StakingCoinDenom == "" {
 		k.Keeper.IterateStakingsByFarmer(ctx, farmerAcc, func(stakingCoinDenom string, staking types.Staking) (stop bool) {
 			resp.StakedCoins = resp.StakedCoins.Add(sdk.NewCoin(stakingCoinDenom, staking.Amount))
 			return false
 		})
 		k.Keeper.IterateQueuedStakingsByFarmer(ctx, farmerAcc, func(stakingCoinDenom string, queuedStaking types.QueuedStaking) (stop bool) {
-			resp.QueuedCoins = resp.QueuedCoins.Add(sdk.NewCoin(stakingCoinDenom, queuedStaking.Amount))
+			addQueuedStaking(stakingCoinDenom, queuedStaking)
 			return false
 		})
 	} else {
@@ -184,7 +227,7 @@ func (k Querier) Stakings(c context.Context, req *types.QueryStakingsRequest) (*
 		}
 		queuedStaking, found := k.Keeper.GetQueuedStaking(ctx, req.StakingCoinDenom, farmerAcc)
 		if found {
-			resp.QueuedCoins = resp.QueuedCoins.Add(sdk.NewCoin(req.StakingCoinDenom, queuedStaking.Amount))
+			addQueuedStaking(req.StakingCoinDenom, queuedStaking)
 		}
 	}
 
@@ -241,18 +284,230 @@ func (k Querier) Rewards(c context.Context, req *types.QueryRewardsRequest) (*ty
 		rewards = k.Keeper.Rewards(ctx, farmerAcc, req.StakingCoinDenom)
 	}
 	resp.Rewards = rewards
+	resp.PlanRewards = k.Keeper.RewardsByPlan(ctx, farmerAcc, req.StakingCoinDenom)
+
+	return resp, nil
+}
+
+// PlanRewards queries the rewards of the farmer allocated by the plan.
+func (k Querier) PlanRewards(c context.Context, req *types.QueryPlanRewardsRequest) (*types.QueryPlanRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	farmerAcc, err := sdk.AccAddressFromBech32(req.Farmer)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.PlanId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "plan id must be positive")
+	}
+
+	if req.StakingCoinDenom != "" {
+		if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rewards := sdk.NewCoins()
+	for _, r := range k.Keeper.RewardsByPlan(ctx, farmerAcc, req.StakingCoinDenom) {
+		if r.PlanId == req.PlanId {
+			rewards = r.Rewards
+			break
+		}
+	}
+
+	return &types.QueryPlanRewardsResponse{Rewards: rewards}, nil
+}
+
+// year is the duration used to annualize the rewards per epoch.
+const year = 365 * 24 * time.Hour
+
+// Apr queries the projected rewards per staked unit of the staking coin denom.
+func (k Querier) Apr(c context.Context, req *types.QueryAprRequest) (*types.QueryAprResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+		return nil, err
+	}
+
+	additionalStakingAmt := sdk.ZeroInt()
+	if req.AdditionalStakingAmount != "" {
+		var ok bool
+		additionalStakingAmt, ok = sdk.NewIntFromString(req.AdditionalStakingAmount)
+		if !ok || additionalStakingAmt.IsNegative() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid additional staking amount: %s", req.AdditionalStakingAmount)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	totalStakingAmt, epochRewards, unitRewards := k.Keeper.EstimateEpochRewards(ctx, req.StakingCoinDenom, additionalStakingAmt)
+	epochsPerYear := sdk.NewDec(int64(year)).QuoInt64(int64(k.Keeper.GetCurrentEpochDuration(ctx)))
+
+	return &types.QueryAprResponse{
+		TotalStakings:     totalStakingAmt,
+		EpochRewards:      epochRewards,
+		EpochUnitRewards:  unitRewards,
+		AnnualUnitRewards: unitRewards.MulDecTruncate(epochsPerYear),
+	}, nil
+}
+
+// VestingRewards queries locked and unlocked vesting rewards of the farmer.
+func (k Querier) VestingRewards(c context.Context, req *types.QueryVestingRewardsRequest) (*types.QueryVestingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	farmerAcc, err := sdk.AccAddressFromBech32(req.Farmer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	locked, unlocked := k.Keeper.VestingRewards(ctx, farmerAcc)
+	vestings := []types.RewardVesting{}
+	k.Keeper.IterateRewardVestingsByFarmer(ctx, farmerAcc, func(vesting types.RewardVesting) (stop bool) {
+		vestings = append(vestings, vesting)
+		return false
+	})
+
+	return &types.QueryVestingRewardsResponse{
+		LockedRewards:   locked,
+		UnlockedRewards: unlocked,
+		RewardVestings:  vestings,
+	}, nil
+}
+
+// UnbondingStakings queries the unbonding entries of the farmer's unstaked coins.
+func (k Querier) UnbondingStakings(c context.Context, req *types.QueryUnbondingStakingsRequest) (*types.QueryUnbondingStakingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	farmerAcc, err := sdk.AccAddressFromBech32(req.Farmer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	entries := []types.UnbondingStakingEntry{}
+	if ubd, found := k.Keeper.GetUnbondingStaking(ctx, farmerAcc); found {
+		entries = ubd.Entries
+	}
+
+	return &types.QueryUnbondingStakingsResponse{Entries: entries}, nil
+}
+
+// RewardWithdrawAddress queries the address that the rewards of the farmer are sent to.
+func (k Querier) RewardWithdrawAddress(c context.Context, req *types.QueryRewardWithdrawAddressRequest) (*types.QueryRewardWithdrawAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	farmerAcc, err := sdk.AccAddressFromBech32(req.Farmer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	withdrawAcc := k.Keeper.GetRewardWithdrawAddr(ctx, farmerAcc)
+
+	return &types.QueryRewardWithdrawAddressResponse{WithdrawAddress: withdrawAcc.String()}, nil
+}
+
+// AutoCompoundFarmers queries all farmers who have turned on auto-compounding.
+func (k Querier) AutoCompoundFarmers(c context.Context, req *types.QueryAutoCompoundFarmersRequest) (*types.QueryAutoCompoundFarmersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	autoCompoundStore := prefix.NewStore(store, types.AutoCompoundKeyPrefix)
+
+	var farmers []string
+	pageRes, err := query.Paginate(autoCompoundStore, req.Pagination, func(key, _ []byte) error {
+		farmers = append(farmers, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAutoCompoundFarmersResponse{Farmers: farmers, Pagination: pageRes}, nil
+}
+
+// RewardsDust queries the fractional rewards left over from truncation which are not swept yet.
+func (k Querier) RewardsDust(c context.Context, req *types.QueryRewardsDustRequest) (*types.QueryRewardsDustResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryRewardsDustResponse{RewardsDust: k.Keeper.GetRewardsDust(ctx)}, nil
+}
+
+// EpochInfo queries the last epoch time, the current epoch duration, the next epoch time
+// and the current epoch of each staking coin denom.
+func (k Querier) EpochInfo(c context.Context, req *types.QueryEpochInfoRequest) (*types.QueryEpochInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := &types.QueryEpochInfoResponse{
+		CurrentEpochDuration: k.Keeper.GetCurrentEpochDuration(ctx),
+	}
+	if lastEpochTime, found := k.Keeper.GetLastEpochTime(ctx); found {
+		resp.LastEpochTime = &lastEpochTime
+	}
+	if nextEpochTime, found := k.Keeper.GetNextEpochTime(ctx); found {
+		resp.NextEpochTime = &nextEpochTime
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	currentEpochStore := prefix.NewStore(store, types.CurrentEpochKeyPrefix)
+
+	pageRes, err := query.Paginate(currentEpochStore, req.Pagination, func(key, value []byte) error {
+		var val gogotypes.UInt64Value
+		if err := k.cdc.Unmarshal(value, &val); err != nil {
+			return err
+		}
+		resp.CurrentEpochs = append(resp.CurrentEpochs, types.CurrentEpoch{
+			StakingCoinDenom: string(key),
+			CurrentEpoch:     val.GetValue(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp.Pagination = pageRes
 
 	return resp, nil
 }
 
-// CurrentEpochDays queries current epoch days.
+// CurrentEpochDays queries current epoch days and duration.
 func (k Querier) CurrentEpochDays(c context.Context, req *types.QueryCurrentEpochDaysRequest) (*types.QueryCurrentEpochDaysResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	currentEpochDays := k.Keeper.GetCurrentEpochDays(ctx)
+	currentEpochDuration := k.Keeper.GetCurrentEpochDuration(ctx)
 
-	return &types.QueryCurrentEpochDaysResponse{CurrentEpochDays: currentEpochDays}, nil
+	return &types.QueryCurrentEpochDaysResponse{
+		CurrentEpochDays:     uint32(currentEpochDuration / (24 * time.Hour)),
+		CurrentEpochDuration: currentEpochDuration,
+	}, nil
 }
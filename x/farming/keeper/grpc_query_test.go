@@ -1,7 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/tendermint/farming/x/farming"
 	"github.com/tendermint/farming/x/farming/types"
@@ -218,10 +221,50 @@ func (suite *KeeperTestSuite) TestGRPCPlan() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCPlan_SchedulePlan() {
+	suite.SetSchedulePlan(1, suite.addrs[4], map[string]string{denom1: "1.0"}, []types.SchedulePhase{
+		types.NewSchedulePhase(
+			types.ParseTime("2021-09-01T00:00:00Z"), types.ParseTime("2021-10-01T00:00:00Z"),
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000))),
+		types.NewSchedulePhase(
+			types.ParseTime("2021-10-01T00:00:00Z"), types.ParseTime("2021-12-01T00:00:00Z"),
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 500))),
+	})
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-10-15T00:00:00Z"))
+
+	resp, err := suite.querier.Plan(sdk.WrapSDKContext(suite.ctx), &types.QueryPlanRequest{PlanId: 1})
+	suite.Require().NoError(err)
+	plan, err := types.UnpackPlan(resp.Plan)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(plan.(*types.SchedulePlan).CurrentPhase)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 500)), plan.(*types.SchedulePlan).CurrentPhase.EpochAmount))
+
+	plansResp, err := suite.querier.Plans(sdk.WrapSDKContext(suite.ctx), &types.QueryPlansRequest{})
+	suite.Require().NoError(err)
+	plans, err := types.UnpackPlans(plansResp.Plans)
+	suite.Require().NoError(err)
+	suite.Require().Len(plans, 1)
+	suite.Require().Equal(plan.(*types.SchedulePlan).CurrentPhase, plans[0].(*types.SchedulePlan).CurrentPhase)
+
+	// no phase is active after the last phase ends
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-12-15T00:00:00Z"))
+	resp, err = suite.querier.Plan(sdk.WrapSDKContext(suite.ctx), &types.QueryPlanRequest{PlanId: 1})
+	suite.Require().NoError(err)
+	plan, err = types.UnpackPlan(resp.Plan)
+	suite.Require().NoError(err)
+	suite.Require().Nil(plan.(*types.SchedulePlan).CurrentPhase)
+
+	// the current phase is not stored in state
+	storedPlan, found := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Nil(storedPlan.(*types.SchedulePlan).CurrentPhase)
+}
+
 func (suite *KeeperTestSuite) TestGRPCStakings() {
 	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000), sdk.NewInt64Coin(denom2, 1500)))
 	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500), sdk.NewInt64Coin(denom2, 2000)))
-	suite.keeper.ProcessQueuedCoins(suite.ctx)
+	suite.processQueuedStakings()
 	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000), sdk.NewInt64Coin(denom2, 1500)))
 	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500), sdk.NewInt64Coin(denom2, 2000)))
 
@@ -294,10 +337,32 @@ func (suite *KeeperTestSuite) TestGRPCStakings() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCStakings_MaturedQueuedCoins() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000)))
+	suite.startQueuedStakingsPass()
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 1500)))
+
+	// The matured queued coins not processed yet are reported as staked coins.
+	resp, err := suite.querier.Stakings(sdk.WrapSDKContext(suite.ctx), &types.QueryStakingsRequest{Farmer: suite.addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000)), resp.StakedCoins))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom2, 1500)), resp.QueuedCoins))
+
+	resp, err = suite.querier.Stakings(sdk.WrapSDKContext(suite.ctx), &types.QueryStakingsRequest{Farmer: suite.addrs[0].String(), StakingCoinDenom: denom1})
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000)), resp.StakedCoins))
+	suite.Require().True(resp.QueuedCoins.IsZero())
+
+	// Nothing has been changed by the queries.
+	queuedStaking, found := suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(1000), queuedStaking.Amount))
+}
+
 func (suite *KeeperTestSuite) TestGRPCTotalStakings() {
 	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000), sdk.NewInt64Coin(denom2, 1500)))
 	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500), sdk.NewInt64Coin(denom2, 2000)))
-	suite.keeper.ProcessQueuedCoins(suite.ctx)
+	suite.processQueuedStakings()
 	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000), sdk.NewInt64Coin(denom2, 1500)))
 	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500), sdk.NewInt64Coin(denom2, 2000)))
 
@@ -431,3 +496,517 @@ func (suite *KeeperTestSuite) TestGRPCRewards() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCPlanRewards() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetFixedAmountPlan(2, suite.addrs[4], map[string]string{denom1: "0.5", denom2: "0.5"}, map[string]int64{denom3: 1000000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000), sdk.NewInt64Coin(denom2, 1000000)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryPlanRewardsRequest
+		expectErr bool
+		postRun   func(*types.QueryPlanRewardsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid farmer addr",
+			&types.QueryPlanRewardsRequest{Farmer: "invalid", PlanId: 1},
+			true,
+			nil,
+		},
+		{
+			"invalid plan id",
+			&types.QueryPlanRewardsRequest{Farmer: suite.addrs[0].String()},
+			true,
+			nil,
+		},
+		{
+			"query by farmer addr and plan id",
+			&types.QueryPlanRewardsRequest{Farmer: suite.addrs[0].String(), PlanId: 2},
+			false,
+			func(resp *types.QueryPlanRewardsResponse) {
+				// 0.5 * 1000000 * 1/2 + 0.5 * 1000000 * 1/1
+				suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 750000)), resp.Rewards))
+			},
+		},
+		{
+			"query with staking coin denom",
+			&types.QueryPlanRewardsRequest{Farmer: suite.addrs[0].String(), PlanId: 2, StakingCoinDenom: denom1},
+			false,
+			func(resp *types.QueryPlanRewardsResponse) {
+				suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 250000)), resp.Rewards))
+			},
+		},
+		{
+			"query with unrelated plan id",
+			&types.QueryPlanRewardsRequest{Farmer: suite.addrs[1].String(), PlanId: 3},
+			false,
+			func(resp *types.QueryPlanRewardsResponse) {
+				suite.Require().True(resp.Rewards.IsZero())
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.PlanRewards(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+
+	// The rewards query returns the same breakdown.
+	resp, err := suite.querier.Rewards(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardsRequest{Farmer: suite.addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PlanRewards{
+		{PlanId: 1, Rewards: sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000))},
+		{PlanId: 2, Rewards: sdk.NewCoins(sdk.NewInt64Coin(denom3, 250000))},
+	}, resp.PlanRewards)
+}
+
+func (suite *KeeperTestSuite) TestGRPCApr() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryAprRequest
+		expectErr bool
+		postRun   func(*types.QueryAprResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid staking coin denom",
+			&types.QueryAprRequest{StakingCoinDenom: "!"},
+			true,
+			nil,
+		},
+		{
+			"invalid additional staking amount",
+			&types.QueryAprRequest{StakingCoinDenom: denom1, AdditionalStakingAmount: "invalid"},
+			true,
+			nil,
+		},
+		{
+			"negative additional staking amount",
+			&types.QueryAprRequest{StakingCoinDenom: denom1, AdditionalStakingAmount: "-1"},
+			true,
+			nil,
+		},
+		{
+			"query by staking coin denom",
+			&types.QueryAprRequest{StakingCoinDenom: denom1},
+			false,
+			func(resp *types.QueryAprResponse) {
+				suite.Require().True(intEq(sdk.NewInt(1000000), resp.TotalStakings))
+				suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), resp.EpochRewards))
+				suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 1)), resp.EpochUnitRewards))
+				// An epoch lasts 1 day.
+				suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 365)), resp.AnnualUnitRewards))
+			},
+		},
+		{
+			"query with additional staking amount",
+			&types.QueryAprRequest{StakingCoinDenom: denom1, AdditionalStakingAmount: "3000000"},
+			false,
+			func(resp *types.QueryAprResponse) {
+				suite.Require().True(intEq(sdk.NewInt(4000000), resp.TotalStakings))
+				suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.NewDecWithPrec(25, 2))), resp.EpochUnitRewards))
+				suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.NewDecWithPrec(9125, 2))), resp.AnnualUnitRewards))
+			},
+		},
+		{
+			"query with staking coin denom without plans",
+			&types.QueryAprRequest{StakingCoinDenom: denom2},
+			false,
+			func(resp *types.QueryAprResponse) {
+				suite.Require().True(resp.TotalStakings.IsZero())
+				suite.Require().True(resp.EpochRewards.IsZero())
+				suite.Require().True(resp.EpochUnitRewards.IsZero())
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.Apr(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCPlanFarmers() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetPlanType(1, types.PlanTypePrivate)
+	err := suite.keeper.AddPlanFarmers(suite.ctx, suite.addrs[4], 1, []string{suite.addrs[0].String(), suite.addrs[1].String()})
+	suite.Require().NoError(err)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryPlanFarmersRequest
+		expectErr bool
+		postRun   func(*types.QueryPlanFarmersResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"plan not found",
+			&types.QueryPlanFarmersRequest{PlanId: 2},
+			true,
+			nil,
+		},
+		{
+			"query by plan id",
+			&types.QueryPlanFarmersRequest{PlanId: 1},
+			false,
+			func(resp *types.QueryPlanFarmersResponse) {
+				suite.Require().ElementsMatch([]string{suite.addrs[0].String(), suite.addrs[1].String()}, resp.Farmers)
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.PlanFarmers(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCUnbondingStakings() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+	suite.SetUnstakingPeriod(7 * 24 * time.Hour)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000), sdk.NewInt64Coin(denom2, 1500)))
+	suite.processQueuedStakings()
+	err := suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500), sdk.NewInt64Coin(denom2, 1500)))
+	suite.Require().NoError(err)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryUnbondingStakingsRequest
+		expectErr bool
+		postRun   func(*types.QueryUnbondingStakingsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid farmer addr",
+			&types.QueryUnbondingStakingsRequest{Farmer: "invalid"},
+			true,
+			nil,
+		},
+		{
+			"query by farmer addr",
+			&types.QueryUnbondingStakingsRequest{Farmer: suite.addrs[0].String()},
+			false,
+			func(resp *types.QueryUnbondingStakingsResponse) {
+				suite.Require().Len(resp.Entries, 1)
+				suite.Require().True(coinsEq(
+					sdk.NewCoins(sdk.NewInt64Coin(denom1, 500), sdk.NewInt64Coin(denom2, 1500)),
+					resp.Entries[0].Balance))
+				suite.Require().True(types.ParseTime("2021-08-08T00:00:00Z").Equal(resp.Entries[0].CompletionTime))
+			},
+		},
+		{
+			"query by farmer addr without unbondings",
+			&types.QueryUnbondingStakingsRequest{Farmer: suite.addrs[1].String()},
+			false,
+			func(resp *types.QueryUnbondingStakingsResponse) {
+				suite.Require().Empty(resp.Entries)
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.UnbondingStakings(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCRewardWithdrawAddress() {
+	suite.Require().NoError(suite.keeper.SetRewardWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[5]))
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryRewardWithdrawAddressRequest
+		expectErr bool
+		postRun   func(*types.QueryRewardWithdrawAddressResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid farmer addr",
+			&types.QueryRewardWithdrawAddressRequest{Farmer: "invalid"},
+			true,
+			nil,
+		},
+		{
+			"query by farmer addr",
+			&types.QueryRewardWithdrawAddressRequest{Farmer: suite.addrs[0].String()},
+			false,
+			func(resp *types.QueryRewardWithdrawAddressResponse) {
+				suite.Require().Equal(suite.addrs[5].String(), resp.WithdrawAddress)
+			},
+		},
+		{
+			"query by farmer addr without withdraw address",
+			&types.QueryRewardWithdrawAddressRequest{Farmer: suite.addrs[1].String()},
+			false,
+			func(resp *types.QueryRewardWithdrawAddressResponse) {
+				suite.Require().Equal(suite.addrs[1].String(), resp.WithdrawAddress)
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.RewardWithdrawAddress(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCAutoCompoundFarmers() {
+	suite.keeper.SetAutoCompoundSetting(suite.ctx, suite.addrs[0], true)
+	suite.keeper.SetAutoCompoundSetting(suite.ctx, suite.addrs[1], true)
+	suite.keeper.SetAutoCompoundSetting(suite.ctx, suite.addrs[2], true)
+	suite.keeper.SetAutoCompoundSetting(suite.ctx, suite.addrs[2], false)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryAutoCompoundFarmersRequest
+		expectErr bool
+		postRun   func(*types.QueryAutoCompoundFarmersResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query all",
+			&types.QueryAutoCompoundFarmersRequest{},
+			false,
+			func(resp *types.QueryAutoCompoundFarmersResponse) {
+				suite.Require().ElementsMatch([]string{suite.addrs[0].String(), suite.addrs[1].String()}, resp.Farmers)
+			},
+		},
+		{
+			"query with pagination",
+			&types.QueryAutoCompoundFarmersRequest{Pagination: &query.PageRequest{Limit: 1}},
+			false,
+			func(resp *types.QueryAutoCompoundFarmersResponse) {
+				suite.Require().Len(resp.Farmers, 1)
+				suite.Require().NotNil(resp.Pagination.NextKey)
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.AutoCompoundFarmers(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCRewardsDust() {
+	suite.keeper.SetRewardsDust(suite.ctx, sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.NewDecWithPrec(5, 1))))
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryRewardsDustRequest
+		expectErr bool
+		postRun   func(*types.QueryRewardsDustResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query rewards dust",
+			&types.QueryRewardsDustRequest{},
+			false,
+			func(resp *types.QueryRewardsDustResponse) {
+				suite.Require().True(decCoinsEq(
+					sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.NewDecWithPrec(5, 1))),
+					resp.RewardsDust))
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.RewardsDust(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCEpochInfo() {
+	resp, err := suite.querier.EpochInfo(sdk.WrapSDKContext(suite.ctx), &types.QueryEpochInfoRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Nil(resp.LastEpochTime)
+	suite.Require().Nil(resp.NextEpochTime)
+	suite.Require().Empty(resp.CurrentEpochs)
+
+	suite.keeper.SetLastEpochTime(suite.ctx, types.ParseTime("2021-08-01T12:00:00Z"))
+	suite.keeper.SetCurrentEpochDuration(suite.ctx, 6*time.Hour)
+	suite.keeper.SetCurrentEpoch(suite.ctx, denom1, 3)
+	suite.keeper.SetCurrentEpoch(suite.ctx, denom2, 5)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryEpochInfoRequest
+		expectErr bool
+		postRun   func(*types.QueryEpochInfoResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query all",
+			&types.QueryEpochInfoRequest{},
+			false,
+			func(resp *types.QueryEpochInfoResponse) {
+				suite.Require().Equal(types.ParseTime("2021-08-01T12:00:00Z"), *resp.LastEpochTime)
+				suite.Require().Equal(6*time.Hour, resp.CurrentEpochDuration)
+				suite.Require().Equal(types.ParseTime("2021-08-01T18:00:00Z"), *resp.NextEpochTime)
+				suite.Require().Equal([]types.CurrentEpoch{
+					{StakingCoinDenom: denom1, CurrentEpoch: 3},
+					{StakingCoinDenom: denom2, CurrentEpoch: 5},
+				}, resp.CurrentEpochs)
+			},
+		},
+		{
+			"query with pagination",
+			&types.QueryEpochInfoRequest{Pagination: &query.PageRequest{Limit: 1}},
+			false,
+			func(resp *types.QueryEpochInfoResponse) {
+				suite.Require().Equal([]types.CurrentEpoch{{StakingCoinDenom: denom1, CurrentEpoch: 3}}, resp.CurrentEpochs)
+				suite.Require().NotNil(resp.Pagination.NextKey)
+				suite.Require().NotNil(resp.NextEpochTime)
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.EpochInfo(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCCurrentEpochDays() {
+	for _, tc := range []struct {
+		name      string
+		malleate  func()
+		req       *types.QueryCurrentEpochDaysRequest
+		expectErr bool
+		postRun   func(*types.QueryCurrentEpochDaysResponse)
+	}{
+		{
+			"nil request",
+			func() {},
+			nil,
+			true,
+			nil,
+		},
+		{
+			"epoch of days",
+			func() {
+				suite.keeper.SetCurrentEpochDuration(suite.ctx, 48*time.Hour)
+			},
+			&types.QueryCurrentEpochDaysRequest{},
+			false,
+			func(resp *types.QueryCurrentEpochDaysResponse) {
+				suite.Require().Equal(uint32(2), resp.CurrentEpochDays)
+				suite.Require().Equal(48*time.Hour, resp.CurrentEpochDuration)
+			},
+		},
+		{
+			"epoch shorter than a day",
+			func() {
+				suite.keeper.SetCurrentEpochDuration(suite.ctx, time.Hour)
+			},
+			&types.QueryCurrentEpochDaysRequest{},
+			false,
+			func(resp *types.QueryCurrentEpochDaysResponse) {
+				suite.Require().Equal(uint32(0), resp.CurrentEpochDays)
+				suite.Require().Equal(time.Hour, resp.CurrentEpochDuration)
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			tc.malleate()
+			resp, err := suite.querier.CurrentEpochDays(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// Implements FarmingHooks interface
+var _ types.FarmingHooks = Keeper{}
+
+// BeforeAdvanceEpoch - call hook if registered
+func (k Keeper) BeforeAdvanceEpoch(ctx sdk.Context) {
+	if k.hooks != nil {
+		k.hooks.BeforeAdvanceEpoch(ctx)
+	}
+}
+
+// AfterAdvanceEpoch - call hook if registered
+func (k Keeper) AfterAdvanceEpoch(ctx sdk.Context) {
+	if k.hooks != nil {
+		k.hooks.AfterAdvanceEpoch(ctx)
+	}
+}
+
+// AfterAllocateRewards - call hook if registered
+func (k Keeper) AfterAllocateRewards(ctx sdk.Context, plan types.PlanI, allocatedCoins sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.AfterAllocateRewards(ctx, plan, allocatedCoins)
+	}
+}
+
+// AfterStake - call hook if registered
+func (k Keeper) AfterStake(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoins sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.AfterStake(ctx, farmerAcc, stakingCoins)
+	}
+}
+
+// AfterUnstake - call hook if registered
+func (k Keeper) AfterUnstake(ctx sdk.Context, farmerAcc sdk.AccAddress, unstakingCoins sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.AfterUnstake(ctx, farmerAcc, unstakingCoins)
+	}
+}
+
+// AfterHarvest - call hook if registered
+func (k Keeper) AfterHarvest(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenoms []string, rewards sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.AfterHarvest(ctx, farmerAcc, stakingCoinDenoms, rewards)
+	}
+}
+
+// AfterPlanTerminated - call hook if registered
+func (k Keeper) AfterPlanTerminated(ctx sdk.Context, plan types.PlanI) {
+	if k.hooks != nil {
+		k.hooks.AfterPlanTerminated(ctx, plan)
+	}
+}
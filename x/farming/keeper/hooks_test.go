@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/keeper"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+var _ types.FarmingHooks = &mockFarmingHooks{}
+
+// mockFarmingHooks records the calls of the farming hooks.
+type mockFarmingHooks struct {
+	calls []string
+}
+
+func (h *mockFarmingHooks) BeforeAdvanceEpoch(ctx sdk.Context) {
+	h.calls = append(h.calls, "BeforeAdvanceEpoch")
+}
+
+func (h *mockFarmingHooks) AfterAdvanceEpoch(ctx sdk.Context) {
+	h.calls = append(h.calls, "AfterAdvanceEpoch")
+}
+
+func (h *mockFarmingHooks) AfterAllocateRewards(ctx sdk.Context, plan types.PlanI, allocatedCoins sdk.Coins) {
+	h.calls = append(h.calls, fmt.Sprintf("AfterAllocateRewards(%d,%s)", plan.GetId(), allocatedCoins))
+}
+
+func (h *mockFarmingHooks) AfterStake(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoins sdk.Coins) {
+	h.calls = append(h.calls, fmt.Sprintf("AfterStake(%s,%s)", farmerAcc, stakingCoins))
+}
+
+func (h *mockFarmingHooks) AfterUnstake(ctx sdk.Context, farmerAcc sdk.AccAddress, unstakingCoins sdk.Coins) {
+	h.calls = append(h.calls, fmt.Sprintf("AfterUnstake(%s,%s)", farmerAcc, unstakingCoins))
+}
+
+func (h *mockFarmingHooks) AfterHarvest(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenoms []string, rewards sdk.Coins) {
+	h.calls = append(h.calls, fmt.Sprintf("AfterHarvest(%s,%v,%s)", farmerAcc, stakingCoinDenoms, rewards))
+}
+
+func (h *mockFarmingHooks) AfterPlanTerminated(ctx sdk.Context, plan types.PlanI) {
+	h.calls = append(h.calls, fmt.Sprintf("AfterPlanTerminated(%d)", plan.GetId()))
+}
+
+func (suite *KeeperTestSuite) TestSetHooksTwice() {
+	k := keeper.NewKeeper(
+		suite.app.AppCodec(), suite.app.GetKey(types.StoreKey), suite.app.GetSubspace(types.ModuleName),
+		suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.ModuleAccountAddrs(),
+	)
+	k.SetHooks(&mockFarmingHooks{})
+	suite.Require().Panics(func() {
+		k.SetHooks(&mockFarmingHooks{})
+	})
+}
+
+func (suite *KeeperTestSuite) TestHooks() {
+	hooks := &mockFarmingHooks{}
+	k := keeper.NewKeeper(
+		suite.app.AppCodec(), suite.app.GetKey(types.StoreKey), suite.app.GetSubspace(types.ModuleName),
+		suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.ModuleAccountAddrs(),
+	)
+	suite.keeper = *k.SetHooks(hooks)
+
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.Harvest(suite.addrs[0], []string{denom1})
+	suite.Require().NoError(suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000))))
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().NoError(suite.keeper.TerminatePlan(suite.ctx, plan))
+
+	suite.Require().Equal([]string{
+		fmt.Sprintf("AfterStake(%s,1000000denom1)", suite.addrs[0]),
+		"BeforeAdvanceEpoch",
+		"AfterAdvanceEpoch",
+		"BeforeAdvanceEpoch",
+		"AfterAllocateRewards(1,1000000denom3)",
+		"AfterAdvanceEpoch",
+		fmt.Sprintf("AfterHarvest(%s,[denom1],1000000denom3)", suite.addrs[0]),
+		fmt.Sprintf("AfterUnstake(%s,500000denom1)", suite.addrs[0]),
+		"AfterPlanTerminated(1)",
+	}, hooks.calls)
+}
+
+func (suite *KeeperTestSuite) TestHooksStakingChanges() {
+	hooks := &mockFarmingHooks{}
+	k := keeper.NewKeeper(
+		suite.app.AppCodec(), suite.app.GetKey(types.StoreKey), suite.app.GetSubspace(types.ModuleName),
+		suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.ModuleAccountAddrs(),
+	)
+	suite.keeper = *k.SetHooks(hooks)
+	stakingCalls := func() (calls []string) {
+		for _, call := range hooks.calls {
+			if strings.HasPrefix(call, "AfterStake") || strings.HasPrefix(call, "AfterUnstake") {
+				calls = append(calls, call)
+			}
+		}
+		hooks.calls = nil
+		return
+	}
+
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "0.5", denom3: "0.5"}, map[string]int64{denom3: 1_000_000})
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	stakingCalls()
+
+	// Transferring a staking unstakes it from the farmer and stakes it for the recipient.
+	suite.Require().NoError(suite.keeper.TransferStaking(suite.ctx, suite.addrs[0], suite.addrs[1], []string{denom1}))
+	suite.Require().Equal([]string{
+		fmt.Sprintf("AfterUnstake(%s,1000000denom1)", suite.addrs[0]),
+		fmt.Sprintf("AfterStake(%s,1000000denom1)", suite.addrs[1]),
+	}, stakingCalls())
+
+	// Auto-compounding stakes the rewards.
+	suite.keeper.SetAutoCompoundSetting(suite.ctx, suite.addrs[1], true)
+	suite.AdvanceEpoch()
+	suite.Require().Equal([]string{
+		fmt.Sprintf("AfterStake(%s,500000denom3)", suite.addrs[1]),
+	}, stakingCalls())
+
+	// Sending receipts away releases the receipt-backed staking, and receiving them claims it.
+	suite.LiquidStake(suite.addrs[2], sdk.NewCoins(sdk.NewInt64Coin(denom2, 1_000_000)))
+	suite.SendReceipts(suite.addrs[2], suite.addrs[3], denom2, 400_000)
+	stakingCalls()
+	suite.Require().NoError(suite.keeper.SyncReceiptStaking(suite.ctx, suite.addrs[2], denom2))
+	suite.Require().NoError(suite.keeper.SyncReceiptStaking(suite.ctx, suite.addrs[3], denom2))
+	suite.Require().Equal([]string{
+		fmt.Sprintf("AfterUnstake(%s,400000denom2)", suite.addrs[2]),
+		fmt.Sprintf("AfterStake(%s,400000denom2)", suite.addrs[3]),
+	}, stakingCalls())
+}
+
+func (suite *KeeperTestSuite) TestHooksAllocateRewardsOrder() {
+	hooks := &mockFarmingHooks{}
+	k := keeper.NewKeeper(
+		suite.app.AppCodec(), suite.app.GetKey(types.StoreKey), suite.app.GetSubspace(types.ModuleName),
+		suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.ModuleAccountAddrs(),
+	)
+	suite.keeper = *k.SetHooks(hooks)
+
+	for id := uint64(1); id <= 5; id++ {
+		suite.SetFixedAmountPlan(id, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: int64(id) * 1_000_000})
+	}
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	hooks.calls = nil
+	suite.AdvanceEpoch()
+
+	// The rewards of the plans are allocated in order of their ids.
+	suite.Require().Equal([]string{
+		"BeforeAdvanceEpoch",
+		"AfterAllocateRewards(1,1000000denom3)",
+		"AfterAllocateRewards(2,2000000denom3)",
+		"AfterAllocateRewards(3,3000000denom3)",
+		"AfterAllocateRewards(4,4000000denom3)",
+		"AfterAllocateRewards(5,5000000denom3)",
+		"AfterAdvanceEpoch",
+	}, hooks.calls)
+}
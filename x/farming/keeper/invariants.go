@@ -12,6 +12,12 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		StakingReservedAmountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "remaining-rewards",
 		RemainingRewardsAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "vesting-rewards",
+		VestingRewardsAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "receipt-supply",
+		ReceiptSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "historical-rewards-reference-count",
+		HistoricalRewardsReferenceCountInvariant(k))
 }
 
 // AllInvariants runs all invariants of the farming module.
@@ -21,7 +27,19 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if stop {
 			return res, stop
 		}
-		return RemainingRewardsAmountInvariant(k)(ctx)
+		res, stop = RemainingRewardsAmountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = VestingRewardsAmountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = ReceiptSupplyInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return HistoricalRewardsReferenceCountInvariant(k)(ctx)
 	}
 }
 
@@ -44,3 +62,34 @@ func RemainingRewardsAmountInvariant(k Keeper) sdk.Invariant {
 			"the balance of the RewardPoolAddresses of all plans less than the total amount of unwithdrawn reward coins in all reward objects"), broken
 	}
 }
+
+// VestingRewardsAmountInvariant checks that the balance of VestingRewardsAcc greater than the amount of unclaimed rewards in all reward vesting objects.
+func VestingRewardsAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		err := k.ValidateVestingRewardsAmount(ctx)
+		broken := err != nil
+		return sdk.FormatInvariant(types.ModuleName, "vesting rewards amount invariant broken",
+			"the balance of VestingRewardsAcc less than the amount of unclaimed rewards in all reward vesting objects"), broken
+	}
+}
+
+// ReceiptSupplyInvariant checks that the supply of each receipt denom equals the amount of receipt-backed stakings.
+func ReceiptSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		err := k.ValidateReceiptSupply(ctx)
+		broken := err != nil
+		return sdk.FormatInvariant(types.ModuleName, "receipt supply invariant broken",
+			"the supply of receipt coins differs from the amount of receipt-backed stakings"), broken
+	}
+}
+
+// HistoricalRewardsReferenceCountInvariant checks that the reference count of each historical rewards
+// equals the number of stakings and current epochs referring to them.
+func HistoricalRewardsReferenceCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		err := k.ValidateHistoricalRewardsReferenceCount(ctx)
+		broken := err != nil
+		return sdk.FormatInvariant(types.ModuleName, "historical rewards reference count invariant broken",
+			"the reference count of historical rewards differs from the number of stakings and current epochs referring to them"), broken
+	}
+}
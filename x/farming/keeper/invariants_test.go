@@ -68,10 +68,10 @@ package keeper_test
 //		sdk.NewInt64Coin(denom1, 1_000_000),
 //		sdk.NewInt64Coin(denom2, 1_000_000)))
 //
-//	suite.keeper.ProcessQueuedCoins(suite.ctx)
+//	suite.processQueuedStakings()
 //
 //	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-07-31T00:00:00Z"))
-//	err := suite.keeper.AllocateRewards(suite.ctx)
+//	err := suite.keeper.AllocateRewards(suite.ctx, suite.ctx.BlockTime())
 //	suite.Require().NoError(err)
 //
 //	rewards := suite.keeper.GetRewardsByFarmer(suite.ctx, suite.addrs[1])
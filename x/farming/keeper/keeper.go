@@ -36,6 +36,8 @@ type Keeper struct {
 	accountKeeper types.AccountKeeper
 
 	blockedAddrs map[string]bool
+
+	hooks types.FarmingHooks
 }
 
 // NewKeeper returns a farming keeper. It handles:
@@ -66,6 +68,17 @@ func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Su
 	}
 }
 
+// SetHooks sets the farming hooks.
+func (k *Keeper) SetHooks(fh types.FarmingHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set farming hooks twice")
+	}
+
+	k.hooks = fh
+
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -74,6 +87,20 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 // GetParams gets the parameters for the farming module.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
+	// Empty lists are decoded as nil from the param store, while they are
+	// decoded as empty slices from JSON. Keep them consistent.
+	if params.AllowedStakingDenoms == nil {
+		params.AllowedStakingDenoms = []string{}
+	}
+	if params.MaxTotalStakings == nil {
+		params.MaxTotalStakings = sdk.Coins{}
+	}
+	if params.MinStakingAmounts == nil {
+		params.MinStakingAmounts = sdk.Coins{}
+	}
+	if params.PausedOperations == nil {
+		params.PausedOperations = []string{}
+	}
 	return params
 }
 
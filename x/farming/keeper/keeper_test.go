@@ -168,6 +168,51 @@ func (suite *KeeperTestSuite) SetFixedAmountPlan(id uint64, farmingPoolAcc sdk.A
 	))
 }
 
+func (suite *KeeperTestSuite) SetDecayingPlan(id uint64, farmingPoolAcc sdk.AccAddress, stakingCoinWeightsMap map[string]string, epochAmountMap map[string]int64, decayRateStr string, decayEpochs uint32) {
+	stakingCoinWeights := sdk.NewDecCoins()
+	for denom, weight := range stakingCoinWeightsMap {
+		stakingCoinWeights = stakingCoinWeights.Add(sdk.NewDecCoinFromDec(denom, sdk.MustNewDecFromStr(weight)))
+	}
+
+	epochAmount := sdk.NewCoins()
+	for denom, amount := range epochAmountMap {
+		epochAmount = epochAmount.Add(sdk.NewInt64Coin(denom, amount))
+	}
+
+	suite.keeper.SetPlan(suite.ctx, types.NewDecayingPlan(
+		types.NewBasePlan(
+			id,
+			fmt.Sprintf("plan%d", id),
+			types.PlanTypePublic,
+			farmingPoolAcc.String(),
+			farmingPoolAcc.String(),
+			stakingCoinWeights,
+			types.ParseTime("0001-01-01T00:00:00Z"),
+			types.ParseTime("9999-12-31T00:00:00Z"),
+		), epochAmount, sdk.MustNewDecFromStr(decayRateStr), decayEpochs,
+	))
+}
+
+func (suite *KeeperTestSuite) SetSchedulePlan(id uint64, farmingPoolAcc sdk.AccAddress, stakingCoinWeightsMap map[string]string, phases []types.SchedulePhase) {
+	stakingCoinWeights := sdk.NewDecCoins()
+	for denom, weight := range stakingCoinWeightsMap {
+		stakingCoinWeights = stakingCoinWeights.Add(sdk.NewDecCoinFromDec(denom, sdk.MustNewDecFromStr(weight)))
+	}
+
+	suite.keeper.SetPlan(suite.ctx, types.NewSchedulePlan(
+		types.NewBasePlan(
+			id,
+			fmt.Sprintf("plan%d", id),
+			types.PlanTypePublic,
+			farmingPoolAcc.String(),
+			farmingPoolAcc.String(),
+			stakingCoinWeights,
+			types.ParseTime("0001-01-01T00:00:00Z"),
+			types.ParseTime("9999-12-31T00:00:00Z"),
+		), phases,
+	))
+}
+
 func (suite *KeeperTestSuite) SetRatioPlan(id uint64, farmingPoolAcc sdk.AccAddress, stakingCoinWeightsMap map[string]string, epochRatioStr string) {
 	stakingCoinWeights := sdk.NewDecCoins()
 	for denom, weight := range stakingCoinWeightsMap {
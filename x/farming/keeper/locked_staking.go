@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// GetLockedStaking returns a locked staking of the farmer which ends at the end time.
+func (k Keeper) GetLockedStaking(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress, endTime time.Time) (lock types.LockedStaking, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLockedStakingKey(endTime, farmerAcc, stakingCoinDenom))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &lock)
+	found = true
+	return
+}
+
+// SetLockedStaking sets a locked staking of the farmer.
+func (k Keeper) SetLockedStaking(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress, lock types.LockedStaking) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&lock)
+	store.Set(types.GetLockedStakingKey(lock.EndTime, farmerAcc, stakingCoinDenom), bz)
+	store.Set(types.GetLockedStakingIndexKey(farmerAcc, stakingCoinDenom, lock.EndTime), []byte{})
+}
+
+// DeleteLockedStaking deletes a locked staking of the farmer.
+func (k Keeper) DeleteLockedStaking(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress, lock types.LockedStaking) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLockedStakingKey(lock.EndTime, farmerAcc, stakingCoinDenom))
+	store.Delete(types.GetLockedStakingIndexKey(farmerAcc, stakingCoinDenom, lock.EndTime))
+}
+
+// IterateLockedStakings iterates through all locked stakings in the order of their end time
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateLockedStakings(ctx sdk.Context, cb func(stakingCoinDenom string, farmerAcc sdk.AccAddress, lock types.LockedStaking) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.LockedStakingKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var lock types.LockedStaking
+		k.cdc.MustUnmarshal(iter.Value(), &lock)
+		_, farmerAcc, stakingCoinDenom := types.ParseLockedStakingKey(iter.Key())
+		if cb(stakingCoinDenom, farmerAcc, lock) {
+			break
+		}
+	}
+}
+
+// IterateExpiredLockedStakings iterates through all locked stakings which are expired
+// at given time t and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateExpiredLockedStakings(ctx sdk.Context, t time.Time, cb func(stakingCoinDenom string, farmerAcc sdk.AccAddress, lock types.LockedStaking) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.LockedStakingKeyPrefix, sdk.PrefixEndBytes(types.GetLockedStakingsByEndTimePrefix(t)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var lock types.LockedStaking
+		k.cdc.MustUnmarshal(iter.Value(), &lock)
+		_, farmerAcc, stakingCoinDenom := types.ParseLockedStakingKey(iter.Key())
+		if cb(stakingCoinDenom, farmerAcc, lock) {
+			break
+		}
+	}
+}
+
+// IterateLockedStakingsByFarmer iterates through all locked stakings of the farmer
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateLockedStakingsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress, cb func(stakingCoinDenom string, lock types.LockedStaking) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetLockedStakingsByFarmerPrefix(farmerAcc))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		farmerAcc, stakingCoinDenom, endTime := types.ParseLockedStakingIndexKey(iter.Key())
+		lock, _ := k.GetLockedStaking(ctx, stakingCoinDenom, farmerAcc, endTime)
+		if cb(stakingCoinDenom, lock) {
+			break
+		}
+	}
+}
+
+// GetLockedStakingsByFarmer returns all locked stakings of the farmer for the staking coin denom.
+func (k Keeper) GetLockedStakingsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) []types.LockedStaking {
+	var locks []types.LockedStaking
+	k.IterateLockedStakingsByFarmer(ctx, farmerAcc, func(denom string, lock types.LockedStaking) (stop bool) {
+		if denom == stakingCoinDenom {
+			locks = append(locks, lock)
+		}
+		return false
+	})
+	return locks
+}
+
+// LockStake stakes coins like Stake, but the coins can't be unstaked until the lock duration
+// has passed. In return, the reward weight of the coins is multiplied by the multiplier of the
+// lock duration defined in params, once the queued coins are staked.
+func (k Keeper) LockStake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coins, lockDuration time.Duration) error {
+	multiplier, found := k.GetParams(ctx).GetLockMultiplier(lockDuration)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidLockDuration, "lock duration %s is not allowed", lockDuration)
+	}
+
+	endTime := ctx.BlockTime().Add(lockDuration)
+	for _, coin := range amount {
+		if err := k.SettleQueuedStaking(ctx, coin.Denom, farmerAcc); err != nil {
+			return err
+		}
+		// A boosted locked staking can't be merged with the new one whose coins are queued.
+		if lock, found := k.GetLockedStaking(ctx, coin.Denom, farmerAcc, endTime); found && lock.Boosted {
+			return sdkerrors.Wrapf(types.ErrInvalidLockDuration, "locked staking of %s ending at %s already exists", coin.Denom, endTime)
+		}
+	}
+
+	if err := k.Stake(ctx, farmerAcc, amount); err != nil {
+		return err
+	}
+
+	for _, coin := range amount {
+		lock, found := k.GetLockedStaking(ctx, coin.Denom, farmerAcc, endTime)
+		if !found {
+			lock = types.LockedStaking{
+				Amount:      sdk.ZeroInt(),
+				BoostAmount: sdk.ZeroInt(),
+				EndTime:     endTime,
+			}
+		}
+		lock.Amount = lock.Amount.Add(coin.Amount)
+		lock.BoostAmount = lock.BoostAmount.Add(types.BoostAmountOf(coin.Amount, multiplier))
+		k.SetLockedStaking(ctx, coin.Denom, farmerAcc, lock)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeLockStaking,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyStakingCoins, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyLockDuration, lockDuration.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, endTime.String()),
+		),
+	})
+
+	return nil
+}
+
+// BoostLockedStakings applies the boost of the farmer's locked stakings which are not boosted yet.
+// It is called when the queued coins of the farmer are staked, and returns the total boost amount applied.
+// Locked stakings expired at given time t, at which the queued coins are staked, are not boosted.
+func (k Keeper) BoostLockedStakings(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress, t time.Time) sdk.Int {
+	boostAmt := sdk.ZeroInt()
+	for _, lock := range k.GetLockedStakingsByFarmer(ctx, farmerAcc, stakingCoinDenom) {
+		if lock.Boosted || lock.IsExpiredAt(t) {
+			continue
+		}
+		lock.Boosted = true
+		k.SetLockedStaking(ctx, stakingCoinDenom, farmerAcc, lock)
+		boostAmt = boostAmt.Add(lock.BoostAmount)
+	}
+	return boostAmt
+}
+
+// UnlockStaking deletes the locked staking and removes its boost from the staking and the total stakings.
+func (k Keeper) UnlockStaking(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress, lock types.LockedStaking) error {
+	if lock.Boosted && lock.BoostAmount.IsPositive() {
+		// Rewards accumulated with the boost must be withdrawn before the boost is removed.
+		if _, err := k.WithdrawRewards(ctx, farmerAcc, stakingCoinDenom); err != nil {
+			return err
+		}
+
+		staking, _ := k.GetStaking(ctx, stakingCoinDenom, farmerAcc)
+		staking.BoostAmount = staking.GetBoostAmount().Sub(lock.BoostAmount)
+		k.SetStaking(ctx, stakingCoinDenom, farmerAcc, staking)
+		k.DecreaseTotalStakings(ctx, stakingCoinDenom, lock.BoostAmount)
+		k.DecreasePlanTotalStakingsByFarmer(ctx, farmerAcc, stakingCoinDenom, lock.BoostAmount)
+	}
+
+	k.DeleteLockedStaking(ctx, stakingCoinDenom, farmerAcc, lock)
+
+	return nil
+}
+
+// UnlockExpiredStakings unlocks all the farmer's locked stakings of the staking coin denom which are expired.
+func (k Keeper) UnlockExpiredStakings(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress) error {
+	for _, lock := range k.GetLockedStakingsByFarmer(ctx, farmerAcc, stakingCoinDenom) {
+		if !lock.IsExpiredAt(ctx.BlockTime()) {
+			continue
+		}
+		if err := k.UnlockStaking(ctx, stakingCoinDenom, farmerAcc, lock); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ProcessExpiredLockedStakings unlocks all locked stakings which are expired at given time t.
+func (k Keeper) ProcessExpiredLockedStakings(ctx sdk.Context, t time.Time) error {
+	type expiredLock struct {
+		stakingCoinDenom string
+		farmerAcc        sdk.AccAddress
+		lock             types.LockedStaking
+	}
+
+	var expiredLocks []expiredLock
+	k.IterateExpiredLockedStakings(ctx, t, func(stakingCoinDenom string, farmerAcc sdk.AccAddress, lock types.LockedStaking) (stop bool) {
+		expiredLocks = append(expiredLocks, expiredLock{stakingCoinDenom, farmerAcc, lock})
+		return false
+	})
+
+	for _, l := range expiredLocks {
+		if err := k.UnlockStaking(ctx, l.stakingCoinDenom, l.farmerAcc, l.lock); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
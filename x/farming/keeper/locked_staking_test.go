@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/keeper"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+func (suite *KeeperTestSuite) TestLockStake() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 2100000})
+
+	// Only the lock durations defined in params are allowed.
+	err := suite.keeper.LockStake(suite.ctx, suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)), time.Hour)
+	suite.Require().ErrorIs(err, types.ErrInvalidLockDuration)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	err = suite.keeper.LockStake(suite.ctx, suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)), 30*24*time.Hour)
+	suite.Require().NoError(err)
+
+	locks := suite.keeper.GetLockedStakingsByFarmer(suite.ctx, suite.addrs[1], denom1)
+	suite.Require().Len(locks, 1)
+	suite.Require().False(locks[0].Boosted)
+	suite.Require().True(intEq(sdk.NewInt(100000), locks[0].BoostAmount))
+
+	suite.AdvanceEpoch()
+
+	// The boost is applied once the queued coins are staked.
+	staking, found := suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(1100000), staking.BoostedAmount()))
+	totalStakings, _ := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(intEq(sdk.NewInt(2100000), totalStakings.Amount))
+
+	suite.AdvanceEpoch()
+
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), suite.keeper.AllRewards(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1100000)), suite.keeper.AllRewards(suite.ctx, suite.addrs[1])))
+
+	// Locked coins can't be unstaked until the lock ends.
+	err = suite.keeper.Unstake(suite.ctx, suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1)))
+	suite.Require().ErrorIs(err, types.ErrStakingLocked)
+
+	// The expired locked staking is unlocked on the epoch, and the boost is removed.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-09-01T00:00:00Z"))
+	suite.AdvanceEpoch()
+
+	suite.Require().Empty(suite.keeper.GetLockedStakingsByFarmer(suite.ctx, suite.addrs[1], denom1))
+	staking, _ = suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().True(intEq(sdk.NewInt(1000000), staking.BoostedAmount()))
+	totalStakings, _ = suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(intEq(sdk.NewInt(2000000), totalStakings.Amount))
+
+	err = suite.keeper.Unstake(suite.ctx, suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Require().NoError(err)
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestLockStake_QueuedUnstake() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)))
+	err := suite.keeper.LockStake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)), 90*24*time.Hour)
+	suite.Require().NoError(err)
+
+	// Queued coins which are not locked can still be unstaked.
+	err = suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500001)))
+	suite.Require().ErrorIs(err, types.ErrStakingLocked)
+	err = suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestLockStake_Genesis() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+
+	err := suite.keeper.LockStake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)), 180*24*time.Hour)
+	suite.Require().NoError(err)
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genState.LockedStakingRecords, 1)
+
+	bz, err := suite.app.AppCodec().MarshalJSON(genState)
+	suite.Require().NoError(err)
+	var genState2 types.GenesisState
+	suite.Require().NoError(suite.app.AppCodec().UnmarshalJSON(bz, &genState2))
+	suite.Require().NoError(types.ValidateGenesis(genState2))
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, genState2)
+	})
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+}
@@ -29,6 +29,11 @@ var _ types.MsgServer = msgServer{}
 // CreateFixedAmountPlan defines a method for creating fixed amount farming plan.
 func (k msgServer) CreateFixedAmountPlan(goCtx context.Context, msg *types.MsgCreateFixedAmountPlan) (*types.MsgCreateFixedAmountPlanResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateOperationNotPaused(ctx, types.OperationPlanCreation); err != nil {
+		return nil, err
+	}
+
 	poolAcc, err := k.GeneratePrivatePlanFarmingPoolAddress(ctx, msg.Name)
 	if err != nil {
 		return nil, err
@@ -44,6 +49,11 @@ func (k msgServer) CreateFixedAmountPlan(goCtx context.Context, msg *types.MsgCr
 // CreateRatioPlan defines a method for creating ratio farming plan.
 func (k msgServer) CreateRatioPlan(goCtx context.Context, msg *types.MsgCreateRatioPlan) (*types.MsgCreateRatioPlanResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateOperationNotPaused(ctx, types.OperationPlanCreation); err != nil {
+		return nil, err
+	}
+
 	poolAcc, err := k.GeneratePrivatePlanFarmingPoolAddress(ctx, msg.Name)
 	if err != nil {
 		return nil, err
@@ -61,14 +71,68 @@ func (k msgServer) CreateRatioPlan(goCtx context.Context, msg *types.MsgCreateRa
 	return &types.MsgCreateRatioPlanResponse{}, nil
 }
 
+// CreateDecayingPlan defines a method for creating decaying farming plan.
+func (k msgServer) CreateDecayingPlan(goCtx context.Context, msg *types.MsgCreateDecayingPlan) (*types.MsgCreateDecayingPlanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateOperationNotPaused(ctx, types.OperationPlanCreation); err != nil {
+		return nil, err
+	}
+
+	poolAcc, err := k.GeneratePrivatePlanFarmingPoolAddress(ctx, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.Keeper.CreateDecayingPlan(ctx, msg, poolAcc, msg.GetCreator(), types.PlanTypePrivate); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDecayingPlanResponse{}, nil
+}
+
+// CreateSchedulePlan defines a method for creating schedule-based farming plan.
+func (k msgServer) CreateSchedulePlan(goCtx context.Context, msg *types.MsgCreateSchedulePlan) (*types.MsgCreateSchedulePlanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateOperationNotPaused(ctx, types.OperationPlanCreation); err != nil {
+		return nil, err
+	}
+
+	poolAcc, err := k.GeneratePrivatePlanFarmingPoolAddress(ctx, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.Keeper.CreateSchedulePlan(ctx, msg, poolAcc, msg.GetCreator(), types.PlanTypePrivate); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateSchedulePlanResponse{}, nil
+}
+
 // Stake defines a method for staking coins to the farming plan.
 func (k msgServer) Stake(goCtx context.Context, msg *types.MsgStake) (*types.MsgStakeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if err := k.Keeper.Stake(ctx, msg.GetFarmer(), msg.StakingCoins); err != nil {
+	if err := k.ValidateOperationNotPaused(ctx, types.OperationStaking); err != nil {
 		return nil, err
 	}
 
+	if msg.LockDuration > 0 {
+		if err := k.Keeper.LockStake(ctx, msg.GetFarmer(), msg.StakingCoins, msg.LockDuration); err != nil {
+			return nil, err
+		}
+	} else if msg.MintReceipt {
+		if err := k.Keeper.LiquidStake(ctx, msg.GetFarmer(), msg.StakingCoins); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := k.Keeper.Stake(ctx, msg.GetFarmer(), msg.StakingCoins); err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgStakeResponse{}, nil
 }
 
@@ -76,6 +140,11 @@ func (k msgServer) Stake(goCtx context.Context, msg *types.MsgStake) (*types.Msg
 func (k msgServer) Unstake(goCtx context.Context, msg *types.MsgUnstake) (*types.MsgUnstakeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Unstaking withdraws the rewards of the staking.
+	if err := k.ValidateOperationNotPaused(ctx, types.OperationStaking, types.OperationHarvesting); err != nil {
+		return nil, err
+	}
+
 	if err := k.Keeper.Unstake(ctx, msg.GetFarmer(), msg.UnstakingCoins); err != nil {
 		return nil, err
 	}
@@ -83,10 +152,40 @@ func (k msgServer) Unstake(goCtx context.Context, msg *types.MsgUnstake) (*types
 	return &types.MsgUnstakeResponse{}, nil
 }
 
+// CancelQueuedStaking defines a method for cancelling queued coins which are not staked yet.
+func (k msgServer) CancelQueuedStaking(goCtx context.Context, msg *types.MsgCancelQueuedStaking) (*types.MsgCancelQueuedStakingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateOperationNotPaused(ctx, types.OperationStaking); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelQueuedStaking(ctx, msg.GetFarmer(), msg.QueuedCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelQueuedStakingResponse{}, nil
+}
+
+// EmergencyUnstake defines a method for withdrawing staked and queued coins while staking or harvesting is paused.
+func (k msgServer) EmergencyUnstake(goCtx context.Context, msg *types.MsgEmergencyUnstake) (*types.MsgEmergencyUnstakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.Keeper.EmergencyUnstake(ctx, msg.GetFarmer(), msg.StakingCoinDenoms); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgEmergencyUnstakeResponse{}, nil
+}
+
 // Harvest defines a method for claiming farming rewards from the farming plan.
 func (k msgServer) Harvest(goCtx context.Context, msg *types.MsgHarvest) (*types.MsgHarvestResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.ValidateOperationNotPaused(ctx, types.OperationHarvesting); err != nil {
+		return nil, err
+	}
+
 	if err := k.Keeper.Harvest(ctx, msg.GetFarmer(), msg.StakingCoinDenoms); err != nil {
 		return nil, err
 	}
@@ -94,12 +193,155 @@ func (k msgServer) Harvest(goCtx context.Context, msg *types.MsgHarvest) (*types
 	return &types.MsgHarvestResponse{}, nil
 }
 
+// ClaimVestedRewards defines a method for claiming unlocked vesting rewards.
+func (k msgServer) ClaimVestedRewards(goCtx context.Context, msg *types.MsgClaimVestedRewards) (*types.MsgClaimVestedRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateOperationNotPaused(ctx, types.OperationHarvesting); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.Keeper.ClaimVestedRewards(ctx, msg.GetFarmer()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimVestedRewardsResponse{}, nil
+}
+
+// TransferStaking defines a method for transferring staking positions to another address without unstaking.
+func (k msgServer) TransferStaking(goCtx context.Context, msg *types.MsgTransferStaking) (*types.MsgTransferStakingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Transferring withdraws the rewards of both the farmer and the recipient.
+	if err := k.ValidateOperationNotPaused(ctx, types.OperationStaking, types.OperationHarvesting); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.TransferStaking(ctx, msg.GetFarmer(), msg.GetRecipient(), msg.StakingCoinDenoms); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferStakingResponse{}, nil
+}
+
+// SyncReceiptStaking defines a method for syncing the receipt-backed staking of a farmer with the receipt coins the farmer holds.
+func (k msgServer) SyncReceiptStaking(goCtx context.Context, msg *types.MsgSyncReceiptStaking) (*types.MsgSyncReceiptStakingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Releasing the staking backed by the receipts sent away withdraws its rewards.
+	if err := k.ValidateOperationNotPaused(ctx, types.OperationStaking, types.OperationHarvesting); err != nil {
+		return nil, err
+	}
+
+	for _, denom := range msg.StakingCoinDenoms {
+		if err := k.Keeper.SyncReceiptStaking(ctx, msg.GetFarmer(), denom); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSyncReceiptStakingResponse{}, nil
+}
+
+// TerminatePrivatePlan defines a method for terminating a private plan by its creator.
+func (k msgServer) TerminatePrivatePlan(goCtx context.Context, msg *types.MsgTerminatePrivatePlan) (*types.MsgTerminatePrivatePlanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateOperationNotPaused(ctx, types.OperationPlanCreation); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.TerminatePrivatePlan(ctx, msg.GetCreator(), msg.PlanId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTerminatePrivatePlanResponse{}, nil
+}
+
+// UpdatePrivatePlan defines a method for updating a private plan by its creator.
+func (k msgServer) UpdatePrivatePlan(goCtx context.Context, msg *types.MsgUpdatePrivatePlan) (*types.MsgUpdatePrivatePlanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateOperationNotPaused(ctx, types.OperationPlanCreation); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.Keeper.UpdatePrivatePlan(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	plans := k.GetPlans(ctx)
+	if err := types.ValidateTotalEpochRatio(plans); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdatePrivatePlanResponse{}, nil
+}
+
+// AddPlanFarmers defines a method for adding farmers to the allowlist of a private plan by its creator.
+func (k msgServer) AddPlanFarmers(goCtx context.Context, msg *types.MsgAddPlanFarmers) (*types.MsgAddPlanFarmersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Changing the allowlist withdraws the rewards of the farmers.
+	if err := k.ValidateOperationNotPaused(ctx, types.OperationPlanCreation, types.OperationHarvesting); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.AddPlanFarmers(ctx, msg.GetCreator(), msg.PlanId, msg.Farmers); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddPlanFarmersResponse{}, nil
+}
+
+// RemovePlanFarmers defines a method for removing farmers from the allowlist of a private plan by its creator.
+func (k msgServer) RemovePlanFarmers(goCtx context.Context, msg *types.MsgRemovePlanFarmers) (*types.MsgRemovePlanFarmersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Changing the allowlist withdraws the rewards of the farmers.
+	if err := k.ValidateOperationNotPaused(ctx, types.OperationPlanCreation, types.OperationHarvesting); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RemovePlanFarmers(ctx, msg.GetCreator(), msg.PlanId, msg.Farmers); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemovePlanFarmersResponse{}, nil
+}
+
+// SetRewardWithdrawAddress defines a method for changing the address that the rewards of a farmer are sent to.
+func (k msgServer) SetRewardWithdrawAddress(goCtx context.Context, msg *types.MsgSetRewardWithdrawAddress) (*types.MsgSetRewardWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.SetRewardWithdrawAddress(ctx, msg.GetFarmer(), msg.GetWithdrawAddress()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRewardWithdrawAddressResponse{}, nil
+}
+
+// SetAutoCompound defines a method for enabling or disabling auto-compounding of a farmer's rewards.
+func (k msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateOperationNotPaused(ctx, types.OperationHarvesting); err != nil {
+		return nil, err
+	}
+
+	k.Keeper.SetAutoCompoundSetting(ctx, msg.GetFarmer(), msg.Enabled)
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
 // AdvanceEpoch defines a method for advancing epoch by one, just for testing purpose
 // and shouldn't be used in real world.
 func (k msgServer) AdvanceEpoch(goCtx context.Context, msg *types.MsgAdvanceEpoch) (*types.MsgAdvanceEpochResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	if EnableAdvanceEpoch {
+		if err := k.ValidateOperationNotPaused(ctx, types.OperationEpochAdvancement); err != nil {
+			return nil, err
+		}
 		if err := k.Keeper.AdvanceEpoch(ctx); err != nil {
 			return nil, err
 		}
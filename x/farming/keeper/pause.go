@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// IsOperationPaused returns whether the operation is paused by the params.
+func (k Keeper) IsOperationPaused(ctx sdk.Context, operation string) bool {
+	return k.GetParams(ctx).IsOperationPaused(operation)
+}
+
+// ValidateOperationNotPaused returns an error if any of the operations is paused.
+func (k Keeper) ValidateOperationNotPaused(ctx sdk.Context, operations ...string) error {
+	params := k.GetParams(ctx)
+	for _, operation := range operations {
+		if params.IsOperationPaused(operation) {
+			return sdkerrors.Wrapf(types.ErrOperationPaused, "%s is paused", operation)
+		}
+	}
+	return nil
+}
+
+// EmergencyUnstake withdraws all the staked and queued coins of the farmer for the
+// staking coin denoms at once, forfeiting the rewards accumulated by the staking.
+// It is only allowed while staking or harvesting is paused, in which case farmers can't
+// unstake or would lose their rewards by unstaking anyway, so that they can get their
+// principal back without relying on the reward accounting.
+// Locked stakings are unlocked regardless of their end time, and the unstaking period
+// is not applied. The receipt-backed staking is withdrawn as much as the farmer holds
+// the receipts, and the rest is left to be claimed by the holders of the receipts.
+// The forfeited rewards are removed from the outstanding rewards, and their integral
+// part is sent from the rewards reserve pool to the farming fee collector while the
+// fractional part is added to the rewards dust.
+func (k Keeper) EmergencyUnstake(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenoms []string) (sdk.Coins, error) {
+	params := k.GetParams(ctx)
+	if !params.IsOperationPaused(types.OperationStaking) && !params.IsOperationPaused(types.OperationHarvesting) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "emergency unstake is only allowed while staking or harvesting is paused")
+	}
+
+	withdrawnCoins := sdk.NewCoins()
+	forfeitedRewards := sdk.DecCoins{}
+	burningReceipts := sdk.NewCoins()
+	for _, denom := range stakingCoinDenoms {
+		if err := k.SettleQueuedStaking(ctx, denom, farmerAcc); err != nil {
+			return nil, err
+		}
+		staking, stakingFound := k.GetStaking(ctx, denom, farmerAcc)
+		queuedStaking, queuedFound := k.GetQueuedStaking(ctx, denom, farmerAcc)
+		if !stakingFound && !queuedFound {
+			return nil, sdkerrors.Wrapf(types.ErrStakingNotExists, "no staking of %s", denom)
+		}
+		if !stakingFound {
+			staking.Amount = sdk.ZeroInt()
+		}
+		if !queuedFound {
+			queuedStaking.Amount = sdk.ZeroInt()
+		}
+
+		for _, lock := range k.GetLockedStakingsByFarmer(ctx, farmerAcc, denom) {
+			k.DeleteLockedStaking(ctx, denom, farmerAcc, lock)
+		}
+
+		if stakingFound {
+			rewards := k.CalculateRewards(ctx, farmerAcc, denom, k.GetCurrentEpoch(ctx, denom)-1)
+			if !rewards.IsZero() {
+				k.DecreaseOutstandingRewards(ctx, denom, rewards)
+				forfeitedRewards = forfeitedRewards.Add(rewards...)
+			}
+			removedAmt := staking.Amount.Add(staking.GetBoostAmount())
+			k.DeleteStaking(ctx, denom, farmerAcc)
+			k.DecreaseTotalStakings(ctx, denom, removedAmt)
+			k.DecreasePlanTotalStakingsByFarmer(ctx, farmerAcc, denom, removedAmt)
+		}
+		if queuedFound {
+			k.DeleteQueuedStaking(ctx, denom, farmerAcc)
+		}
+
+		amt := staking.Amount.Add(queuedStaking.Amount)
+
+		// Receipts held by the farmer are burned, and the staking backed by
+		// receipts sent away is released to be claimed by their holders.
+		backedAmt := k.GetReceiptStaking(ctx, denom, farmerAcc)
+		if backedAmt.IsPositive() {
+			balance := k.bankKeeper.GetBalance(ctx, farmerAcc, types.ReceiptDenom(denom)).Amount
+			burningAmt := sdk.MinInt(balance, backedAmt)
+			if releasedAmt := backedAmt.Sub(burningAmt); releasedAmt.IsPositive() {
+				k.SetReceiptStaking(ctx, denom, farmerAcc, burningAmt)
+				k.SetUnclaimedReceiptStaking(ctx, denom, k.GetUnclaimedReceiptStaking(ctx, denom).Add(releasedAmt))
+				amt = amt.Sub(releasedAmt)
+			}
+			if burningAmt.IsPositive() {
+				burningReceipts = burningReceipts.Add(sdk.NewCoin(denom, burningAmt))
+			}
+		}
+
+		withdrawnCoins = withdrawnCoins.Add(sdk.NewCoin(denom, amt))
+	}
+
+	if !burningReceipts.IsZero() {
+		if err := k.BurnReceipts(ctx, farmerAcc, burningReceipts); err != nil {
+			return nil, err
+		}
+	}
+
+	forfeitedCoins, _ := forfeitedRewards.TruncateDecimal()
+	if !forfeitedCoins.IsZero() {
+		feeCollectorAcc, err := sdk.AccAddressFromBech32(k.GetParams(ctx).FarmingFeeCollector)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, k.GetRewardsReservePoolAcc(ctx), feeCollectorAcc, forfeitedCoins); err != nil {
+			return nil, err
+		}
+	}
+	k.AddRewardsDust(ctx, forfeitedRewards, forfeitedCoins)
+
+	if !withdrawnCoins.IsZero() {
+		if err := k.ReleaseStakingCoins(ctx, farmerAcc, withdrawnCoins); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeEmergencyUnstake,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawnCoins, withdrawnCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyForfeitedCoins, forfeitedCoins.String()),
+		),
+	})
+
+	k.AfterUnstake(ctx, farmerAcc, withdrawnCoins)
+
+	return withdrawnCoins, nil
+}
@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/keeper"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+func (suite *KeeperTestSuite) SetPausedOperations(operations ...string) {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.PausedOperations = operations
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+func (suite *KeeperTestSuite) TestPausedOperations() {
+	suite.SetPausedOperations(types.OperationStaking, types.OperationPlanCreation)
+
+	suite.Require().True(suite.keeper.IsOperationPaused(suite.ctx, types.OperationStaking))
+	suite.Require().True(suite.keeper.IsOperationPaused(suite.ctx, types.OperationPlanCreation))
+	suite.Require().False(suite.keeper.IsOperationPaused(suite.ctx, types.OperationHarvesting))
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := msgServer.Stake(goCtx, types.NewMsgStake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000))))
+	suite.Require().ErrorIs(err, types.ErrOperationPaused)
+
+	_, err = msgServer.CreateFixedAmountPlan(goCtx, types.NewMsgCreateFixedAmountPlan(
+		"plan", suite.addrs[4],
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+		types.ParseTime("2021-08-01T00:00:00Z"), types.ParseTime("2021-08-30T00:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)),
+	))
+	suite.Require().ErrorIs(err, types.ErrOperationPaused)
+
+	_, err = msgServer.Harvest(goCtx, types.NewMsgHarvest(suite.addrs[0], []string{denom1}))
+	suite.Require().ErrorIs(err, types.ErrStakingNotExists)
+
+	// Operations are resumed once the params are reset.
+	suite.SetPausedOperations()
+	_, err = msgServer.Stake(goCtx, types.NewMsgStake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000))))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestPausedOperations_Harvesting() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom1: 1000000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.keeper.SetAutoCompoundSetting(suite.ctx, suite.addrs[0], true)
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	suite.SetPausedOperations(types.OperationHarvesting)
+
+	_, err := keeper.NewMsgServerImpl(suite.keeper).Harvest(sdk.WrapSDKContext(suite.ctx), types.NewMsgHarvest(suite.addrs[0], []string{denom1}))
+	suite.Require().ErrorIs(err, types.ErrOperationPaused)
+
+	// Auto-compounding is skipped while harvesting is paused.
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 2000000)),
+		suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)),
+		suite.keeper.AllRewards(suite.ctx, suite.addrs[0])))
+
+	// The messages withdrawing the rewards as a side effect are rejected too.
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	_, err = msgServer.Unstake(goCtx, types.NewMsgUnstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000))))
+	suite.Require().ErrorIs(err, types.ErrOperationPaused)
+	_, err = msgServer.TransferStaking(goCtx, types.NewMsgTransferStaking(suite.addrs[0], suite.addrs[1], []string{denom1}))
+	suite.Require().ErrorIs(err, types.ErrOperationPaused)
+	_, err = msgServer.SyncReceiptStaking(goCtx, types.NewMsgSyncReceiptStaking(suite.addrs[0], []string{denom1}))
+	suite.Require().ErrorIs(err, types.ErrOperationPaused)
+	_, err = msgServer.AddPlanFarmers(goCtx, types.NewMsgAddPlanFarmers(suite.addrs[4], 1, []string{suite.addrs[0].String()}))
+	suite.Require().ErrorIs(err, types.ErrOperationPaused)
+	_, err = msgServer.RemovePlanFarmers(goCtx, types.NewMsgRemovePlanFarmers(suite.addrs[4], 1, []string{suite.addrs[0].String()}))
+	suite.Require().ErrorIs(err, types.ErrOperationPaused)
+	_, err = msgServer.SetAutoCompound(goCtx, types.NewMsgSetAutoCompound(suite.addrs[0], false))
+	suite.Require().ErrorIs(err, types.ErrOperationPaused)
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)),
+		suite.keeper.AllRewards(suite.ctx, suite.addrs[0])))
+}
+
+func (suite *KeeperTestSuite) TestPausedOperations_PlanCreation() {
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := msgServer.CreateFixedAmountPlan(goCtx, types.NewMsgCreateFixedAmountPlan(
+		"plan", suite.addrs[4],
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+		types.ParseTime("2021-08-01T00:00:00Z"), types.ParseTime("2021-08-30T00:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)),
+	))
+	suite.Require().NoError(err)
+	plans := suite.keeper.GetPlans(suite.ctx)
+	planId := plans[len(plans)-1].GetId()
+
+	suite.SetPausedOperations(types.OperationPlanCreation)
+
+	// The private plans can't be changed by their creators while plan creation is paused.
+	_, err = msgServer.UpdatePrivatePlan(goCtx, types.NewMsgUpdatePrivatePlan(
+		suite.addrs[4], planId, "new-plan", nil, nil,
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 2000000)), sdk.Dec{}, sdk.Dec{}, 0, nil,
+	))
+	suite.Require().ErrorIs(err, types.ErrOperationPaused)
+	_, err = msgServer.TerminatePrivatePlan(goCtx, types.NewMsgTerminatePrivatePlan(suite.addrs[4], planId))
+	suite.Require().ErrorIs(err, types.ErrOperationPaused)
+	_, err = msgServer.AddPlanFarmers(goCtx, types.NewMsgAddPlanFarmers(suite.addrs[4], planId, []string{suite.addrs[0].String()}))
+	suite.Require().ErrorIs(err, types.ErrOperationPaused)
+
+	plan, found := suite.keeper.GetPlan(suite.ctx, planId)
+	suite.Require().True(found)
+	suite.Require().Equal("plan", plan.GetName())
+	suite.Require().False(plan.GetTerminated())
+
+	suite.SetPausedOperations()
+	_, err = msgServer.TerminatePrivatePlan(goCtx, types.NewMsgTerminatePrivatePlan(suite.addrs[4], planId))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestEmergencyUnstake() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetUnstakingPeriod(7 * 24 * time.Hour)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	err := suite.keeper.LockStake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)), 30*24*time.Hour)
+	suite.Require().NoError(err)
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 300000)))
+	suite.Require().False(suite.keeper.Rewards(suite.ctx, suite.addrs[0], denom1).IsZero())
+
+	// Emergency unstake is not allowed unless any operation is paused.
+	_, err = suite.keeper.EmergencyUnstake(suite.ctx, suite.addrs[0], []string{denom1})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// Pausing the operations unrelated to the stakings doesn't allow emergency unstake.
+	suite.SetPausedOperations(types.OperationPlanCreation, types.OperationEpochAdvancement)
+	_, err = suite.keeper.EmergencyUnstake(suite.ctx, suite.addrs[0], []string{denom1})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	suite.SetPausedOperations(types.OperationStaking)
+
+	_, err = suite.keeper.EmergencyUnstake(suite.ctx, suite.addrs[0], []string{denom2})
+	suite.Require().ErrorIs(err, types.ErrStakingNotExists)
+
+	forfeited := suite.keeper.CalculateRewards(suite.ctx, suite.addrs[0], denom1, suite.keeper.GetCurrentEpoch(suite.ctx, denom1)-1)
+	outstandingBefore, _ := suite.keeper.GetOutstandingRewards(suite.ctx, denom1)
+	feeCollectorAcc, _ := sdk.AccAddressFromBech32(suite.keeper.GetParams(suite.ctx).FarmingFeeCollector)
+	feeCollectorBalancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollectorAcc)
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	withdrawn, err := suite.keeper.EmergencyUnstake(suite.ctx, suite.addrs[0], []string{denom1})
+	suite.Require().NoError(err)
+
+	// The forfeited rewards are no longer outstanding, and are sent to the fee collector.
+	forfeitedCoins, _ := forfeited.TruncateDecimal()
+	suite.Require().False(forfeitedCoins.IsZero())
+	outstanding, _ := suite.keeper.GetOutstandingRewards(suite.ctx, denom1)
+	suite.Require().True(decCoinsEq(outstandingBefore.Rewards.Sub(forfeited), outstanding.Rewards))
+	suite.Require().True(coinsEq(
+		feeCollectorBalancesBefore.Add(forfeitedCoins...),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollectorAcc)))
+
+	// The staked, locked and queued coins are all released immediately without rewards.
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1800000)), withdrawn))
+	suite.Require().True(coinsEq(balancesBefore.Add(withdrawn...), suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+	suite.Require().True(suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+	suite.Require().True(suite.keeper.GetAllQueuedStakedCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+	suite.Require().Empty(suite.keeper.GetLockedStakingsByFarmer(suite.ctx, suite.addrs[0], denom1))
+	_, found := suite.keeper.GetUnbondingStaking(suite.ctx, suite.addrs[0])
+	suite.Require().False(found)
+
+	totalStakings, _ := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(intEq(sdk.NewInt(1000000), totalStakings.Amount))
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	// The other farmers are not affected, and take all the rewards from now on.
+	rewards := suite.keeper.AllRewards(suite.ctx, suite.addrs[1])
+	suite.SetPausedOperations()
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(
+		rewards.Add(sdk.NewInt64Coin(denom3, 1000000)),
+		suite.keeper.AllRewards(suite.ctx, suite.addrs[1])))
+}
+
+func (suite *KeeperTestSuite) TestEmergencyUnstake_Receipt() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+
+	suite.LiquidStake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)))
+	suite.AdvanceEpoch()
+	suite.SendReceipts(suite.addrs[0], suite.addrs[1], denom1, 400000)
+
+	suite.SetPausedOperations(types.OperationHarvesting)
+
+	withdrawn, err := suite.keeper.EmergencyUnstake(suite.ctx, suite.addrs[0], []string{denom1})
+	suite.Require().NoError(err)
+
+	// The staking backed by the receipts sent away is left to their holder.
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1100000)), withdrawn))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[0], types.ReceiptDenom(denom1)).IsZero())
+	suite.Require().True(suite.keeper.GetReceiptStaking(suite.ctx, denom1, suite.addrs[0]).IsZero())
+	suite.Require().True(intEq(sdk.NewInt(400000), suite.keeper.GetUnclaimedReceiptStaking(suite.ctx, denom1)))
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	err = suite.keeper.SyncReceiptStaking(suite.ctx, suite.addrs[1], denom1)
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 400000)),
+		suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[1])))
+}
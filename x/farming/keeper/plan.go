@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"sort"
 	"strconv"
+	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
 
@@ -42,6 +44,7 @@ func (k Keeper) GetPlans(ctx sdk.Context) (plans []types.PlanI) {
 }
 
 // SetPlan implements PlanI.
+// The end time queue and the active plan index are updated along with the plan.
 func (k Keeper) SetPlan(ctx sdk.Context, plan types.PlanI) {
 	id := plan.GetId()
 	store := ctx.KVStore(k.storeKey)
@@ -52,6 +55,12 @@ func (k Keeper) SetPlan(ctx sdk.Context, plan types.PlanI) {
 	}
 
 	store.Set(types.GetPlanKey(id), bz)
+
+	k.deletePlanIndexes(ctx, id)
+	if !plan.GetTerminated() {
+		store.Set(types.GetActivePlanKey(id), sdk.FormatTimeBytes(plan.GetEndTime()))
+		store.Set(types.GetPlanEndTimeQueueKey(plan.GetEndTime(), id), []byte{})
+	}
 }
 
 // RemovePlan removes an plan for the plan mapper store.
@@ -60,6 +69,84 @@ func (k Keeper) RemovePlan(ctx sdk.Context, plan types.PlanI) {
 	id := plan.GetId()
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetPlanKey(id))
+	k.deletePlanIndexes(ctx, id)
+}
+
+// deletePlanIndexes deletes the plan from the end time queue and the active plan index.
+// The active plan index holds the end time of the plan, which is used to find the
+// plan in the end time queue even after the end time of the plan is updated.
+func (k Keeper) deletePlanIndexes(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetActivePlanKey(id))
+	if bz == nil {
+		return
+	}
+	endTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	store.Delete(types.GetPlanEndTimeQueueKey(endTime, id))
+	store.Delete(types.GetActivePlanKey(id))
+}
+
+// IterateActivePlans iterates over all the plans which are not terminated yet,
+// including the plans which have not started, and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IterateActivePlans(ctx sdk.Context, cb func(plan types.PlanI) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ActivePlanKeyPrefix)
+
+	// The index keys of missing plans are stale, and are deleted after the iteration.
+	var staleIds []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		id := types.ParseActivePlanKey(iterator.Key())
+		plan, found := k.GetPlan(ctx, id)
+		if !found {
+			staleIds = append(staleIds, id)
+			continue
+		}
+
+		if cb(plan) {
+			break
+		}
+	}
+	iterator.Close()
+
+	for _, id := range staleIds {
+		k.deletePlanIndexes(ctx, id)
+	}
+}
+
+// GetEndedPlans returns all the plans which are not terminated yet and
+// whose end time is before given time t, in order of their ids.
+func (k Keeper) GetEndedPlans(ctx sdk.Context, t time.Time) (plans []types.PlanI) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.PlanEndTimeQueueKeyPrefix, types.GetPlanEndTimeQueueByTimePrefix(t))
+
+	// The index keys of missing plans are stale, and are deleted after the iteration.
+	var staleKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		_, id := types.ParsePlanEndTimeQueueKey(iterator.Key())
+		plan, found := k.GetPlan(ctx, id)
+		if !found {
+			staleKeys = append(staleKeys, iterator.Key())
+			continue
+		}
+		plans = append(plans, plan)
+	}
+	iterator.Close()
+
+	for _, key := range staleKeys {
+		_, id := types.ParsePlanEndTimeQueueKey(key)
+		store.Delete(key)
+		k.deletePlanIndexes(ctx, id)
+	}
+
+	sort.Slice(plans, func(i, j int) bool {
+		return plans[i].GetId() < plans[j].GetId()
+	})
+
+	return plans
 }
 
 // IteratePlans iterates over all the stored plans and performs a callback function.
@@ -166,6 +253,7 @@ func (k Keeper) CreateFixedAmountPlan(ctx sdk.Context, msg *types.MsgCreateFixed
 		msg.StartTime,
 		msg.EndTime,
 	)
+	basePlan.RewardVestingDuration = msg.RewardVestingDuration
 
 	fixedPlan := types.NewFixedAmountPlan(basePlan, msg.EpochAmount)
 
@@ -217,6 +305,7 @@ func (k Keeper) CreateRatioPlan(ctx sdk.Context, msg *types.MsgCreateRatioPlan,
 		msg.StartTime,
 		msg.EndTime,
 	)
+	basePlan.RewardVestingDuration = msg.RewardVestingDuration
 
 	ratioPlan := types.NewRatioPlan(basePlan, msg.EpochRatio)
 
@@ -237,6 +326,112 @@ func (k Keeper) CreateRatioPlan(ctx sdk.Context, msg *types.MsgCreateRatioPlan,
 	return ratioPlan, nil
 }
 
+// CreateDecayingPlan sets decaying plan.
+func (k Keeper) CreateDecayingPlan(ctx sdk.Context, msg *types.MsgCreateDecayingPlan, farmingPoolAcc, terminationAcc sdk.AccAddress, typ types.PlanType) (types.PlanI, error) {
+	nextId := k.GetNextPlanIdWithUpdate(ctx)
+	if typ == types.PlanTypePrivate {
+		params := k.GetParams(ctx)
+		balances := k.bankKeeper.GetAllBalances(ctx, msg.GetCreator())
+		diffs, hasNeg := balances.SafeSub(params.PrivatePlanCreationFee)
+		if hasNeg {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "lack of %s coins to pay private plan creation fee", diffs.String())
+		}
+
+		farmingFeeCollectorAcc, err := sdk.AccAddressFromBech32(params.FarmingFeeCollector)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, msg.GetCreator(), farmingFeeCollectorAcc, params.PrivatePlanCreationFee); err != nil {
+			return nil, err
+		}
+	}
+
+	basePlan := types.NewBasePlan(
+		nextId,
+		msg.Name,
+		typ,
+		farmingPoolAcc.String(),
+		terminationAcc.String(),
+		msg.StakingCoinWeights,
+		msg.StartTime,
+		msg.EndTime,
+	)
+	basePlan.RewardVestingDuration = msg.RewardVestingDuration
+
+	decayingPlan := types.NewDecayingPlan(basePlan, msg.EpochAmount, msg.DecayRate, msg.DecayEpochs)
+
+	k.SetPlan(ctx, decayingPlan)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateDecayingPlan,
+			sdk.NewAttribute(types.AttributeKeyPlanId, strconv.FormatUint(nextId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPlanName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyFarmingPoolAddress, farmingPoolAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, msg.StartTime.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, msg.EndTime.String()),
+			sdk.NewAttribute(types.AttributeKeyEpochAmount, msg.EpochAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyDecayRate, msg.DecayRate.String()),
+			sdk.NewAttribute(types.AttributeKeyDecayEpochs, strconv.FormatUint(uint64(msg.DecayEpochs), 10)),
+		),
+	})
+
+	return decayingPlan, nil
+}
+
+// CreateSchedulePlan sets schedule plan.
+func (k Keeper) CreateSchedulePlan(ctx sdk.Context, msg *types.MsgCreateSchedulePlan, farmingPoolAcc, terminationAcc sdk.AccAddress, typ types.PlanType) (types.PlanI, error) {
+	nextId := k.GetNextPlanIdWithUpdate(ctx)
+	if typ == types.PlanTypePrivate {
+		params := k.GetParams(ctx)
+		balances := k.bankKeeper.GetAllBalances(ctx, msg.GetCreator())
+		diffs, hasNeg := balances.SafeSub(params.PrivatePlanCreationFee)
+		if hasNeg {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "lack of %s coins to pay private plan creation fee", diffs.String())
+		}
+
+		farmingFeeCollectorAcc, err := sdk.AccAddressFromBech32(params.FarmingFeeCollector)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, msg.GetCreator(), farmingFeeCollectorAcc, params.PrivatePlanCreationFee); err != nil {
+			return nil, err
+		}
+	}
+
+	basePlan := types.NewBasePlan(
+		nextId,
+		msg.Name,
+		typ,
+		farmingPoolAcc.String(),
+		terminationAcc.String(),
+		msg.StakingCoinWeights,
+		msg.StartTime,
+		msg.EndTime,
+	)
+	basePlan.RewardVestingDuration = msg.RewardVestingDuration
+
+	schedulePlan := types.NewSchedulePlan(basePlan, msg.Phases)
+
+	k.SetPlan(ctx, schedulePlan)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateSchedulePlan,
+			sdk.NewAttribute(types.AttributeKeyPlanId, strconv.FormatUint(nextId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPlanName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyFarmingPoolAddress, farmingPoolAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, msg.StartTime.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, msg.EndTime.String()),
+			sdk.NewAttribute(types.AttributeKeyPhases, strconv.Itoa(len(msg.Phases))),
+		),
+	})
+
+	return schedulePlan, nil
+}
+
 // TerminatePlan sends all remaining coins in the plan's farming pool to
 // the termination address and mark the plan as terminated.
 func (k Keeper) TerminatePlan(ctx sdk.Context, plan types.PlanI) error {
@@ -262,9 +457,104 @@ func (k Keeper) TerminatePlan(ctx sdk.Context, plan types.PlanI) error {
 		),
 	})
 
+	k.AfterPlanTerminated(ctx, plan)
+
 	return nil
 }
 
+// TerminatePrivatePlan terminates a private plan before its end time.
+// Only the creator of the plan, which is the plan's termination address, can terminate it.
+func (k Keeper) TerminatePrivatePlan(ctx sdk.Context, creatorAcc sdk.AccAddress, planId uint64) error {
+	plan, found := k.GetPlan(ctx, planId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrPlanNotExists, "plan %d is not found", planId)
+	}
+
+	if plan.GetType() != types.PlanTypePrivate {
+		return sdkerrors.Wrapf(types.ErrInvalidPlanType, "plan %d is not a private plan", planId)
+	}
+
+	if !plan.GetTerminationAddress().Equals(creatorAcc) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "plan %d can only be terminated by its creator", planId)
+	}
+
+	if plan.GetTerminated() {
+		return sdkerrors.Wrapf(types.ErrAlreadyTerminatedPlan, "plan %d", planId)
+	}
+
+	return k.TerminatePlan(ctx, plan)
+}
+
+// UpdatePrivatePlan overwrites the private plan with the given fields of the message.
+// Only the creator of the plan, which is the plan's termination address, can update it.
+func (k Keeper) UpdatePrivatePlan(ctx sdk.Context, msg *types.MsgUpdatePrivatePlan) (types.PlanI, error) {
+	plan, found := k.GetPlan(ctx, msg.PlanId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrPlanNotExists, "plan %d is not found", msg.PlanId)
+	}
+
+	if plan.GetType() != types.PlanTypePrivate {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPlanType, "plan %d is not a private plan", msg.PlanId)
+	}
+
+	if !plan.GetTerminationAddress().Equals(msg.GetCreator()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "plan %d can only be updated by its creator", msg.PlanId)
+	}
+
+	if plan.GetTerminated() {
+		return nil, sdkerrors.Wrapf(types.ErrAlreadyTerminatedPlan, "plan %d", msg.PlanId)
+	}
+
+	// A schedule plan is only updated with new phases, so that its schedule is not lost unintentionally.
+	if _, ok := plan.(*types.SchedulePlan); ok && !msg.IsForSchedulePlan() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSchedulePhases, "phases must be provided to update schedule plan %d", msg.PlanId)
+	}
+
+	if msg.Name != "" {
+		if err := plan.SetName(msg.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := plan.SetStakingCoinWeights(msg.StakingCoinWeights); err != nil {
+		return nil, err
+	}
+
+	if msg.EndTime != nil {
+		if err := plan.SetEndTime(*msg.EndTime); err != nil {
+			return nil, err
+		}
+	}
+
+	// change the plan type if needed
+	if msg.IsForSchedulePlan() {
+		plan = types.NewSchedulePlan(plan.GetBasePlan(), msg.Phases)
+	} else if msg.IsForDecayingPlan() {
+		plan = types.NewDecayingPlanFrom(plan, msg.EpochAmount, msg.DecayRate, msg.DecayEpochs)
+	} else if msg.IsForFixedAmountPlan() {
+		plan = types.NewFixedAmountPlan(plan.GetBasePlan(), msg.EpochAmount)
+	} else {
+		plan = types.NewRatioPlan(plan.GetBasePlan(), msg.EpochRatio)
+	}
+
+	if err := plan.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetPlan(ctx, plan)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdatePrivatePlan,
+			sdk.NewAttribute(types.AttributeKeyPlanId, strconv.FormatUint(plan.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyPlanName, plan.GetName()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, plan.GetEndTime().String()),
+		),
+	})
+
+	return plan, nil
+}
+
 func (k Keeper) GeneratePrivatePlanFarmingPoolAddress(ctx sdk.Context, name string) (sdk.AccAddress, error) {
 	nextPlanId := k.GetGlobalPlanId(ctx) + 1
 	poolAcc := types.PrivatePlanFarmingPoolAddress(name, nextPlanId)
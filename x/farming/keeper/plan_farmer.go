@@ -0,0 +1,255 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// IsPlanFarmer returns if the farmer is in the allowlist of the plan.
+func (k Keeper) IsPlanFarmer(ctx sdk.Context, planId uint64, farmerAcc sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetPlanFarmerKey(planId, farmerAcc))
+}
+
+// SetPlanFarmer adds the farmer to the allowlist of the plan.
+func (k Keeper) SetPlanFarmer(ctx sdk.Context, planId uint64, farmerAcc sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPlanFarmerKey(planId, farmerAcc), []byte{})
+	store.Set(types.GetPlanFarmerIndexKey(farmerAcc, planId), []byte{})
+}
+
+// DeletePlanFarmer removes the farmer from the allowlist of the plan.
+func (k Keeper) DeletePlanFarmer(ctx sdk.Context, planId uint64, farmerAcc sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPlanFarmerKey(planId, farmerAcc))
+	store.Delete(types.GetPlanFarmerIndexKey(farmerAcc, planId))
+}
+
+// IteratePlanFarmers iterates through the allowlists of all plans
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IteratePlanFarmers(ctx sdk.Context, cb func(planId uint64, farmerAcc sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PlanFarmerKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		planId, farmerAcc := types.ParsePlanFarmerKey(iter.Key())
+		if cb(planId, farmerAcc) {
+			break
+		}
+	}
+}
+
+// IteratePlansByFarmer iterates through all plans which have the farmer in their allowlists
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IteratePlansByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress, cb func(planId uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPlanFarmerIndexByFarmerPrefix(farmerAcc))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, planId := types.ParsePlanFarmerIndexKey(iter.Key())
+		if cb(planId) {
+			break
+		}
+	}
+}
+
+// GetPlanTotalStakings returns the total stakings of the farmers in the allowlist of the plan.
+func (k Keeper) GetPlanTotalStakings(ctx sdk.Context, planId uint64, stakingCoinDenom string) (totalStakings types.TotalStakings, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPlanTotalStakingsKey(planId, stakingCoinDenom))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &totalStakings)
+	found = true
+	return
+}
+
+// SetPlanTotalStakings sets the total stakings of the farmers in the allowlist of the plan.
+func (k Keeper) SetPlanTotalStakings(ctx sdk.Context, planId uint64, stakingCoinDenom string, totalStakings types.TotalStakings) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&totalStakings)
+	store.Set(types.GetPlanTotalStakingsKey(planId, stakingCoinDenom), bz)
+}
+
+// DeletePlanTotalStakings deletes the total stakings of the farmers in the allowlist of the plan.
+func (k Keeper) DeletePlanTotalStakings(ctx sdk.Context, planId uint64, stakingCoinDenom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPlanTotalStakingsKey(planId, stakingCoinDenom))
+}
+
+// IteratePlanTotalStakings iterates through the total stakings of all restricted plans
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IteratePlanTotalStakings(ctx sdk.Context, cb func(planId uint64, stakingCoinDenom string, totalStakings types.TotalStakings) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PlanTotalStakingKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var totalStakings types.TotalStakings
+		k.cdc.MustUnmarshal(iter.Value(), &totalStakings)
+		planId, stakingCoinDenom := types.ParsePlanTotalStakingsKey(iter.Key())
+		if cb(planId, stakingCoinDenom, totalStakings) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IncreasePlanTotalStakings(ctx sdk.Context, planId uint64, stakingCoinDenom string, amount sdk.Int) {
+	totalStakings, found := k.GetPlanTotalStakings(ctx, planId, stakingCoinDenom)
+	if !found {
+		totalStakings.Amount = sdk.ZeroInt()
+	}
+	totalStakings.Amount = totalStakings.Amount.Add(amount)
+	k.SetPlanTotalStakings(ctx, planId, stakingCoinDenom, totalStakings)
+}
+
+func (k Keeper) DecreasePlanTotalStakings(ctx sdk.Context, planId uint64, stakingCoinDenom string, amount sdk.Int) {
+	totalStakings, found := k.GetPlanTotalStakings(ctx, planId, stakingCoinDenom)
+	if !found {
+		panic("plan total stakings not found")
+	}
+	if totalStakings.Amount.Equal(amount) {
+		k.DeletePlanTotalStakings(ctx, planId, stakingCoinDenom)
+	} else {
+		totalStakings.Amount = totalStakings.Amount.Sub(amount)
+		k.SetPlanTotalStakings(ctx, planId, stakingCoinDenom, totalStakings)
+	}
+}
+
+// IncreasePlanTotalStakingsByFarmer increases the total stakings of all plans
+// which have the farmer in their allowlists.
+// It must be called whenever the staking of the farmer increases.
+func (k Keeper) IncreasePlanTotalStakingsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, amount sdk.Int) {
+	k.IteratePlansByFarmer(ctx, farmerAcc, func(planId uint64) (stop bool) {
+		k.IncreasePlanTotalStakings(ctx, planId, stakingCoinDenom, amount)
+		return false
+	})
+}
+
+// DecreasePlanTotalStakingsByFarmer decreases the total stakings of all plans
+// which have the farmer in their allowlists.
+// It must be called whenever the staking of the farmer decreases.
+func (k Keeper) DecreasePlanTotalStakingsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, amount sdk.Int) {
+	k.IteratePlansByFarmer(ctx, farmerAcc, func(planId uint64) (stop bool) {
+		k.DecreasePlanTotalStakings(ctx, planId, stakingCoinDenom, amount)
+		return false
+	})
+}
+
+// getRestrictablePlan returns the private plan which can be restricted by the creator.
+func (k Keeper) getRestrictablePlan(ctx sdk.Context, creatorAcc sdk.AccAddress, planId uint64) (types.PlanI, error) {
+	plan, found := k.GetPlan(ctx, planId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrPlanNotExists, "plan %d is not found", planId)
+	}
+
+	if plan.GetType() != types.PlanTypePrivate {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPlanType, "plan %d is not a private plan", planId)
+	}
+
+	if !plan.GetTerminationAddress().Equals(creatorAcc) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "allowlist of plan %d can only be modified by its creator", planId)
+	}
+
+	if plan.GetTerminated() {
+		return nil, sdkerrors.Wrapf(types.ErrAlreadyTerminatedPlan, "plan %d", planId)
+	}
+
+	return plan, nil
+}
+
+// AddPlanFarmers adds the farmers to the allowlist of the private plan and makes the plan restricted,
+// so that the rewards of the plan are distributed only to the farmers in the allowlist.
+// Only the creator of the plan, which is the plan's termination address, can add farmers.
+// The rewards of the farmers are withdrawn before they are added.
+func (k Keeper) AddPlanFarmers(ctx sdk.Context, creatorAcc sdk.AccAddress, planId uint64, farmers []string) error {
+	plan, err := k.getRestrictablePlan(ctx, creatorAcc, planId)
+	if err != nil {
+		return err
+	}
+
+	for _, farmer := range farmers {
+		farmerAcc, err := sdk.AccAddressFromBech32(farmer)
+		if err != nil {
+			return err
+		}
+
+		if k.IsPlanFarmer(ctx, planId, farmerAcc) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "farmer %s is already in the allowlist of plan %d", farmer, planId)
+		}
+
+		if _, err := k.WithdrawAllRewards(ctx, farmerAcc); err != nil {
+			return err
+		}
+
+		k.SetPlanFarmer(ctx, planId, farmerAcc)
+		k.IterateStakingsByFarmer(ctx, farmerAcc, func(stakingCoinDenom string, staking types.Staking) (stop bool) {
+			k.IncreasePlanTotalStakings(ctx, planId, stakingCoinDenom, staking.BoostedAmount())
+			return false
+		})
+	}
+
+	if !plan.GetRestricted() {
+		_ = plan.SetRestricted(true)
+		k.SetPlan(ctx, plan)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddPlanFarmers,
+			sdk.NewAttribute(types.AttributeKeyPlanId, strconv.FormatUint(planId, 10)),
+			sdk.NewAttribute(types.AttributeKeyFarmers, strings.Join(farmers, ",")),
+		),
+	})
+
+	return nil
+}
+
+// RemovePlanFarmers removes the farmers from the allowlist of the restricted plan.
+// The plan remains restricted even if its allowlist becomes empty.
+// Only the creator of the plan, which is the plan's termination address, can remove farmers.
+// The rewards of the farmers are withdrawn before they are removed.
+func (k Keeper) RemovePlanFarmers(ctx sdk.Context, creatorAcc sdk.AccAddress, planId uint64, farmers []string) error {
+	if _, err := k.getRestrictablePlan(ctx, creatorAcc, planId); err != nil {
+		return err
+	}
+
+	for _, farmer := range farmers {
+		farmerAcc, err := sdk.AccAddressFromBech32(farmer)
+		if err != nil {
+			return err
+		}
+
+		if !k.IsPlanFarmer(ctx, planId, farmerAcc) {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "farmer %s is not in the allowlist of plan %d", farmer, planId)
+		}
+
+		if _, err := k.WithdrawAllRewards(ctx, farmerAcc); err != nil {
+			return err
+		}
+
+		k.IterateStakingsByFarmer(ctx, farmerAcc, func(stakingCoinDenom string, staking types.Staking) (stop bool) {
+			k.DecreasePlanTotalStakings(ctx, planId, stakingCoinDenom, staking.BoostedAmount())
+			return false
+		})
+		k.DeletePlanFarmer(ctx, planId, farmerAcc)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemovePlanFarmers,
+			sdk.NewAttribute(types.AttributeKeyPlanId, strconv.FormatUint(planId, 10)),
+			sdk.NewAttribute(types.AttributeKeyFarmers, strings.Join(farmers, ",")),
+		),
+	})
+
+	return nil
+}
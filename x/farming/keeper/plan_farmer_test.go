@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/keeper"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+func (suite *KeeperTestSuite) SetPlanType(planId uint64, typ types.PlanType) {
+	plan, found := suite.keeper.GetPlan(suite.ctx, planId)
+	suite.Require().True(found)
+	suite.Require().NoError(plan.SetType(typ))
+	suite.keeper.SetPlan(suite.ctx, plan)
+}
+
+func (suite *KeeperTestSuite) TestRestrictedPlan() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetFixedAmountPlan(2, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetPlanType(2, types.PlanTypePrivate)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+
+	err := suite.keeper.AddPlanFarmers(suite.ctx, suite.addrs[4], 2, []string{suite.addrs[0].String()})
+	suite.Require().NoError(err)
+
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 2)
+	suite.Require().True(plan.GetRestricted())
+	suite.Require().True(suite.keeper.IsPlanFarmer(suite.ctx, 2, suite.addrs[0]))
+	planTotalStakings, found := suite.keeper.GetPlanTotalStakings(suite.ctx, 2, denom1)
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(1000000), planTotalStakings.Amount))
+
+	// The rewards of the restricted plan are given only to the farmer in the allowlist.
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1500000)), suite.keeper.AllRewards(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000)), suite.keeper.AllRewards(suite.ctx, suite.addrs[1])))
+
+	// Unstaking decreases the plan total stakings as well.
+	err = suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)))
+	suite.Require().NoError(err)
+	planTotalStakings, _ = suite.keeper.GetPlanTotalStakings(suite.ctx, 2, denom1)
+	suite.Require().True(intEq(sdk.NewInt(500000), planTotalStakings.Amount))
+
+	// The rewards are withdrawn when the farmer is removed from the allowlist.
+	suite.AdvanceEpoch()
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	err = suite.keeper.RemovePlanFarmers(suite.ctx, suite.addrs[4], 2, []string{suite.addrs[0].String()})
+	suite.Require().NoError(err)
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(balancesBefore.Add(sdk.NewInt64Coin(denom3, 1333333)), balancesAfter))
+	suite.Require().False(suite.keeper.IsPlanFarmer(suite.ctx, 2, suite.addrs[0]))
+	_, found = suite.keeper.GetPlanTotalStakings(suite.ctx, 2, denom1)
+	suite.Require().False(found)
+
+	// The plan remains restricted, so no one gets its rewards.
+	plan, _ = suite.keeper.GetPlan(suite.ctx, 2)
+	suite.Require().True(plan.GetRestricted())
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 333333)), suite.keeper.AllRewards(suite.ctx, suite.addrs[0])))
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestAddPlanFarmers_Errors() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetFixedAmountPlan(2, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetPlanType(2, types.PlanTypePrivate)
+
+	farmers := []string{suite.addrs[0].String()}
+
+	err := suite.keeper.AddPlanFarmers(suite.ctx, suite.addrs[4], 3, farmers)
+	suite.Require().ErrorIs(err, types.ErrPlanNotExists)
+
+	err = suite.keeper.AddPlanFarmers(suite.ctx, suite.addrs[4], 1, farmers)
+	suite.Require().ErrorIs(err, types.ErrInvalidPlanType)
+
+	err = suite.keeper.AddPlanFarmers(suite.ctx, suite.addrs[0], 2, farmers)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	err = suite.keeper.RemovePlanFarmers(suite.ctx, suite.addrs[4], 2, farmers)
+	suite.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	suite.Require().NoError(suite.keeper.AddPlanFarmers(suite.ctx, suite.addrs[4], 2, farmers))
+	err = suite.keeper.AddPlanFarmers(suite.ctx, suite.addrs[4], 2, farmers)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (suite *KeeperTestSuite) TestRestrictedPlan_Genesis() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetPlanType(1, types.PlanTypePrivate)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Require().NoError(suite.keeper.AddPlanFarmers(suite.ctx, suite.addrs[4], 1, []string{suite.addrs[0].String()}))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genState.PlanFarmerRecords, 1)
+
+	bz, err := suite.app.AppCodec().MarshalJSON(genState)
+	suite.Require().NoError(err)
+	var genState2 types.GenesisState
+	suite.Require().NoError(suite.app.AppCodec().UnmarshalJSON(bz, &genState2))
+	suite.Require().NoError(types.ValidateGenesis(genState2))
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, genState2)
+	})
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+
+	planTotalStakings, found := suite.keeper.GetPlanTotalStakings(suite.ctx, 1, denom1)
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(1000000), planTotalStakings.Amount))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), suite.keeper.AllRewards(suite.ctx, suite.addrs[0])))
+	suite.Require().True(suite.keeper.AllRewards(suite.ctx, suite.addrs[1]).IsZero())
+}
@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	simapp "github.com/tendermint/farming/app"
 	"github.com/tendermint/farming/x/farming/types"
 )
 
@@ -101,3 +105,215 @@ func (suite *KeeperTestSuite) TestGlobalPlanId() {
 	nextPlanId = suite.keeper.GetNextPlanIdWithUpdate(cacheCtx)
 	suite.Require().Equal(uint64(3), nextPlanId)
 }
+
+func (suite *KeeperTestSuite) TestTerminatePrivatePlan() {
+	sampleFixedPlan := suite.sampleFixedAmtPlans[0].(*types.FixedAmountPlan)
+	poolAcc, err := suite.keeper.GeneratePrivatePlanFarmingPoolAddress(suite.ctx, sampleFixedPlan.Name)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.CreateFixedAmountPlan(suite.ctx, &types.MsgCreateFixedAmountPlan{
+		Name:               sampleFixedPlan.Name,
+		Creator:            suite.addrs[0].String(),
+		StakingCoinWeights: sampleFixedPlan.GetStakingCoinWeights(),
+		StartTime:          sampleFixedPlan.GetStartTime(),
+		EndTime:            sampleFixedPlan.GetEndTime(),
+		EpochAmount:        sampleFixedPlan.EpochAmount,
+	}, poolAcc, suite.addrs[0], types.PlanTypePrivate)
+	suite.Require().NoError(err)
+
+	poolCoins := sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000))
+	err = simapp.FundAccount(suite.app.BankKeeper, suite.ctx, poolAcc, poolCoins)
+	suite.Require().NoError(err)
+
+	// only the creator can terminate the plan
+	err = suite.keeper.TerminatePrivatePlan(suite.ctx, suite.addrs[1], 1)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	err = suite.keeper.TerminatePrivatePlan(suite.ctx, suite.addrs[0], 2)
+	suite.Require().ErrorIs(err, types.ErrPlanNotExists)
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	err = suite.keeper.TerminatePrivatePlan(suite.ctx, suite.addrs[0], 1)
+	suite.Require().NoError(err)
+
+	plan, found := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().True(plan.GetTerminated())
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, poolAcc).IsZero())
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(balancesBefore.Add(poolCoins...), balancesAfter))
+
+	err = suite.keeper.TerminatePrivatePlan(suite.ctx, suite.addrs[0], 1)
+	suite.Require().ErrorIs(err, types.ErrAlreadyTerminatedPlan)
+
+	// public plans cannot be terminated by messages
+	suite.keeper.SetPlan(suite.ctx, suite.sampleFixedAmtPlans[1])
+	err = suite.keeper.TerminatePrivatePlan(suite.ctx, suite.addrs[5], suite.sampleFixedAmtPlans[1].GetId())
+	suite.Require().ErrorIs(err, types.ErrInvalidPlanType)
+}
+
+func (suite *KeeperTestSuite) TestUpdatePrivatePlan() {
+	sampleFixedPlan := suite.sampleFixedAmtPlans[0].(*types.FixedAmountPlan)
+	poolAcc, err := suite.keeper.GeneratePrivatePlanFarmingPoolAddress(suite.ctx, sampleFixedPlan.Name)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.CreateFixedAmountPlan(suite.ctx, &types.MsgCreateFixedAmountPlan{
+		Name:               sampleFixedPlan.Name,
+		Creator:            suite.addrs[0].String(),
+		StakingCoinWeights: sampleFixedPlan.GetStakingCoinWeights(),
+		StartTime:          sampleFixedPlan.GetStartTime(),
+		EndTime:            sampleFixedPlan.GetEndTime(),
+		EpochAmount:        sampleFixedPlan.EpochAmount,
+	}, poolAcc, suite.addrs[0], types.PlanTypePrivate)
+	suite.Require().NoError(err)
+
+	newWeights := sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom2, sdk.OneDec()))
+	newEndTime := sampleFixedPlan.GetEndTime().AddDate(0, 1, 0)
+
+	// only the creator can update the plan
+	_, err = suite.keeper.UpdatePrivatePlan(suite.ctx, types.NewMsgUpdatePrivatePlan(
+		suite.addrs[1], 1, "", newWeights, nil, nil, sdk.NewDecWithPrec(5, 2), sdk.Dec{}, 0, nil))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = suite.keeper.UpdatePrivatePlan(suite.ctx, types.NewMsgUpdatePrivatePlan(
+		suite.addrs[0], 2, "", newWeights, nil, nil, sdk.NewDecWithPrec(5, 2), sdk.Dec{}, 0, nil))
+	suite.Require().ErrorIs(err, types.ErrPlanNotExists)
+
+	// the end time must be after the start time of the plan
+	invalidEndTime := sampleFixedPlan.GetStartTime().AddDate(0, 0, -1)
+	_, err = suite.keeper.UpdatePrivatePlan(suite.ctx, types.NewMsgUpdatePrivatePlan(
+		suite.addrs[0], 1, "", newWeights, &invalidEndTime, nil, sdk.NewDecWithPrec(5, 2), sdk.Dec{}, 0, nil))
+	suite.Require().ErrorIs(err, types.ErrInvalidPlanEndTime)
+
+	// the name and the end time remain unchanged when they are not given
+	plan, err := suite.keeper.UpdatePrivatePlan(suite.ctx, types.NewMsgUpdatePrivatePlan(
+		suite.addrs[0], 1, "", newWeights, nil, nil, sdk.NewDecWithPrec(5, 2), sdk.Dec{}, 0, nil))
+	suite.Require().NoError(err)
+	ratioPlan, ok := plan.(*types.RatioPlan)
+	suite.Require().True(ok)
+	suite.Require().Equal(sampleFixedPlan.Name, ratioPlan.GetName())
+	suite.Require().Equal(sampleFixedPlan.GetEndTime(), ratioPlan.GetEndTime())
+	suite.Require().True(decCoinsEq(newWeights, ratioPlan.GetStakingCoinWeights()))
+	suite.Require().True(sdk.NewDecWithPrec(5, 2).Equal(ratioPlan.EpochRatio))
+
+	_, err = suite.keeper.UpdatePrivatePlan(suite.ctx, types.NewMsgUpdatePrivatePlan(
+		suite.addrs[0], 1, "new name", newWeights, &newEndTime, sampleFixedPlan.EpochAmount, sdk.Dec{}, sdk.Dec{}, 0, nil))
+	suite.Require().NoError(err)
+
+	plan, found := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().True(found)
+	fixedPlan, ok := plan.(*types.FixedAmountPlan)
+	suite.Require().True(ok)
+	suite.Require().Equal("new name", fixedPlan.GetName())
+	suite.Require().Equal(newEndTime, fixedPlan.GetEndTime())
+	suite.Require().Equal(poolAcc, fixedPlan.GetFarmingPoolAddress())
+	suite.Require().True(coinsEq(sampleFixedPlan.EpochAmount, fixedPlan.EpochAmount))
+
+	// terminated plans cannot be updated
+	err = suite.keeper.TerminatePrivatePlan(suite.ctx, suite.addrs[0], 1)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.UpdatePrivatePlan(suite.ctx, types.NewMsgUpdatePrivatePlan(
+		suite.addrs[0], 1, "", newWeights, nil, nil, sdk.NewDecWithPrec(5, 2), sdk.Dec{}, 0, nil))
+	suite.Require().ErrorIs(err, types.ErrAlreadyTerminatedPlan)
+}
+
+func (suite *KeeperTestSuite) TestUpdatePrivateSchedulePlan() {
+	weights := sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec()))
+	startTime := types.ParseTime("2021-08-02T00:00:00Z")
+	endTime := types.ParseTime("2021-08-10T00:00:00Z")
+	poolAcc, err := suite.keeper.GeneratePrivatePlanFarmingPoolAddress(suite.ctx, "schedulePlan")
+	suite.Require().NoError(err)
+	_, err = suite.keeper.CreateSchedulePlan(suite.ctx, types.NewMsgCreateSchedulePlan(
+		"schedulePlan", suite.addrs[0], weights, startTime, endTime,
+		[]types.SchedulePhase{
+			types.NewSchedulePhase(startTime, endTime, sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000))),
+		},
+	), poolAcc, suite.addrs[0], types.PlanTypePrivate)
+	suite.Require().NoError(err)
+
+	// A schedule plan cannot be updated without phases, so its schedule is not lost.
+	_, err = suite.keeper.UpdatePrivatePlan(suite.ctx, types.NewMsgUpdatePrivatePlan(
+		suite.addrs[0], 1, "", weights, nil, sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), sdk.Dec{}, sdk.Dec{}, 0, nil))
+	suite.Require().ErrorIs(err, types.ErrInvalidSchedulePhases)
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().IsType(&types.SchedulePlan{}, plan)
+
+	// The phases must be within the plan's start time and end time.
+	_, err = suite.keeper.UpdatePrivatePlan(suite.ctx, types.NewMsgUpdatePrivatePlan(
+		suite.addrs[0], 1, "", weights, nil, nil, sdk.Dec{}, sdk.Dec{}, 0,
+		[]types.SchedulePhase{
+			types.NewSchedulePhase(startTime, endTime.AddDate(0, 0, 1), sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000))),
+		}))
+	suite.Require().ErrorIs(err, types.ErrInvalidSchedulePhases)
+
+	newEndTime := endTime.AddDate(0, 0, 5)
+	newPhases := []types.SchedulePhase{
+		types.NewSchedulePhase(startTime, endTime, sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000))),
+		types.NewSchedulePhase(endTime, newEndTime, sdk.NewCoins(sdk.NewInt64Coin(denom3, 500_000))),
+	}
+	_, err = suite.keeper.UpdatePrivatePlan(suite.ctx, types.NewMsgUpdatePrivatePlan(
+		suite.addrs[0], 1, "", weights, &newEndTime, nil, sdk.Dec{}, sdk.Dec{}, 0, newPhases))
+	suite.Require().NoError(err)
+
+	plan, _ = suite.keeper.GetPlan(suite.ctx, 1)
+	schedulePlan, ok := plan.(*types.SchedulePlan)
+	suite.Require().True(ok)
+	suite.Require().Equal(newEndTime, schedulePlan.GetEndTime())
+	suite.Require().Equal(newPhases, schedulePlan.Phases)
+}
+
+func (suite *KeeperTestSuite) TestPlanIndexes() {
+	activePlanIds := func() (ids []uint64) {
+		suite.keeper.IterateActivePlans(suite.ctx, func(plan types.PlanI) (stop bool) {
+			ids = append(ids, plan.GetId())
+			return false
+		})
+		return
+	}
+	endedPlanIds := func(t string) (ids []uint64) {
+		for _, plan := range suite.keeper.GetEndedPlans(suite.ctx, types.ParseTime(t)) {
+			ids = append(ids, plan.GetId())
+		}
+		return
+	}
+
+	for _, plan := range suite.samplePlans {
+		suite.keeper.SetPlan(suite.ctx, plan)
+	}
+	suite.Require().Equal([]uint64{1, 2, 3, 4}, activePlanIds())
+	suite.Require().Equal([]uint64{4}, endedPlanIds("2021-08-08T00:00:00Z"))
+	// A plan ending at the given time is not ended yet.
+	suite.Require().Equal([]uint64{3, 4}, endedPlanIds("2021-08-09T00:00:01Z"))
+	suite.Require().Equal([]uint64{4}, endedPlanIds("2021-08-09T00:00:00Z"))
+
+	// Updating the end time moves the plan in the end time queue.
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 4)
+	suite.Require().NoError(plan.SetEndTime(types.ParseTime("2021-08-20T00:00:00Z")))
+	suite.keeper.SetPlan(suite.ctx, plan)
+	suite.Require().Empty(endedPlanIds("2021-08-08T00:00:00Z"))
+	suite.Require().Equal([]uint64{1, 2, 3, 4}, endedPlanIds("2021-08-21T00:00:00Z"))
+
+	// Terminated or removed plans are removed from the indexes.
+	plan, _ = suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().NoError(suite.keeper.TerminatePlan(suite.ctx, plan))
+	plan, _ = suite.keeper.GetPlan(suite.ctx, 3)
+	suite.keeper.RemovePlan(suite.ctx, plan)
+	suite.Require().Equal([]uint64{2, 4}, activePlanIds())
+	suite.Require().Equal([]uint64{2, 4}, endedPlanIds("2021-08-21T00:00:00Z"))
+
+	// The index keys of a plan missing from the store are skipped and deleted together,
+	// even if the plan is left with only one of them.
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	plan2, _ := suite.keeper.GetPlan(suite.ctx, 2)
+	plan4, _ := suite.keeper.GetPlan(suite.ctx, 4)
+	store.Delete(types.GetPlanKey(2))
+	store.Delete(types.GetPlanKey(4))
+	store.Delete(types.GetActivePlanKey(2))
+	store.Delete(types.GetPlanEndTimeQueueKey(plan4.GetEndTime(), 4))
+
+	suite.Require().Empty(activePlanIds())
+	suite.Require().False(store.Has(types.GetActivePlanKey(4)))
+	suite.Require().True(store.Has(types.GetPlanEndTimeQueueKey(plan2.GetEndTime(), 2)))
+
+	suite.Require().Empty(endedPlanIds("2021-08-21T00:00:00Z"))
+	suite.Require().False(store.Has(types.GetPlanEndTimeQueueKey(plan2.GetEndTime(), 2)))
+	suite.Require().False(store.Has(types.GetActivePlanKey(2)))
+}
@@ -10,6 +10,9 @@ import (
 // HandlePublicPlanProposal is a handler for executing a public plan creation proposal.
 func HandlePublicPlanProposal(ctx sdk.Context, k Keeper, proposal *types.PublicPlanProposal) error {
 	if proposal.AddRequestProposals != nil {
+		if err := k.ValidateOperationNotPaused(ctx, types.OperationPlanCreation); err != nil {
+			return err
+		}
 		if err := k.AddPublicPlanProposal(ctx, proposal.AddRequestProposals); err != nil {
 			return err
 		}
@@ -48,7 +51,28 @@ func (k Keeper) AddPublicPlanProposal(ctx sdk.Context, proposals []*types.AddReq
 			return err
 		}
 
-		if p.EpochAmount.IsAllPositive() {
+		if p.IsForDecayingPlan() {
+			msg := types.NewMsgCreateDecayingPlan(
+				p.GetName(),
+				farmingPoolAddrAcc,
+				p.GetStakingCoinWeights(),
+				p.GetStartTime(),
+				p.GetEndTime(),
+				p.EpochAmount,
+				p.DecayRate,
+				p.GetDecayEpochs(),
+			)
+			msg.RewardVestingDuration = p.RewardVestingDuration
+
+			plan, err := k.CreateDecayingPlan(ctx, msg, farmingPoolAddrAcc, terminationAcc, types.PlanTypePublic)
+			if err != nil {
+				return err
+			}
+
+			logger := k.Logger(ctx)
+			logger.Info("created public decaying plan", "decaying_plan", plan)
+
+		} else if p.EpochAmount.IsAllPositive() {
 			msg := types.NewMsgCreateFixedAmountPlan(
 				p.GetName(),
 				farmingPoolAddrAcc,
@@ -57,6 +81,7 @@ func (k Keeper) AddPublicPlanProposal(ctx sdk.Context, proposals []*types.AddReq
 				p.GetEndTime(),
 				p.EpochAmount,
 			)
+			msg.RewardVestingDuration = p.RewardVestingDuration
 
 			plan, err := k.CreateFixedAmountPlan(ctx, msg, farmingPoolAddrAcc, terminationAcc, types.PlanTypePublic)
 			if err != nil {
@@ -75,6 +100,7 @@ func (k Keeper) AddPublicPlanProposal(ctx sdk.Context, proposals []*types.AddReq
 				p.GetEndTime(),
 				p.EpochRatio,
 			)
+			msg.RewardVestingDuration = p.RewardVestingDuration
 
 			if err = msg.ValidateBasic(); err != nil {
 				return err
@@ -146,8 +172,11 @@ func (k Keeper) UpdatePublicPlanProposal(ctx sdk.Context, proposals []*types.Upd
 				}
 			}
 
-			// change the plan to fixed amount plan if an epoch amount exists
-			if p.GetEpochAmount().IsAllPositive() {
+			// change the plan to decaying plan if a decay rate exists,
+			// otherwise change the plan to fixed amount plan if an epoch amount exists
+			if p.IsForDecayingPlan() {
+				plan = types.NewDecayingPlanFrom(plan, p.GetEpochAmount(), p.DecayRate, p.GetDecayEpochs())
+			} else if p.GetEpochAmount().IsAllPositive() {
 				plan = types.NewFixedAmountPlan(plan.GetBasePlan(), p.GetEpochAmount())
 			}
 
@@ -483,3 +483,77 @@ func (suite *KeeperTestSuite) TestUpdatePlanType() {
 	suite.Require().Equal(true, found)
 	suite.Require().Equal(plan.(*types.RatioPlan).EpochRatio, sdk.NewDecWithPrec(7, 2))
 }
+
+func (suite *KeeperTestSuite) TestDecayingPlanProposal() {
+	// create a decaying public plan
+	addRequest := types.NewAddRequestProposal(
+		"testPlan",
+		suite.addrs[0].String(),
+		suite.addrs[0].String(),
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+		types.ParseTime("2021-08-01T00:00:00Z"),
+		types.ParseTime("2021-08-30T00:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 100_000)),
+		sdk.ZeroDec(),
+	)
+	addRequest.DecayRate = sdk.NewDecWithPrec(5, 1)
+	addRequest.DecayEpochs = 2
+
+	proposal := types.NewPublicPlanProposal("testTitle", "testDescription", []*types.AddRequestProposal{addRequest}, nil, nil)
+	suite.Require().NoError(proposal.ValidateBasic())
+	err := keeper.HandlePublicPlanProposal(suite.ctx, suite.keeper, proposal)
+	suite.Require().NoError(err)
+
+	plan, found := suite.keeper.GetPlan(suite.ctx, uint64(1))
+	suite.Require().True(found)
+	decayingPlan, ok := plan.(*types.DecayingPlan)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), decayingPlan.DecayRate)
+	suite.Require().Equal(uint32(2), decayingPlan.DecayEpochs)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 100_000)), decayingPlan.CurrentEpochAmount()))
+
+	// the number of allocated epochs is carried over when the decaying plan is updated
+	decayingPlan.AllocatedEpochs = 3
+	suite.keeper.SetPlan(suite.ctx, decayingPlan)
+
+	updateRequest := types.NewUpdateRequestProposal(
+		plan.GetId(),
+		plan.GetName(),
+		plan.GetFarmingPoolAddress().String(),
+		plan.GetTerminationAddress().String(),
+		plan.GetStakingCoinWeights(),
+		plan.GetStartTime(),
+		plan.GetEndTime(),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 200_000)),
+		sdk.ZeroDec(),
+	)
+	updateRequest.DecayRate = sdk.NewDecWithPrec(8, 1)
+	updateRequest.DecayEpochs = 1
+
+	proposal = types.NewPublicPlanProposal("testTitle", "testDescription", nil, []*types.UpdateRequestProposal{updateRequest}, nil)
+	suite.Require().NoError(proposal.ValidateBasic())
+	err = keeper.HandlePublicPlanProposal(suite.ctx, suite.keeper, proposal)
+	suite.Require().NoError(err)
+
+	plan, found = suite.keeper.GetPlan(suite.ctx, uint64(1))
+	suite.Require().True(found)
+	decayingPlan, ok = plan.(*types.DecayingPlan)
+	suite.Require().True(ok)
+	suite.Require().Equal(uint64(3), decayingPlan.AllocatedEpochs)
+	// 200_000 * 0.8^3 = 102_400
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 102_400)), decayingPlan.CurrentEpochAmount()))
+
+	// update the decaying plan to fixed amount plan type
+	updateRequest.DecayRate = sdk.Dec{}
+	updateRequest.DecayEpochs = 0
+
+	proposal = types.NewPublicPlanProposal("testTitle", "testDescription", nil, []*types.UpdateRequestProposal{updateRequest}, nil)
+	suite.Require().NoError(proposal.ValidateBasic())
+	err = keeper.HandlePublicPlanProposal(suite.ctx, suite.keeper, proposal)
+	suite.Require().NoError(err)
+
+	plan, found = suite.keeper.GetPlan(suite.ctx, uint64(1))
+	suite.Require().True(found)
+	_, ok = plan.(*types.FixedAmountPlan)
+	suite.Require().True(ok)
+}
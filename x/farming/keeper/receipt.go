@@ -0,0 +1,374 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// GetReceiptStaking returns the amount of the farmer's staking which is backed by receipt coins.
+func (k Keeper) GetReceiptStaking(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetReceiptStakingKey(stakingCoinDenom, farmerAcc))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var amt sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &amt)
+	return amt.Int
+}
+
+// SetReceiptStaking sets the amount of the farmer's staking which is backed by receipt coins.
+// It deletes the record when the amount is zero.
+func (k Keeper) SetReceiptStaking(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress, amt sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if !amt.IsPositive() {
+		store.Delete(types.GetReceiptStakingKey(stakingCoinDenom, farmerAcc))
+		return
+	}
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: amt})
+	store.Set(types.GetReceiptStakingKey(stakingCoinDenom, farmerAcc), bz)
+}
+
+// IterateReceiptStakings iterates through all receipt-backed stakings
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateReceiptStakings(ctx sdk.Context, cb func(stakingCoinDenom string, farmerAcc sdk.AccAddress, amt sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ReceiptStakingKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var amt sdk.IntProto
+		k.cdc.MustUnmarshal(iter.Value(), &amt)
+		stakingCoinDenom, farmerAcc := types.ParseReceiptStakingKey(iter.Key())
+		if cb(stakingCoinDenom, farmerAcc, amt.Int) {
+			break
+		}
+	}
+}
+
+// GetUnclaimedReceiptStaking returns the amount of the receipt-backed stakings
+// released from farmers who no longer hold the receipts, which is waiting
+// to be claimed by the new holders of the receipts.
+func (k Keeper) GetUnclaimedReceiptStaking(ctx sdk.Context, stakingCoinDenom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUnclaimedReceiptStakingKey(stakingCoinDenom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var amt sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &amt)
+	return amt.Int
+}
+
+// SetUnclaimedReceiptStaking sets the amount of the unclaimed receipt-backed stakings.
+// It deletes the record when the amount is zero.
+func (k Keeper) SetUnclaimedReceiptStaking(ctx sdk.Context, stakingCoinDenom string, amt sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if !amt.IsPositive() {
+		store.Delete(types.GetUnclaimedReceiptStakingKey(stakingCoinDenom))
+		return
+	}
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: amt})
+	store.Set(types.GetUnclaimedReceiptStakingKey(stakingCoinDenom), bz)
+}
+
+// IterateUnclaimedReceiptStakings iterates through all unclaimed receipt-backed stakings
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateUnclaimedReceiptStakings(ctx sdk.Context, cb func(stakingCoinDenom string, amt sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.UnclaimedReceiptStakingKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var amt sdk.IntProto
+		k.cdc.MustUnmarshal(iter.Value(), &amt)
+		if cb(types.ParseUnclaimedReceiptStakingKey(iter.Key()), amt.Int) {
+			break
+		}
+	}
+}
+
+// receiptCoins returns the receipt coins for the staking coins.
+func receiptCoins(stakingCoins sdk.Coins) sdk.Coins {
+	receipts := sdk.NewCoins()
+	for _, coin := range stakingCoins {
+		receipts = receipts.Add(sdk.NewCoin(types.ReceiptDenom(coin.Denom), coin.Amount))
+	}
+	return receipts
+}
+
+// LiquidStake stakes the coins like Stake, and mints the receipt coins of
+// the staking coins to the farmer.
+// The receipt-backed staking follows the ownership of the receipt coins.
+func (k Keeper) LiquidStake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coins) error {
+	for _, coin := range amount {
+		if types.IsReceiptDenom(coin.Denom) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "receipts can't be minted for receipt coins %s", coin.Denom)
+		}
+		// Settle the receipts the farmer has received so far before minting new ones.
+		if err := k.SyncReceiptStaking(ctx, farmerAcc, coin.Denom); err != nil {
+			return err
+		}
+	}
+
+	if err := k.Stake(ctx, farmerAcc, amount); err != nil {
+		return err
+	}
+
+	receipts := receiptCoins(amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, receipts); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, farmerAcc, receipts); err != nil {
+		return err
+	}
+
+	for _, coin := range amount {
+		k.SetReceiptStaking(ctx, coin.Denom, farmerAcc, k.GetReceiptStaking(ctx, coin.Denom, farmerAcc).Add(coin.Amount))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMintReceipt,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiptCoins, receipts.String()),
+		),
+	})
+
+	return nil
+}
+
+// BurnReceipts burns the farmer's receipt coins of the staking coins
+// and decreases the farmer's receipt-backed staking accordingly.
+// It is called when the receipt-backed staking is unstaked.
+func (k Keeper) BurnReceipts(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoins sdk.Coins) error {
+	receipts := receiptCoins(stakingCoins)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, farmerAcc, types.ModuleName, receipts); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, receipts); err != nil {
+		return err
+	}
+
+	for _, coin := range stakingCoins {
+		k.SetReceiptStaking(ctx, coin.Denom, farmerAcc, k.GetReceiptStaking(ctx, coin.Denom, farmerAcc).Sub(coin.Amount))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBurnReceipt,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiptCoins, receipts.String()),
+		),
+	})
+
+	return nil
+}
+
+// SyncReceiptStaking makes the farmer's receipt-backed staking of the staking coin denom
+// follow the receipt coins the farmer holds.
+// If the farmer has sent receipts away, the staking backed by them is released from
+// the farmer after withdrawing the farmer's rewards, and waits to be claimed.
+// If the farmer has received receipts, the farmer claims the released staking backed
+// by them as staked coins, as much as it is available.
+func (k Keeper) SyncReceiptStaking(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) error {
+	backedAmt := k.GetReceiptStaking(ctx, stakingCoinDenom, farmerAcc)
+	balance := k.bankKeeper.GetBalance(ctx, farmerAcc, types.ReceiptDenom(stakingCoinDenom)).Amount
+
+	switch {
+	case balance.LT(backedAmt):
+		return k.releaseReceiptStaking(ctx, farmerAcc, stakingCoinDenom, backedAmt.Sub(balance))
+	case balance.GT(backedAmt):
+		return k.claimReceiptStaking(ctx, farmerAcc, stakingCoinDenom, balance.Sub(backedAmt))
+	}
+	return nil
+}
+
+// releaseReceiptStaking removes the receipt-backed staking amount from the farmer's
+// queued coins first, then from the staked coins, and adds it to the unclaimed receipt stakings.
+// The released coins stay in the staking reserve pool.
+func (k Keeper) releaseReceiptStaking(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, amt sdk.Int) error {
+	if err := k.SettleQueuedStaking(ctx, stakingCoinDenom, farmerAcc); err != nil {
+		return err
+	}
+	staking, found := k.GetStaking(ctx, stakingCoinDenom, farmerAcc)
+	if !found {
+		staking.Amount = sdk.ZeroInt()
+	}
+	queuedStaking, found := k.GetQueuedStaking(ctx, stakingCoinDenom, farmerAcc)
+	if !found {
+		queuedStaking.Amount = sdk.ZeroInt()
+	}
+
+	lockedQueuedAmt := sdk.ZeroInt()
+	for _, lock := range k.GetLockedStakingsByFarmer(ctx, farmerAcc, stakingCoinDenom) {
+		if !lock.Boosted {
+			lockedQueuedAmt = lockedQueuedAmt.Add(lock.Amount)
+		}
+	}
+	unlockedQueuedAmt := sdk.MaxInt(queuedStaking.Amount.Sub(lockedQueuedAmt), sdk.ZeroInt())
+
+	removedFromQueued := sdk.MinInt(amt, unlockedQueuedAmt)
+	removedFromStaking := amt.Sub(removedFromQueued)
+	if staking.Amount.LT(removedFromStaking) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFunds, "%s%s is smaller than %s%s", staking.Amount, stakingCoinDenom, removedFromStaking, stakingCoinDenom)
+	}
+
+	if removedFromQueued.IsPositive() {
+		queuedStaking.Amount = queuedStaking.Amount.Sub(removedFromQueued)
+		if queuedStaking.Amount.IsPositive() {
+			k.SetQueuedStaking(ctx, stakingCoinDenom, farmerAcc, queuedStaking)
+		} else {
+			k.DeleteQueuedStaking(ctx, stakingCoinDenom, farmerAcc)
+		}
+	}
+
+	if removedFromStaking.IsPositive() {
+		if _, err := k.WithdrawRewards(ctx, farmerAcc, stakingCoinDenom); err != nil {
+			return err
+		}
+
+		staking.Amount = staking.Amount.Sub(removedFromStaking)
+		if staking.Amount.IsPositive() {
+			staking.StartingEpoch = k.GetCurrentEpoch(ctx, stakingCoinDenom)
+			k.SetStaking(ctx, stakingCoinDenom, farmerAcc, staking)
+		} else {
+			k.DeleteStaking(ctx, stakingCoinDenom, farmerAcc)
+		}
+
+		k.DecreaseTotalStakings(ctx, stakingCoinDenom, removedFromStaking)
+		k.DecreasePlanTotalStakingsByFarmer(ctx, farmerAcc, stakingCoinDenom, removedFromStaking)
+	}
+
+	k.SetReceiptStaking(ctx, stakingCoinDenom, farmerAcc, k.GetReceiptStaking(ctx, stakingCoinDenom, farmerAcc).Sub(amt))
+	k.SetUnclaimedReceiptStaking(ctx, stakingCoinDenom, k.GetUnclaimedReceiptStaking(ctx, stakingCoinDenom).Add(amt))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSyncReceiptStaking,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyReleasedCoins, sdk.NewCoin(stakingCoinDenom, amt).String()),
+		),
+	})
+
+	k.AfterUnstake(ctx, farmerAcc, sdk.NewCoins(sdk.NewCoin(stakingCoinDenom, amt)))
+
+	return nil
+}
+
+// claimReceiptStaking moves the unclaimed receipt-backed staking to the farmer's staked coins,
+// up to the given amount. The claimed coins earn rewards from the current epoch, so that
+// the staking following the receipts doesn't miss any epoch.
+func (k Keeper) claimReceiptStaking(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, amt sdk.Int) error {
+	unclaimedAmt := k.GetUnclaimedReceiptStaking(ctx, stakingCoinDenom)
+	claimedAmt := sdk.MinInt(amt, unclaimedAmt)
+	if !claimedAmt.IsPositive() {
+		return nil
+	}
+
+	if err := k.SettleQueuedStaking(ctx, stakingCoinDenom, farmerAcc); err != nil {
+		return err
+	}
+	staking, found := k.GetStaking(ctx, stakingCoinDenom, farmerAcc)
+	if found {
+		if _, err := k.WithdrawRewards(ctx, farmerAcc, stakingCoinDenom); err != nil {
+			return err
+		}
+	} else {
+		staking.Amount = sdk.ZeroInt()
+	}
+
+	k.SetStaking(ctx, stakingCoinDenom, farmerAcc, types.Staking{
+		Amount:        staking.Amount.Add(claimedAmt),
+		StartingEpoch: k.GetCurrentEpoch(ctx, stakingCoinDenom),
+		BoostAmount:   staking.GetBoostAmount(),
+	})
+	k.IncreaseTotalStakings(ctx, stakingCoinDenom, claimedAmt)
+	k.IncreasePlanTotalStakingsByFarmer(ctx, farmerAcc, stakingCoinDenom, claimedAmt)
+
+	k.SetReceiptStaking(ctx, stakingCoinDenom, farmerAcc, k.GetReceiptStaking(ctx, stakingCoinDenom, farmerAcc).Add(claimedAmt))
+	k.SetUnclaimedReceiptStaking(ctx, stakingCoinDenom, unclaimedAmt.Sub(claimedAmt))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSyncReceiptStaking,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimedCoins, sdk.NewCoin(stakingCoinDenom, claimedAmt).String()),
+		),
+	})
+
+	k.AfterStake(ctx, farmerAcc, sdk.NewCoins(sdk.NewCoin(stakingCoinDenom, claimedAmt)))
+
+	return nil
+}
+
+// SyncAllReceiptStakings syncs the receipt-backed stakings of all farmers who have them
+// or hold the receipts. Stakings backed by receipts which have been sent away are released
+// first, and then claimed right away by the holders of the receipts, including the ones
+// who have never had a receipt-backed staking.
+// It is called at the end of every epoch.
+func (k Keeper) SyncAllReceiptStakings(ctx sdk.Context) error {
+	type receiptStaking struct {
+		stakingCoinDenom string
+		farmerAcc        sdk.AccAddress
+	}
+	var backed []receiptStaking
+	k.IterateReceiptStakings(ctx, func(stakingCoinDenom string, farmerAcc sdk.AccAddress, _ sdk.Int) (stop bool) {
+		backed = append(backed, receiptStaking{stakingCoinDenom, farmerAcc})
+		return false
+	})
+
+	for _, rs := range backed {
+		backedAmt := k.GetReceiptStaking(ctx, rs.stakingCoinDenom, rs.farmerAcc)
+		balance := k.bankKeeper.GetBalance(ctx, rs.farmerAcc, types.ReceiptDenom(rs.stakingCoinDenom)).Amount
+		if balance.LT(backedAmt) {
+			if err := k.releaseReceiptStaking(ctx, rs.farmerAcc, rs.stakingCoinDenom, backedAmt.Sub(balance)); err != nil {
+				return err
+			}
+		}
+	}
+
+	// The receipts can be held by anyone, so the holders are found from the balances.
+	var holders []receiptStaking
+	k.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) (stop bool) {
+		if types.IsReceiptDenom(coin.Denom) {
+			holders = append(holders, receiptStaking{strings.TrimPrefix(coin.Denom, types.ReceiptDenomPrefix), addr})
+		}
+		return false
+	})
+
+	for _, rs := range holders {
+		if err := k.SyncReceiptStaking(ctx, rs.farmerAcc, rs.stakingCoinDenom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateReceiptSupply checks that the supply of each receipt denom equals
+// the sum of the receipt-backed stakings and the unclaimed receipt-backed stakings.
+func (k Keeper) ValidateReceiptSupply(ctx sdk.Context) error {
+	backedCoins := sdk.NewCoins()
+	k.IterateReceiptStakings(ctx, func(stakingCoinDenom string, _ sdk.AccAddress, amt sdk.Int) (stop bool) {
+		backedCoins = backedCoins.Add(sdk.NewCoin(stakingCoinDenom, amt))
+		return false
+	})
+	k.IterateUnclaimedReceiptStakings(ctx, func(stakingCoinDenom string, amt sdk.Int) (stop bool) {
+		backedCoins = backedCoins.Add(sdk.NewCoin(stakingCoinDenom, amt))
+		return false
+	})
+
+	for _, coin := range backedCoins {
+		if supply := k.bankKeeper.GetSupply(ctx, types.ReceiptDenom(coin.Denom)); !supply.Amount.Equal(coin.Amount) {
+			return types.ErrInvalidReceiptSupply
+		}
+	}
+
+	return nil
+}
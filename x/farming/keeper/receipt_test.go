@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/keeper"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+func (suite *KeeperTestSuite) LiquidStake(farmerAcc sdk.AccAddress, amt sdk.Coins) {
+	err := suite.keeper.LiquidStake(suite.ctx, farmerAcc, amt)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) SendReceipts(fromAcc, toAcc sdk.AccAddress, stakingCoinDenom string, amt int64) {
+	err := suite.app.BankKeeper.SendCoins(suite.ctx, fromAcc, toAcc, sdk.NewCoins(sdk.NewInt64Coin(types.ReceiptDenom(stakingCoinDenom), amt)))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestLiquidStake() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+
+	suite.LiquidStake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	receipt := suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[0], types.ReceiptDenom(denom1))
+	suite.Require().True(intEq(sdk.NewInt(1000000), receipt.Amount))
+	suite.Require().True(intEq(sdk.NewInt(1000000), suite.keeper.GetReceiptStaking(suite.ctx, denom1, suite.addrs[0])))
+
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	suite.SendReceipts(suite.addrs[0], suite.addrs[1], denom1, 400000)
+
+	// Nothing can be claimed until the sender's staking is released.
+	suite.Require().NoError(suite.keeper.SyncReceiptStaking(suite.ctx, suite.addrs[1], denom1))
+	_, found := suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().False(found)
+
+	// The sender's rewards are settled when the staking is released.
+	rewards := suite.keeper.AllRewards(suite.ctx, suite.addrs[0])
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().NoError(suite.keeper.SyncReceiptStaking(suite.ctx, suite.addrs[0], denom1))
+	suite.Require().True(coinsEq(balancesBefore.Add(rewards...), suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+
+	staking, found := suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(600000), staking.Amount))
+	totalStakings, _ := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(intEq(sdk.NewInt(600000), totalStakings.Amount))
+	suite.Require().True(intEq(sdk.NewInt(400000), suite.keeper.GetUnclaimedReceiptStaking(suite.ctx, denom1)))
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	// The receiver claims the released staking as staked coins, which earn rewards from the next epoch.
+	suite.Require().NoError(suite.keeper.SyncReceiptStaking(suite.ctx, suite.addrs[1], denom1))
+	staking, found = suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(400000), staking.Amount))
+	_, found = suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().False(found)
+	totalStakings, _ = suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(intEq(sdk.NewInt(1000000), totalStakings.Amount))
+	suite.Require().True(intEq(sdk.NewInt(400000), suite.keeper.GetReceiptStaking(suite.ctx, denom1, suite.addrs[1])))
+	suite.Require().True(suite.keeper.GetUnclaimedReceiptStaking(suite.ctx, denom1).IsZero())
+
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 800000)), suite.keeper.AllRewards(suite.ctx, suite.addrs[1])))
+
+	_, broken = keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestLiquidStake_Unstake() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)))
+	suite.LiquidStake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+
+	// Coins not backed by receipts are unstaked first, and then the receipts are burned.
+	err := suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 800000)))
+	suite.Require().NoError(err)
+	receipt := suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[0], types.ReceiptDenom(denom1))
+	suite.Require().True(intEq(sdk.NewInt(700000), receipt.Amount))
+	suite.Require().True(intEq(sdk.NewInt(700000), suite.keeper.GetReceiptStaking(suite.ctx, denom1, suite.addrs[0])))
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, types.ReceiptDenom(denom1))
+	suite.Require().True(intEq(sdk.NewInt(700000), supply.Amount))
+
+	// The staking backed by the receipts sent away can't be unstaked.
+	suite.SendReceipts(suite.addrs[0], suite.addrs[1], denom1, 700000)
+	err = suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 100000)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// The receiver can unstake it after claiming it.
+	suite.AdvanceEpoch()
+	suite.Require().NoError(suite.keeper.SyncReceiptStaking(suite.ctx, suite.addrs[1], denom1))
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])
+	err = suite.keeper.Unstake(suite.ctx, suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 700000)))
+	suite.Require().NoError(err)
+	balancesBefore = balancesBefore.Sub(sdk.NewCoins(sdk.NewInt64Coin(types.ReceiptDenom(denom1), 700000)))
+	balancesBefore = balancesBefore.Add(sdk.NewInt64Coin(denom1, 700000))
+	suite.Require().True(coinsEq(balancesBefore, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])))
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestSyncAllReceiptStakings() {
+	suite.LiquidStake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.LiquidStake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+
+	// Receipts moved between farmers holding receipt-backed stakings are synced at the end of the epoch.
+	suite.SendReceipts(suite.addrs[0], suite.addrs[1], denom1, 300000)
+	suite.AdvanceEpoch()
+
+	staking, _ := suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().True(intEq(sdk.NewInt(700000), staking.Amount))
+	staking, _ = suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().True(intEq(sdk.NewInt(1300000), staking.Amount))
+	suite.Require().True(suite.keeper.GetUnclaimedReceiptStaking(suite.ctx, denom1).IsZero())
+
+	// Receipts sent to a farmer who has never had a receipt-backed staking are synced as well.
+	suite.SendReceipts(suite.addrs[1], suite.addrs[2], denom1, 500000)
+	suite.AdvanceEpoch()
+
+	staking, _ = suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().True(intEq(sdk.NewInt(800000), staking.Amount))
+	staking, _ = suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[2])
+	suite.Require().True(intEq(sdk.NewInt(500000), staking.Amount))
+	suite.Require().True(intEq(sdk.NewInt(500000), suite.keeper.GetReceiptStaking(suite.ctx, denom1, suite.addrs[2])))
+	suite.Require().True(suite.keeper.GetUnclaimedReceiptStaking(suite.ctx, denom1).IsZero())
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestLiquidStake_TransferStaking() {
+	suite.LiquidStake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+
+	err := suite.keeper.TransferStaking(suite.ctx, suite.addrs[0], suite.addrs[1], []string{denom1})
+	suite.Require().ErrorIs(err, types.ErrReceiptStaking)
+}
+
+func (suite *KeeperTestSuite) TestLiquidStake_Genesis() {
+	suite.LiquidStake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.SendReceipts(suite.addrs[0], suite.addrs[1], denom1, 300000)
+	suite.Require().NoError(suite.keeper.SyncReceiptStaking(suite.ctx, suite.addrs[0], denom1))
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genState.ReceiptStakingRecords, 1)
+	suite.Require().Len(genState.UnclaimedReceiptStakingRecords, 1)
+
+	bz, err := suite.app.AppCodec().MarshalJSON(genState)
+	suite.Require().NoError(err)
+	var genState2 types.GenesisState
+	suite.Require().NoError(suite.app.AppCodec().UnmarshalJSON(bz, &genState2))
+	suite.Require().NoError(types.ValidateGenesis(genState2))
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, genState2)
+	})
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+}
@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	"fmt"
+	"sort"
 	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	gogotypes "github.com/gogo/protobuf/types"
 
 	"github.com/tendermint/farming/x/farming/types"
@@ -31,6 +35,51 @@ func (k Keeper) DeleteHistoricalRewards(ctx sdk.Context, stakingCoinDenom string
 	store.Delete(types.GetHistoricalRewardsKey(stakingCoinDenom, epoch))
 }
 
+// incrementReferenceCount increments the reference count of the historical rewards.
+func (k Keeper) incrementReferenceCount(ctx sdk.Context, stakingCoinDenom string, epoch uint64) {
+	historical, found := k.GetHistoricalRewards(ctx, stakingCoinDenom, epoch)
+	if !found {
+		panic(fmt.Sprintf("historical rewards of %s at epoch %d not found", stakingCoinDenom, epoch))
+	}
+	historical.ReferenceCount++
+	k.SetHistoricalRewards(ctx, stakingCoinDenom, epoch, historical)
+}
+
+// decrementReferenceCount decrements the reference count of the historical rewards,
+// and deletes them once they are no longer referenced.
+func (k Keeper) decrementReferenceCount(ctx sdk.Context, stakingCoinDenom string, epoch uint64) {
+	historical, found := k.GetHistoricalRewards(ctx, stakingCoinDenom, epoch)
+	if !found {
+		panic(fmt.Sprintf("historical rewards of %s at epoch %d not found", stakingCoinDenom, epoch))
+	}
+	if historical.ReferenceCount == 0 {
+		panic("cannot set negative reference count")
+	}
+	historical.ReferenceCount--
+	if historical.ReferenceCount == 0 {
+		k.DeleteHistoricalRewards(ctx, stakingCoinDenom, epoch)
+	} else {
+		k.SetHistoricalRewards(ctx, stakingCoinDenom, epoch, historical)
+	}
+}
+
+// incrementStakingReference increments the reference count of the historical rewards
+// which a staking starting at the starting epoch refers to.
+// A staking starting at the first epoch doesn't refer to any historical rewards.
+func (k Keeper) incrementStakingReference(ctx sdk.Context, stakingCoinDenom string, startingEpoch uint64) {
+	if startingEpoch > 0 {
+		k.incrementReferenceCount(ctx, stakingCoinDenom, startingEpoch-1)
+	}
+}
+
+// decrementStakingReference decrements the reference count of the historical rewards
+// which a staking starting at the starting epoch refers to.
+func (k Keeper) decrementStakingReference(ctx sdk.Context, stakingCoinDenom string, startingEpoch uint64) {
+	if startingEpoch > 0 {
+		k.decrementReferenceCount(ctx, stakingCoinDenom, startingEpoch-1)
+	}
+}
+
 func (k Keeper) IterateHistoricalRewards(ctx sdk.Context, cb func(stakingCoinDenom string, epoch uint64, rewards types.HistoricalRewards) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 	iter := sdk.KVStorePrefixIterator(store, types.HistoricalRewardsKeyPrefix)
@@ -138,10 +187,92 @@ func (k Keeper) CalculateRewards(ctx sdk.Context, farmerAcc sdk.AccAddress, stak
 	starting, _ := k.GetHistoricalRewards(ctx, stakingCoinDenom, staking.StartingEpoch-1)
 	ending, _ := k.GetHistoricalRewards(ctx, stakingCoinDenom, endingEpoch)
 	diff := ending.CumulativeUnitRewards.Sub(starting.CumulativeUnitRewards)
-	rewards = diff.MulDecTruncate(staking.Amount.ToDec())
+	rewards = diff.MulDecTruncate(staking.BoostedAmount().ToDec())
+
+	// Rewards of restricted plans are given only to the farmers in their allowlists.
+	for _, r := range ending.CumulativePlanUnitRewards {
+		if !k.IsPlanFarmer(ctx, r.PlanId, farmerAcc) {
+			continue
+		}
+		diff := r.CumulativeUnitRewards.Sub(types.PlanUnitRewardsOf(starting.CumulativePlanUnitRewards, r.PlanId))
+		rewards = rewards.Add(diff.MulDecTruncate(staking.BoostedAmount().ToDec())...)
+	}
 	return
 }
 
+// CalculateRewardsByPlan returns the farmer's rewards broken down by the plans which allocated them.
+func (k Keeper) CalculateRewardsByPlan(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, endingEpoch uint64) map[uint64]sdk.DecCoins {
+	staking, found := k.GetStaking(ctx, stakingCoinDenom, farmerAcc)
+	if !found {
+		return nil
+	}
+
+	starting, _ := k.GetHistoricalRewards(ctx, stakingCoinDenom, staking.StartingEpoch-1)
+	ending, _ := k.GetHistoricalRewards(ctx, stakingCoinDenom, endingEpoch)
+
+	rewards := map[uint64]sdk.DecCoins{} // (plan id) => (rewards)
+	for _, r := range ending.CumulativeUnitRewardsByPlan {
+		diff := r.CumulativeUnitRewards.Sub(types.PlanUnitRewardsOf(starting.CumulativeUnitRewardsByPlan, r.PlanId))
+		if diff.IsZero() {
+			continue
+		}
+		rewards[r.PlanId] = diff.MulDecTruncate(staking.BoostedAmount().ToDec())
+	}
+	for _, r := range ending.CumulativePlanUnitRewards {
+		if !k.IsPlanFarmer(ctx, r.PlanId, farmerAcc) {
+			continue
+		}
+		diff := r.CumulativeUnitRewards.Sub(types.PlanUnitRewardsOf(starting.CumulativePlanUnitRewards, r.PlanId))
+		if diff.IsZero() {
+			continue
+		}
+		rewards[r.PlanId] = diff.MulDecTruncate(staking.BoostedAmount().ToDec())
+	}
+	return rewards
+}
+
+// CalculateVestingRewards returns the part of the farmer's rewards that was allocated
+// by plans with reward vesting, grouped by the vesting duration.
+func (k Keeper) CalculateVestingRewards(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, endingEpoch uint64) []types.VestingRewards {
+	staking, found := k.GetStaking(ctx, stakingCoinDenom, farmerAcc)
+	if !found {
+		return nil
+	}
+
+	starting, _ := k.GetHistoricalRewards(ctx, stakingCoinDenom, staking.StartingEpoch-1)
+	ending, _ := k.GetHistoricalRewards(ctx, stakingCoinDenom, endingEpoch)
+
+	var vestingRewards []types.VestingRewards
+	for _, r := range ending.CumulativeVestingUnitRewards {
+		diff := r.CumulativeUnitRewards.Sub(types.VestingUnitRewardsOf(starting.CumulativeVestingUnitRewards, r.VestingDuration))
+		if diff.IsZero() {
+			continue
+		}
+		vestingRewards = append(vestingRewards, types.VestingRewards{
+			VestingDuration: r.VestingDuration,
+			Rewards:         diff.MulDecTruncate(staking.BoostedAmount().ToDec()),
+		})
+	}
+	for _, r := range ending.CumulativePlanUnitRewards {
+		if !k.IsPlanFarmer(ctx, r.PlanId, farmerAcc) {
+			continue
+		}
+		plan, found := k.GetPlan(ctx, r.PlanId)
+		if !found || plan.GetRewardVestingDuration() == 0 {
+			continue
+		}
+		diff := r.CumulativeUnitRewards.Sub(types.PlanUnitRewardsOf(starting.CumulativePlanUnitRewards, r.PlanId))
+		if diff.IsZero() {
+			continue
+		}
+		vestingRewards = append(vestingRewards, types.VestingRewards{
+			VestingDuration: plan.GetRewardVestingDuration(),
+			Rewards:         diff.MulDecTruncate(staking.BoostedAmount().ToDec()),
+		})
+	}
+	return vestingRewards
+}
+
 func (k Keeper) Rewards(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) sdk.Coins {
 	currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom)
 	rewards := k.CalculateRewards(ctx, farmerAcc, stakingCoinDenom, currentEpoch-1)
@@ -150,6 +281,41 @@ func (k Keeper) Rewards(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDe
 	return truncatedRewards
 }
 
+// RewardsByPlan returns the farmer's rewards of the staking coin denom broken down by
+// the plans which allocated them, sorted by the plan id. If the staking coin denom is
+// empty, the rewards of all the farmer's stakings are returned.
+// The rewards are truncated per plan, so their sum can be less than the total rewards.
+func (k Keeper) RewardsByPlan(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) []types.PlanRewards {
+	rewardsByPlan := map[uint64]sdk.Coins{} // (plan id) => (rewards)
+	addRewards := func(stakingCoinDenom string) {
+		currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom)
+		for planId, rewards := range k.CalculateRewardsByPlan(ctx, farmerAcc, stakingCoinDenom, currentEpoch-1) {
+			truncatedRewards, _ := rewards.TruncateDecimal()
+			rewardsByPlan[planId] = rewardsByPlan[planId].Add(truncatedRewards...)
+		}
+	}
+	if stakingCoinDenom == "" {
+		k.IterateStakingsByFarmer(ctx, farmerAcc, func(stakingCoinDenom string, _ types.Staking) (stop bool) {
+			addRewards(stakingCoinDenom)
+			return false
+		})
+	} else {
+		addRewards(stakingCoinDenom)
+	}
+
+	planRewards := []types.PlanRewards{}
+	for planId, rewards := range rewardsByPlan {
+		if rewards.IsZero() {
+			continue
+		}
+		planRewards = append(planRewards, types.PlanRewards{PlanId: planId, Rewards: rewards})
+	}
+	sort.Slice(planRewards, func(i, j int) bool {
+		return planRewards[i].PlanId < planRewards[j].PlanId
+	})
+	return planRewards
+}
+
 func (k Keeper) AllRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) sdk.Coins {
 	totalRewards := sdk.NewCoins()
 	k.IterateStakingsByFarmer(ctx, farmerAcc, func(stakingCoinDenom string, staking types.Staking) (stop bool) {
@@ -160,6 +326,8 @@ func (k Keeper) AllRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) sdk.Coins
 	return totalRewards
 }
 
+// WithdrawRewards withdraws accumulated rewards of the farmer's staking of the staking coin denom.
+// The rewards are sent to the farmer's reward withdraw address.
 func (k Keeper) WithdrawRewards(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) (sdk.Coins, error) {
 	staking, found := k.GetStaking(ctx, stakingCoinDenom, farmerAcc)
 	if !found {
@@ -172,13 +340,20 @@ func (k Keeper) WithdrawRewards(ctx sdk.Context, farmerAcc sdk.AccAddress, staki
 	truncatedRewards, _ := rewards.TruncateDecimal()
 
 	if !rewards.IsZero() {
-		if !truncatedRewards.IsZero() {
-			if err := k.bankKeeper.SendCoins(ctx, k.GetRewardsReservePoolAcc(ctx), farmerAcc, truncatedRewards); err != nil {
+		vestedRewards, err := k.VestRewards(ctx, farmerAcc, stakingCoinDenom, currentEpoch-1)
+		if err != nil {
+			return nil, err
+		}
+
+		withdrawnRewards := unvestedRewards(truncatedRewards, vestedRewards)
+		if !withdrawnRewards.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, k.GetRewardsReservePoolAcc(ctx), k.GetRewardWithdrawAddr(ctx, farmerAcc), withdrawnRewards); err != nil {
 				return nil, err
 			}
 		}
 
 		k.DecreaseOutstandingRewards(ctx, stakingCoinDenom, rewards)
+		k.AddRewardsDust(ctx, rewards, vestedRewards.Add(withdrawnRewards...))
 	}
 
 	staking.StartingEpoch = currentEpoch
@@ -187,8 +362,29 @@ func (k Keeper) WithdrawRewards(ctx sdk.Context, farmerAcc sdk.AccAddress, staki
 	return truncatedRewards, nil
 }
 
+// WithdrawAllRewards withdraws accumulated rewards of all the farmer's stakings.
+// The rewards are sent to the farmer's reward withdraw address.
 func (k Keeper) WithdrawAllRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) (sdk.Coins, error) {
-	totalRewards := sdk.NewCoins()
+	totalRewards, totalWithdrawn, err := k.withdrawAllRewards(ctx, farmerAcc)
+	if err != nil {
+		return nil, err
+	}
+
+	if !totalWithdrawn.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, k.GetRewardsReservePoolAcc(ctx), k.GetRewardWithdrawAddr(ctx, farmerAcc), totalWithdrawn); err != nil {
+			return nil, err
+		}
+	}
+
+	return totalRewards, nil
+}
+
+// withdrawAllRewards settles accumulated rewards of all the farmer's stakings without sending them.
+// It returns the total rewards and the part of them which is not vested and thus
+// has to be paid out of the rewards reserve pool by the caller.
+func (k Keeper) withdrawAllRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) (totalRewards, totalWithdrawn sdk.Coins, err error) {
+	totalRewards = sdk.NewCoins()
+	totalWithdrawn = sdk.NewCoins()
 	k.IterateStakingsByFarmer(ctx, farmerAcc, func(stakingCoinDenom string, staking types.Staking) (stop bool) {
 		currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom)
 		rewards := k.CalculateRewards(ctx, farmerAcc, stakingCoinDenom, currentEpoch-1)
@@ -196,7 +392,16 @@ func (k Keeper) WithdrawAllRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) (s
 		totalRewards = totalRewards.Add(truncatedRewards...)
 
 		if !rewards.IsZero() {
+			var vestedRewards sdk.Coins
+			vestedRewards, err = k.VestRewards(ctx, farmerAcc, stakingCoinDenom, currentEpoch-1)
+			if err != nil {
+				return true
+			}
+			withdrawnRewards := unvestedRewards(truncatedRewards, vestedRewards)
+			totalWithdrawn = totalWithdrawn.Add(withdrawnRewards...)
+
 			k.DecreaseOutstandingRewards(ctx, stakingCoinDenom, rewards)
+			k.AddRewardsDust(ctx, rewards, vestedRewards.Add(withdrawnRewards...))
 		}
 
 		staking.StartingEpoch = currentEpoch
@@ -204,14 +409,23 @@ func (k Keeper) WithdrawAllRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) (s
 
 		return false
 	})
-
-	if !totalRewards.IsZero() {
-		if err := k.bankKeeper.SendCoins(ctx, k.GetRewardsReservePoolAcc(ctx), farmerAcc, totalRewards); err != nil {
-			return nil, err
-		}
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return totalRewards, nil
+	return totalRewards, totalWithdrawn, nil
+}
+
+// unvestedRewards returns the part of the truncated rewards which is not vested
+// and thus can be sent to the farmer directly.
+func unvestedRewards(truncatedRewards, vestedRewards sdk.Coins) sdk.Coins {
+	rewards, hasNeg := truncatedRewards.SafeSub(vestedRewards)
+	if hasNeg {
+		// Vested rewards are truncated per vesting duration, so they can exceed the
+		// truncated total rewards only by the smallest unit of decimal truncation.
+		return sdk.NewCoins()
+	}
+	return rewards
 }
 
 // Harvest claims farming rewards from the reward pool.
@@ -234,6 +448,8 @@ func (k Keeper) Harvest(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDe
 		),
 	})
 
+	k.AfterHarvest(ctx, farmerAcc, stakingCoinDenoms, totalRewards)
+
 	return nil
 }
 
@@ -242,17 +458,19 @@ type AllocationInfo struct {
 	Amount sdk.Coins
 }
 
-func (k Keeper) AllocationInfos(ctx sdk.Context) []AllocationInfo {
+// AllocationInfos returns the rewards to be allocated by each plan for the epoch ending at given time t.
+func (k Keeper) AllocationInfos(ctx sdk.Context, t time.Time) []AllocationInfo {
 	farmingPoolBalances := make(map[string]sdk.Coins)   // farmingPoolAddress => sdk.Coins
 	allocCoins := make(map[string]map[uint64]sdk.Coins) // farmingPoolAddress => (planId => sdk.Coins)
 
 	plans := make(map[uint64]types.PlanI)
-	for _, plan := range k.GetPlans(ctx) {
+	k.IterateActivePlans(ctx, func(plan types.PlanI) (stop bool) {
 		// Filter plans by their start time and end time.
-		if !plan.GetTerminated() && types.IsPlanActiveAt(plan, ctx.BlockTime()) {
+		if types.IsPlanActiveAt(plan, t) {
 			plans[plan.GetId()] = plan
 		}
-	}
+		return false
+	})
 
 	for _, plan := range plans {
 		farmingPoolAcc := plan.GetFarmingPoolAddress()
@@ -275,6 +493,10 @@ func (k Keeper) AllocationInfos(ctx sdk.Context) []AllocationInfo {
 			ac[plan.GetId()] = plan.EpochAmount
 		case *types.RatioPlan:
 			ac[plan.GetId()], _ = sdk.NewDecCoinsFromCoins(balances...).MulDecTruncate(plan.EpochRatio).TruncateDecimal()
+		case *types.DecayingPlan:
+			ac[plan.GetId()] = plan.CurrentEpochAmount()
+		case *types.SchedulePlan:
+			ac[plan.GetId()] = plan.EpochAmountAt(t)
 		}
 	}
 
@@ -298,13 +520,64 @@ func (k Keeper) AllocationInfos(ctx sdk.Context) []AllocationInfo {
 		}
 	}
 
+	// The allocation infos are built from maps, so they are sorted to allocate
+	// the rewards and call the hooks in a deterministic order.
+	sort.Slice(allocInfos, func(i, j int) bool {
+		return allocInfos[i].Plan.GetId() < allocInfos[j].Plan.GetId()
+	})
+
 	return allocInfos
 }
 
-func (k Keeper) AllocateRewards(ctx sdk.Context) error {
-	unitRewardsByDenom := map[string]sdk.DecCoins{} // (staking coin denom) => (unit rewards)
+// EstimateEpochRewards returns the rewards to be allocated to the staking coin denom per epoch,
+// and the rewards per staked unit when the additional amount is staked on top of the current
+// total stakings. Rewards of restricted plans are excluded, since they are given only to the
+// farmers in their allowlists.
+func (k Keeper) EstimateEpochRewards(ctx sdk.Context, stakingCoinDenom string, additionalStakingAmt sdk.Int) (totalStakingAmt sdk.Int, epochRewards sdk.Coins, unitRewards sdk.DecCoins) {
+	totalStakingAmt = additionalStakingAmt
+	if totalStakings, found := k.GetTotalStakings(ctx, stakingCoinDenom); found {
+		totalStakingAmt = totalStakingAmt.Add(totalStakings.Amount)
+	}
+
+	epochRewards = sdk.NewCoins()
+	for _, allocInfo := range k.AllocationInfos(ctx, ctx.BlockTime()) {
+		if allocInfo.Plan.GetRestricted() {
+			continue
+		}
 
-	for _, allocInfo := range k.AllocationInfos(ctx) {
+		weights := allocInfo.Plan.GetStakingCoinWeights()
+		weight := weights.AmountOf(stakingCoinDenom)
+		if !weight.IsPositive() {
+			continue
+		}
+		totalWeight := sdk.ZeroDec()
+		for _, w := range weights {
+			totalWeight = totalWeight.Add(w.Amount)
+		}
+
+		weightProportion := weight.QuoTruncate(totalWeight)
+		allocCoins, _ := sdk.NewDecCoinsFromCoins(allocInfo.Amount...).MulDecTruncate(weightProportion).TruncateDecimal()
+		epochRewards = epochRewards.Add(allocCoins...)
+	}
+
+	unitRewards = sdk.DecCoins{}
+	if totalStakingAmt.IsPositive() {
+		unitRewards = sdk.NewDecCoinsFromCoins(epochRewards...).QuoDecTruncate(totalStakingAmt.ToDec())
+	}
+
+	return totalStakingAmt, epochRewards, unitRewards
+}
+
+// AllocateRewards allocates the rewards of the epoch ending at given time t.
+// The time is earlier than the block time when the epochs elapsed while the chain was halted
+// are advanced, so that each of them is allocated by the plans which were active at that time.
+func (k Keeper) AllocateRewards(ctx sdk.Context, t time.Time) error {
+	unitRewardsByDenom := map[string]sdk.DecCoins{}                      // (staking coin denom) => (unit rewards)
+	vestingUnitRewardsByDenom := map[string][]types.VestingUnitRewards{} // (staking coin denom) => (vesting unit rewards)
+	planUnitRewardsByDenom := map[string][]types.PlanUnitRewards{}       // (staking coin denom) => (restricted plan unit rewards)
+	unitRewardsByPlanByDenom := map[string][]types.PlanUnitRewards{}     // (staking coin denom) => (plan unit rewards)
+
+	for _, allocInfo := range k.AllocationInfos(ctx, t) {
 		totalWeight := sdk.ZeroDec()
 		for _, weight := range allocInfo.Plan.GetStakingCoinWeights() {
 			totalWeight = totalWeight.Add(weight.Amount)
@@ -313,6 +586,10 @@ func (k Keeper) AllocateRewards(ctx sdk.Context) error {
 		totalAllocCoins := sdk.NewCoins()
 		for _, weight := range allocInfo.Plan.GetStakingCoinWeights() {
 			totalStakings, found := k.GetTotalStakings(ctx, weight.Denom)
+			if allocInfo.Plan.GetRestricted() {
+				// Rewards of a restricted plan are shared only among the farmers in its allowlist.
+				totalStakings, found = k.GetPlanTotalStakings(ctx, allocInfo.Plan.GetId(), weight.Denom)
+			}
 			if !found {
 				continue
 			}
@@ -324,9 +601,29 @@ func (k Keeper) AllocateRewards(ctx sdk.Context) error {
 			allocCoins, _ := sdk.NewDecCoinsFromCoins(allocInfo.Amount...).MulDecTruncate(weightProportion).TruncateDecimal()
 			allocCoinsDec := sdk.NewDecCoinsFromCoins(allocCoins...)
 
-			unitRewardsByDenom[weight.Denom] = unitRewardsByDenom[weight.Denom].Add(allocCoinsDec.QuoDecTruncate(totalStakings.Amount.ToDec())...)
+			unitRewards := allocCoinsDec.QuoDecTruncate(totalStakings.Amount.ToDec())
+			if allocInfo.Plan.GetRestricted() {
+				planUnitRewardsByDenom[weight.Denom] = types.AddPlanUnitRewards(
+					planUnitRewardsByDenom[weight.Denom], allocInfo.Plan.GetId(), unitRewards)
+				// Make sure that the epoch of the staking coin denom advances.
+				unitRewardsByDenom[weight.Denom] = unitRewardsByDenom[weight.Denom].Add()
+			} else {
+				unitRewardsByDenom[weight.Denom] = unitRewardsByDenom[weight.Denom].Add(unitRewards...)
+				unitRewardsByPlanByDenom[weight.Denom] = types.AddPlanUnitRewards(
+					unitRewardsByPlanByDenom[weight.Denom], allocInfo.Plan.GetId(), unitRewards)
+				if vestingDuration := allocInfo.Plan.GetRewardVestingDuration(); vestingDuration > 0 {
+					vestingUnitRewardsByDenom[weight.Denom] = types.AddVestingUnitRewards(
+						vestingUnitRewardsByDenom[weight.Denom], vestingDuration, unitRewards)
+				}
+			}
 
-			k.IncreaseOutstandingRewards(ctx, weight.Denom, allocCoinsDec)
+			// The rewards the farmers are entitled to can be less than the allocated coins
+			// due to the truncation of the unit rewards, and the remainder is the dust.
+			outstandingRewards := unitRewards.MulDecTruncate(totalStakings.Amount.ToDec())
+			k.IncreaseOutstandingRewards(ctx, weight.Denom, outstandingRewards)
+			if dust := allocCoinsDec.Sub(outstandingRewards); !dust.IsZero() {
+				k.SetRewardsDust(ctx, k.GetRewardsDust(ctx).Add(dust...))
+			}
 
 			totalAllocCoins = totalAllocCoins.Add(allocCoins...)
 		}
@@ -340,9 +637,11 @@ func (k Keeper) AllocateRewards(ctx sdk.Context) error {
 			return err
 		}
 
-		t := ctx.BlockTime()
 		_ = allocInfo.Plan.SetLastDistributionTime(&t)
 		_ = allocInfo.Plan.SetDistributedCoins(allocInfo.Plan.GetDistributedCoins().Add(totalAllocCoins...))
+		if plan, ok := allocInfo.Plan.(*types.DecayingPlan); ok {
+			plan.AllocatedEpochs++
+		}
 		k.SetPlan(ctx, allocInfo.Plan)
 
 		ctx.EventManager().EmitEvents(sdk.Events{
@@ -352,14 +651,37 @@ func (k Keeper) AllocateRewards(ctx sdk.Context) error {
 				sdk.NewAttribute(types.AttributeKeyAmount, totalAllocCoins.String()),
 			),
 		})
+
+		k.AfterAllocateRewards(ctx, allocInfo.Plan, totalAllocCoins)
 	}
 
 	for stakingCoinDenom, unitRewards := range unitRewardsByDenom {
 		currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom)
 		historical, _ := k.GetHistoricalRewards(ctx, stakingCoinDenom, currentEpoch-1)
+		cumulativeVestingUnitRewards := historical.CumulativeVestingUnitRewards
+		for _, r := range vestingUnitRewardsByDenom[stakingCoinDenom] {
+			cumulativeVestingUnitRewards = types.AddVestingUnitRewards(cumulativeVestingUnitRewards, r.VestingDuration, r.CumulativeUnitRewards)
+		}
+		cumulativePlanUnitRewards := historical.CumulativePlanUnitRewards
+		for _, r := range planUnitRewardsByDenom[stakingCoinDenom] {
+			cumulativePlanUnitRewards = types.AddPlanUnitRewards(cumulativePlanUnitRewards, r.PlanId, r.CumulativeUnitRewards)
+		}
+		cumulativeUnitRewardsByPlan := historical.CumulativeUnitRewardsByPlan
+		for _, r := range unitRewardsByPlanByDenom[stakingCoinDenom] {
+			cumulativeUnitRewardsByPlan = types.AddPlanUnitRewards(cumulativeUnitRewardsByPlan, r.PlanId, r.CumulativeUnitRewards)
+		}
+		// The latest historical rewards are referenced by the current epoch, since the
+		// rewards of all stakings are calculated up to them.
 		k.SetHistoricalRewards(ctx, stakingCoinDenom, currentEpoch, types.HistoricalRewards{
-			CumulativeUnitRewards: historical.CumulativeUnitRewards.Add(unitRewards...),
+			CumulativeUnitRewards:        historical.CumulativeUnitRewards.Add(unitRewards...),
+			CumulativeVestingUnitRewards: cumulativeVestingUnitRewards,
+			CumulativePlanUnitRewards:    cumulativePlanUnitRewards,
+			ReferenceCount:               1,
+			CumulativeUnitRewardsByPlan:  cumulativeUnitRewardsByPlan,
 		})
+		if currentEpoch > 0 {
+			k.decrementReferenceCount(ctx, stakingCoinDenom, currentEpoch-1)
+		}
 		k.SetCurrentEpoch(ctx, stakingCoinDenom, currentEpoch+1)
 	}
 
@@ -391,6 +713,9 @@ func (k Keeper) ValidateOutstandingRewards(ctx sdk.Context) error {
 		return false
 	})
 
+	// The rewards dust not swept yet is also kept in the rewards reserve pool.
+	totalOutstandingRewards = totalOutstandingRewards.Add(k.GetRewardsDust(ctx)...)
+
 	rewardsReservePoolBalances := sdk.NewDecCoinsFromCoins(k.bankKeeper.GetAllBalances(ctx, k.GetRewardsReservePoolAcc(ctx))...)
 	_, hasNeg := rewardsReservePoolBalances.SafeSub(totalOutstandingRewards)
 	if hasNeg {
@@ -399,3 +724,50 @@ func (k Keeper) ValidateOutstandingRewards(ctx sdk.Context) error {
 
 	return nil
 }
+
+// ValidateHistoricalRewardsReferenceCount checks that the reference count of each historical rewards
+// equals the number of stakings referring to them, plus one if they are the latest historical rewards
+// of the staking coin denom.
+func (k Keeper) ValidateHistoricalRewardsReferenceCount(ctx sdk.Context) error {
+	type denomEpoch struct {
+		stakingCoinDenom string
+		epoch            uint64
+	}
+	referenceCounts := map[denomEpoch]uint32{}
+	k.IterateStakings(ctx, func(stakingCoinDenom string, _ sdk.AccAddress, staking types.Staking) (stop bool) {
+		if staking.StartingEpoch > 0 {
+			referenceCounts[denomEpoch{stakingCoinDenom, staking.StartingEpoch - 1}]++
+		}
+		return false
+	})
+	k.IterateCurrentEpochs(ctx, func(stakingCoinDenom string, currentEpoch uint64) (stop bool) {
+		if currentEpoch > 0 {
+			referenceCounts[denomEpoch{stakingCoinDenom, currentEpoch - 1}]++
+		}
+		return false
+	})
+
+	var err error
+	k.IterateHistoricalRewards(ctx, func(stakingCoinDenom string, epoch uint64, rewards types.HistoricalRewards) (stop bool) {
+		key := denomEpoch{stakingCoinDenom, epoch}
+		if rewards.ReferenceCount != referenceCounts[key] {
+			err = sdkerrors.Wrapf(
+				types.ErrInvalidHistoricalRewardsReferenceCount, "historical rewards of %s at epoch %d have reference count %d, expected %d",
+				stakingCoinDenom, epoch, rewards.ReferenceCount, referenceCounts[key])
+			return true
+		}
+		delete(referenceCounts, key)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	// Every referred historical rewards must exist.
+	for key := range referenceCounts {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidHistoricalRewardsReferenceCount, "historical rewards of %s at epoch %d not found", key.stakingCoinDenom, key.epoch)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	simapp "github.com/tendermint/farming/app"
+	"github.com/tendermint/farming/x/farming/keeper"
 	"github.com/tendermint/farming/x/farming/types"
 
 	_ "github.com/stretchr/testify/suite"
@@ -123,7 +124,7 @@ func (suite *KeeperTestSuite) TestAllocationInfos() {
 			}
 
 			suite.ctx = suite.ctx.WithBlockTime(tc.t)
-			distrInfos := suite.keeper.AllocationInfos(suite.ctx)
+			distrInfos := suite.keeper.AllocationInfos(suite.ctx, suite.ctx.BlockTime())
 			if suite.Len(distrInfos, len(tc.distrAmts)) {
 				for _, distrInfo := range distrInfos {
 					distrAmt, ok := tc.distrAmts[distrInfo.Plan.GetId()]
@@ -145,7 +146,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards() {
 
 	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
 	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000), sdk.NewInt64Coin(denom2, 1000000)))
-	suite.keeper.ProcessQueuedCoins(suite.ctx)
+	suite.processQueuedStakings()
 
 	prevDistrCoins := map[uint64]sdk.Coins{}
 
@@ -153,7 +154,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards() {
 	for i := 0; i < 365; i++ {
 		suite.ctx = suite.ctx.WithBlockTime(t)
 
-		err := suite.keeper.AllocateRewards(suite.ctx)
+		err := suite.keeper.AllocateRewards(suite.ctx, suite.ctx.BlockTime())
 		suite.Require().NoError(err)
 
 		for _, plan := range suite.sampleFixedAmtPlans {
@@ -246,6 +247,98 @@ func (suite *KeeperTestSuite) TestAllocateRewards_RatioPlanOverBalances() {
 	suite.Require().True(rewards.IsZero())
 }
 
+func (suite *KeeperTestSuite) TestAllocateRewards_DecayingPlan() {
+	farmingPoolAcc := simapp.AddTestAddrs(suite.app, suite.ctx, 1, sdk.ZeroInt())[0]
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, farmingPoolAcc, sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)))
+	suite.Require().NoError(err)
+
+	// The epoch amount is halved every 2 epochs.
+	suite.SetDecayingPlan(1, farmingPoolAcc, map[string]string{denom1: "1.0"}, map[string]int64{denom3: 100000}, "0.5", 2)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch() // queued coins => staked coins
+
+	for _, expected := range []int64{100000, 100000, 50000, 50000, 25000, 25000, 12500} {
+		balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, farmingPoolAcc)
+		suite.AdvanceEpoch()
+		balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, farmingPoolAcc)
+		suite.Require().True(intEq(sdk.NewInt(expected), balancesBefore.Sub(balancesAfter).AmountOf(denom3)))
+	}
+
+	plan, found := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().True(found)
+	decayingPlan := plan.(*types.DecayingPlan)
+	suite.Require().Equal(uint64(7), decayingPlan.AllocatedEpochs)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 362500)), decayingPlan.GetDistributedCoins()))
+
+	rewards := suite.keeper.AllRewards(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 362500)), rewards))
+}
+
+func (suite *KeeperTestSuite) TestAllocateRewards_DecayingPlanNotAllocated() {
+	farmingPoolAcc := simapp.AddTestAddrs(suite.app, suite.ctx, 1, sdk.ZeroInt())[0]
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, farmingPoolAcc, sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)))
+	suite.Require().NoError(err)
+
+	suite.SetDecayingPlan(1, farmingPoolAcc, map[string]string{denom1: "1.0"}, map[string]int64{denom3: 100000}, "0.5", 1)
+
+	// The plan doesn't decay while there is no staking to allocate rewards to.
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().Equal(uint64(0), plan.(*types.DecayingPlan).AllocatedEpochs)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 100000)), plan.(*types.DecayingPlan).CurrentEpochAmount()))
+}
+
+func (suite *KeeperTestSuite) TestAllocateRewards_SchedulePlan() {
+	farmingPoolAcc := simapp.AddTestAddrs(suite.app, suite.ctx, 1, sdk.ZeroInt())[0]
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, farmingPoolAcc, sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)))
+	suite.Require().NoError(err)
+
+	suite.SetSchedulePlan(1, farmingPoolAcc, map[string]string{denom1: "1.0"}, []types.SchedulePhase{
+		types.NewSchedulePhase(
+			types.ParseTime("2021-09-01T00:00:00Z"), types.ParseTime("2021-10-01T00:00:00Z"),
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000))),
+		types.NewSchedulePhase(
+			types.ParseTime("2021-10-01T00:00:00Z"), types.ParseTime("2021-12-01T00:00:00Z"),
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 500))),
+		types.NewSchedulePhase(
+			types.ParseTime("2021-12-01T00:00:00Z"), types.ParseTime("9999-12-31T00:00:00Z"),
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 100))),
+	})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch() // queued coins => staked coins
+
+	for _, tc := range []struct {
+		blockTime string
+		expected  int64
+	}{
+		{"2021-08-31T00:00:00Z", 0}, // before the first phase
+		{"2021-09-01T00:00:00Z", 1000},
+		{"2021-09-30T00:00:00Z", 1000},
+		{"2021-10-01T00:00:00Z", 500},
+		{"2021-11-30T00:00:00Z", 500},
+		{"2021-12-01T00:00:00Z", 100},
+		{"2022-06-01T00:00:00Z", 100},
+	} {
+		suite.ctx = suite.ctx.WithBlockTime(types.ParseTime(tc.blockTime))
+		balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, farmingPoolAcc)
+		suite.AdvanceEpoch()
+		balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, farmingPoolAcc)
+		suite.Require().True(intEq(sdk.NewInt(tc.expected), balancesBefore.Sub(balancesAfter).AmountOf(denom3)))
+	}
+
+	plan, found := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 3200)), plan.GetDistributedCoins()))
+	suite.Require().Nil(plan.(*types.SchedulePlan).CurrentPhase)
+
+	rewards := suite.keeper.AllRewards(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 3200)), rewards))
+}
+
 func (suite *KeeperTestSuite) TestOutstandingRewards() {
 	// The block time here is not important, and has chosen randomly.
 	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-09-01T00:00:00Z"))
@@ -268,10 +361,16 @@ func (suite *KeeperTestSuite) TestOutstandingRewards() {
 	suite.AdvanceEpoch() // Queued staking coins have now staked.
 	suite.AdvanceEpoch() // Allocate rewards for staked coins.
 
-	// After the first allocation of rewards, the outstanding rewards should be 1000denom3.
+	// After the first allocation of rewards, the outstanding rewards should be 1000denom3
+	// except for the remainder of the truncated unit rewards, which is the dust.
 	outstanding, found := suite.keeper.GetOutstandingRewards(suite.ctx, denom1)
 	suite.Require().True(found)
-	suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 1000)), outstanding.Rewards))
+	suite.Require().True(decCoinsEq(
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.MustNewDecFromStr("999.999999999999"))),
+		outstanding.Rewards))
+	suite.Require().True(decCoinsEq(
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.MustNewDecFromStr("0.000000000001"))),
+		suite.keeper.GetRewardsDust(suite.ctx)))
 
 	// All farmers harvest rewards, so the outstanding rewards should be (approximately)0.
 	suite.Harvest(suite.addrs[0], []string{denom1})
@@ -283,6 +382,88 @@ func (suite *KeeperTestSuite) TestOutstandingRewards() {
 	suite.Require().True(truncatedOutstanding.IsZero())
 }
 
+func (suite *KeeperTestSuite) TestRewardsByPlan() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetFixedAmountPlan(2, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetPlanType(2, types.PlanTypePrivate)
+	suite.SetFixedAmountPlan(3, suite.addrs[4], map[string]string{denom1: "0.5", denom2: "0.5"}, map[string]int64{denom3: 1000000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000), sdk.NewInt64Coin(denom2, 1000000)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	err := suite.keeper.AddPlanFarmers(suite.ctx, suite.addrs[4], 2, []string{suite.addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.AdvanceEpoch()
+
+	suite.Require().Equal([]types.PlanRewards{
+		{PlanId: 1, Rewards: sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000))},
+		{PlanId: 2, Rewards: sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000))},
+		{PlanId: 3, Rewards: sdk.NewCoins(sdk.NewInt64Coin(denom3, 750000))},
+	}, suite.keeper.RewardsByPlan(suite.ctx, suite.addrs[0], ""))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2250000)), suite.keeper.AllRewards(suite.ctx, suite.addrs[0])))
+
+	suite.Require().Equal([]types.PlanRewards{
+		{PlanId: 3, Rewards: sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000))},
+	}, suite.keeper.RewardsByPlan(suite.ctx, suite.addrs[0], denom2))
+
+	suite.Require().Equal([]types.PlanRewards{
+		{PlanId: 1, Rewards: sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000))},
+		{PlanId: 3, Rewards: sdk.NewCoins(sdk.NewInt64Coin(denom3, 250000))},
+	}, suite.keeper.RewardsByPlan(suite.ctx, suite.addrs[1], ""))
+
+	// The breakdown is reset once the rewards are withdrawn.
+	suite.Harvest(suite.addrs[0], []string{denom1})
+	suite.Require().Equal([]types.PlanRewards{
+		{PlanId: 3, Rewards: sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000))},
+	}, suite.keeper.RewardsByPlan(suite.ctx, suite.addrs[0], ""))
+}
+
+func (suite *KeeperTestSuite) TestEstimateEpochRewards() {
+	farmingPoolAcc := sdk.AccAddress("farmingPoolAcc")
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, farmingPoolAcc, sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000_000)))
+	suite.Require().NoError(err)
+
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetRatioPlan(2, farmingPoolAcc, map[string]string{denom1: "0.5", denom2: "0.5"}, "0.001")
+	// The farming pool of the plan doesn't have enough balances, so the plan is skipped.
+	suite.SetFixedAmountPlan(3, suite.addrs[3], map[string]string{denom1: "1"}, map[string]int64{denom3: 2_000_000_000})
+	// The rewards of the restricted plan are not given to everyone, so the plan is skipped.
+	suite.SetFixedAmountPlan(4, suite.addrs[2], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetPlanType(4, types.PlanTypePrivate)
+	err = suite.keeper.AddPlanFarmers(suite.ctx, suite.addrs[2], 4, []string{suite.addrs[1].String()})
+	suite.Require().NoError(err)
+
+	totalStakingAmt, epochRewards, unitRewards := suite.keeper.EstimateEpochRewards(suite.ctx, denom1, sdk.ZeroInt())
+	suite.Require().True(intEq(sdk.ZeroInt(), totalStakingAmt))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1500000)), epochRewards))
+	suite.Require().True(unitRewards.IsZero())
+
+	totalStakingAmt, _, unitRewards = suite.keeper.EstimateEpochRewards(suite.ctx, denom1, sdk.NewInt(1000000))
+	suite.Require().True(intEq(sdk.NewInt(1000000), totalStakingAmt))
+	suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.NewDecWithPrec(15, 1))), unitRewards))
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+
+	// The additional staking dilutes the rewards.
+	totalStakingAmt, epochRewards, unitRewards = suite.keeper.EstimateEpochRewards(suite.ctx, denom1, sdk.NewInt(2000000))
+	suite.Require().True(intEq(sdk.NewInt(3000000), totalStakingAmt))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1500000)), epochRewards))
+	suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.NewDecWithPrec(5, 1))), unitRewards))
+
+	// The estimation matches the actual allocation.
+	_, _, unitRewards = suite.keeper.EstimateEpochRewards(suite.ctx, denom1, sdk.ZeroInt())
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, unitRewards.AmountOf(denom3).MulInt64(1000000).TruncateInt64())),
+		suite.keeper.AllRewards(suite.ctx, suite.addrs[0])))
+
+	// The ratio plan depends on the balances of the farming pool, which have decreased by
+	// the rewards allocated to denom1 only, since no one has staked denom2.
+	_, epochRewards, _ = suite.keeper.EstimateEpochRewards(suite.ctx, denom2, sdk.ZeroInt())
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 499750)), epochRewards))
+}
+
 func (suite *KeeperTestSuite) TestHarvest() {
 	for _, plan := range suite.samplePlans {
 		suite.keeper.SetPlan(suite.ctx, plan)
@@ -292,11 +473,11 @@ func (suite *KeeperTestSuite) TestHarvest() {
 	suite.Require().EqualError(types.ErrStakingNotExists, err.Error())
 
 	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
-	suite.keeper.ProcessQueuedCoins(suite.ctx)
+	suite.processQueuedStakings()
 
 	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
 	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-05T00:00:00Z"))
-	err = suite.keeper.AllocateRewards(suite.ctx)
+	err = suite.keeper.AllocateRewards(suite.ctx, suite.ctx.BlockTime())
 	suite.Require().NoError(err)
 
 	rewards := suite.keeper.AllRewards(suite.ctx, suite.addrs[0])
@@ -337,22 +518,74 @@ func (suite *KeeperTestSuite) TestHistoricalRewards() {
 
 	// After a farmer has staked(not queued) coins, historical rewards records will be created for each epoch.
 	// Here we advance epoch three times, and this will create 3 historical rewards records.
+	// The staking starts at the first epoch, so it doesn't refer to any of them and
+	// only the latest one is kept in the store.
 	suite.AdvanceEpoch()
 	suite.AdvanceEpoch()
 	suite.AdvanceEpoch()
 
-	// First, ensure that we have only 3 entries in the store.
 	count = 0
 	suite.keeper.IterateHistoricalRewards(suite.ctx, func(stakingCoinDenom string, epoch uint64, rewards types.HistoricalRewards) (stop bool) {
 		count++
 		return false
 	})
-	suite.Require().Equal(count, 3)
+	suite.Require().Equal(1, count)
 
 	// Next, check if cumulative unit rewards is correct.
-	for i := uint64(0); i < 3; i++ {
-		historical, found := suite.keeper.GetHistoricalRewards(suite.ctx, denom1, i)
-		suite.Require().True(found)
-		suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, int64((i+1)*2))), historical.CumulativeUnitRewards))
+	historical, found := suite.keeper.GetHistoricalRewards(suite.ctx, denom1, 2)
+	suite.Require().True(found)
+	suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 6)), historical.CumulativeUnitRewards))
+	suite.Require().EqualValues(1, historical.ReferenceCount)
+}
+
+func (suite *KeeperTestSuite) TestHistoricalRewards_ReferenceCount() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+
+	historicalRewardsEpochs := func() []uint64 {
+		var epochs []uint64
+		suite.keeper.IterateHistoricalRewards(suite.ctx, func(stakingCoinDenom string, epoch uint64, rewards types.HistoricalRewards) (stop bool) {
+			epochs = append(epochs, epoch)
+			return false
+		})
+		return epochs
 	}
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch() // epoch 0
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch() // epoch 1, the staking of addrs[1] starts after it
+	suite.AdvanceEpoch() // epoch 2
+	suite.AdvanceEpoch() // epoch 3
+	suite.Require().Equal([]uint64{1, 3}, historicalRewardsEpochs())
+
+	historical, _ := suite.keeper.GetHistoricalRewards(suite.ctx, denom1, 1)
+	suite.Require().EqualValues(1, historical.ReferenceCount)
+
+	// Historical rewards no longer referenced by any staking are pruned.
+	suite.Harvest(suite.addrs[1], []string{denom1})
+	suite.Require().Equal([]uint64{3}, historicalRewardsEpochs())
+	historical, _ = suite.keeper.GetHistoricalRewards(suite.ctx, denom1, 3)
+	suite.Require().EqualValues(2, historical.ReferenceCount)
+
+	suite.AdvanceEpoch() // epoch 4
+	suite.Require().Equal([]uint64{3, 4}, historicalRewardsEpochs())
+
+	err := suite.keeper.Unstake(suite.ctx, suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{4}, historicalRewardsEpochs())
+
+	// The rewards are calculated the same as before.
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 3500000)),
+		suite.keeper.AllRewards(suite.ctx, suite.addrs[0])))
+
+	_, broken := keeper.HistoricalRewardsReferenceCountInvariant(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	historical, _ = suite.keeper.GetHistoricalRewards(suite.ctx, denom1, 4)
+	historical.ReferenceCount++
+	suite.keeper.SetHistoricalRewards(suite.ctx, denom1, 4, historical)
+	_, broken = keeper.HistoricalRewardsReferenceCountInvariant(suite.keeper)(suite.ctx)
+	suite.Require().True(broken)
 }
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -20,14 +22,38 @@ func (k Keeper) GetStaking(ctx sdk.Context, stakingCoinDenom string, farmerAcc s
 }
 
 // SetStaking implements Staking.
+// It keeps the reference counts of the historical rewards which the starting
+// epochs of the old and the new staking refer to up to date.
 func (k Keeper) SetStaking(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress, staking types.Staking) {
+	old, found := k.GetStaking(ctx, stakingCoinDenom, farmerAcc)
+	if !found || old.StartingEpoch != staking.StartingEpoch {
+		k.incrementStakingReference(ctx, stakingCoinDenom, staking.StartingEpoch)
+		if found {
+			k.decrementStakingReference(ctx, stakingCoinDenom, old.StartingEpoch)
+		}
+	}
+	k.setStaking(ctx, stakingCoinDenom, farmerAcc, staking)
+}
+
+// setStaking sets the staking without touching the reference counts of the historical rewards.
+func (k Keeper) setStaking(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress, staking types.Staking) {
+	old, found := k.GetStaking(ctx, stakingCoinDenom, farmerAcc)
+	if !found {
+		old.Amount = sdk.ZeroInt()
+	}
+	k.addTotalStakingCoins(ctx, stakingCoinDenom, staking.Amount.Sub(old.Amount))
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&staking)
 	store.Set(types.GetStakingKey(stakingCoinDenom, farmerAcc), bz)
 	store.Set(types.GetStakingIndexKey(farmerAcc, stakingCoinDenom), []byte{})
 }
 
+// DeleteStaking deletes the staking and releases its reference to the historical rewards.
 func (k Keeper) DeleteStaking(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress) {
+	if staking, found := k.GetStaking(ctx, stakingCoinDenom, farmerAcc); found {
+		k.decrementStakingReference(ctx, stakingCoinDenom, staking.StartingEpoch)
+		k.addTotalStakingCoins(ctx, stakingCoinDenom, staking.Amount.Neg())
+	}
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetStakingKey(stakingCoinDenom, farmerAcc))
 	store.Delete(types.GetStakingIndexKey(farmerAcc, stakingCoinDenom))
@@ -90,6 +116,11 @@ func (k Keeper) GetAllQueuedStakedCoinsByFarmer(ctx sdk.Context, farmerAcc sdk.A
 }
 
 func (k Keeper) SetQueuedStaking(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress, queuedStaking types.QueuedStaking) {
+	old, found := k.GetQueuedStaking(ctx, stakingCoinDenom, farmerAcc)
+	if !found {
+		old.Amount = sdk.ZeroInt()
+	}
+	k.addTotalStakingCoins(ctx, stakingCoinDenom, queuedStaking.Amount.Sub(old.Amount))
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&queuedStaking)
 	store.Set(types.GetQueuedStakingKey(stakingCoinDenom, farmerAcc), bz)
@@ -97,6 +128,9 @@ func (k Keeper) SetQueuedStaking(ctx sdk.Context, stakingCoinDenom string, farme
 }
 
 func (k Keeper) DeleteQueuedStaking(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress) {
+	if queuedStaking, found := k.GetQueuedStaking(ctx, stakingCoinDenom, farmerAcc); found {
+		k.addTotalStakingCoins(ctx, stakingCoinDenom, queuedStaking.Amount.Neg())
+	}
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetQueuedStakingKey(stakingCoinDenom, farmerAcc))
 	store.Delete(types.GetQueuedStakingIndexKey(farmerAcc, stakingCoinDenom))
@@ -173,6 +207,53 @@ func (k Keeper) DecreaseTotalStakings(ctx sdk.Context, stakingCoinDenom string,
 	}
 }
 
+// GetTotalStakingCoins returns the total amount of the staked and queued coins of the
+// staking coin denom. Unlike the total stakings, the boosts of locked stakings are not counted.
+func (k Keeper) GetTotalStakingCoins(ctx sdk.Context, stakingCoinDenom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTotalStakingCoinsKey(stakingCoinDenom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var amt sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &amt)
+	return amt.Int
+}
+
+// SetTotalStakingCoins sets the total amount of the staked and queued coins of the staking coin denom.
+// It deletes the record when the amount is zero.
+func (k Keeper) SetTotalStakingCoins(ctx sdk.Context, stakingCoinDenom string, amt sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if amt.IsZero() {
+		store.Delete(types.GetTotalStakingCoinsKey(stakingCoinDenom))
+		return
+	}
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: amt})
+	store.Set(types.GetTotalStakingCoinsKey(stakingCoinDenom), bz)
+}
+
+// IterateTotalStakingCoins iterates over the total staking coins of all the staking coin denoms.
+func (k Keeper) IterateTotalStakingCoins(ctx sdk.Context, cb func(stakingCoinDenom string, amt sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TotalStakingCoinsKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var amt sdk.IntProto
+		k.cdc.MustUnmarshal(iter.Value(), &amt)
+		if cb(types.ParseTotalStakingCoinsKey(iter.Key()), amt.Int) {
+			break
+		}
+	}
+}
+
+// addTotalStakingCoins adds the amount, which can be negative, to the total staking coins.
+func (k Keeper) addTotalStakingCoins(ctx sdk.Context, stakingCoinDenom string, amt sdk.Int) {
+	if amt.IsZero() {
+		return
+	}
+	k.SetTotalStakingCoins(ctx, stakingCoinDenom, k.GetTotalStakingCoins(ctx, stakingCoinDenom).Add(amt))
+}
+
 // ReserveStakingCoins sends staking coins to the staking reserve account.
 func (k Keeper) ReserveStakingCoins(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoins sdk.Coins) error {
 	if err := k.bankKeeper.SendCoins(ctx, farmerAcc, k.GetStakingReservePoolAcc(ctx), stakingCoins); err != nil {
@@ -189,18 +270,51 @@ func (k Keeper) ReleaseStakingCoins(ctx sdk.Context, farmerAcc sdk.AccAddress, u
 	return nil
 }
 
+// ValidateStakingCoins checks that the staking coins satisfy the allowed staking denoms,
+// the max total stakings and the min staking amounts defined in params.
+func (k Keeper) ValidateStakingCoins(ctx sdk.Context, amount sdk.Coins) error {
+	params := k.GetParams(ctx)
+	for _, coin := range amount {
+		if !params.IsStakingDenomAllowed(coin.Denom) {
+			return sdkerrors.Wrapf(types.ErrStakingDenomNotAllowed, "%s is not allowed to be staked", coin.Denom)
+		}
+
+		if minAmt := params.MinStakingAmounts.AmountOf(coin.Denom); coin.Amount.LT(minAmt) {
+			return sdkerrors.Wrapf(types.ErrStakingAmountTooSmall, "%s is smaller than %s%s", coin, minAmt, coin.Denom)
+		}
+
+		if maxAmt := params.MaxTotalStakings.AmountOf(coin.Denom); maxAmt.IsPositive() {
+			totalAmt := k.GetTotalStakingCoins(ctx, coin.Denom)
+			if totalAmt.Add(coin.Amount).GT(maxAmt) {
+				return sdkerrors.Wrapf(
+					types.ErrMaxTotalStakingExceeded, "staking %s exceeds the max total staking %s%s, %s%s already staked or queued",
+					coin, maxAmt, coin.Denom, totalAmt, coin.Denom)
+			}
+		}
+	}
+	return nil
+}
+
 // Stake stores staking coins to queued coins, and it will be processed in the next epoch.
 func (k Keeper) Stake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.ValidateStakingCoins(ctx, amount); err != nil {
+		return err
+	}
+
 	if err := k.ReserveStakingCoins(ctx, farmerAcc, amount); err != nil {
 		return err
 	}
 
 	for _, coin := range amount {
+		if err := k.SettleQueuedStaking(ctx, coin.Denom, farmerAcc); err != nil {
+			return err
+		}
 		queuedStaking, found := k.GetQueuedStaking(ctx, coin.Denom, farmerAcc)
 		if !found {
 			queuedStaking.Amount = sdk.ZeroInt()
 		}
 		queuedStaking.Amount = queuedStaking.Amount.Add(coin.Amount)
+		queuedStaking.QueuedEpoch = k.GetEpochNumber(ctx)
 		k.SetQueuedStaking(ctx, coin.Denom, farmerAcc, queuedStaking)
 	}
 
@@ -212,14 +326,30 @@ func (k Keeper) Stake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coin
 		),
 	})
 
+	k.AfterStake(ctx, farmerAcc, amount)
+
 	return nil
 }
 
 // Unstake unstakes an amount of staking coins from the staking reserve account.
+// If the unstaking period is set in params, the coins removed from the staking
+// enter the unbonding queue and are paid out after the unstaking period.
 func (k Keeper) Unstake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coins) error {
 	// TODO: send coins at once, not in every WithdrawRewards
 
+	unbondingCoins := sdk.NewCoins()
+	burningReceipts := sdk.NewCoins()
 	for _, coin := range amount {
+		if err := k.SettleQueuedStaking(ctx, coin.Denom, farmerAcc); err != nil {
+			return err
+		}
+		if err := k.UnlockExpiredStakings(ctx, coin.Denom, farmerAcc); err != nil {
+			return err
+		}
+		if err := k.SyncReceiptStaking(ctx, farmerAcc, coin.Denom); err != nil {
+			return err
+		}
+
 		staking, found := k.GetStaking(ctx, coin.Denom, farmerAcc)
 		if !found {
 			staking.Amount = sdk.ZeroInt()
@@ -236,6 +366,30 @@ func (k Keeper) Unstake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Co
 				sdkerrors.ErrInsufficientFunds, "%s%s is smaller than %s%s", availableAmt, coin.Denom, coin.Amount, coin.Denom)
 		}
 
+		// Locked coins are queued until the locked staking is boosted.
+		lockedQueuedAmt, lockedStakedAmt := sdk.ZeroInt(), sdk.ZeroInt()
+		for _, lock := range k.GetLockedStakingsByFarmer(ctx, farmerAcc, coin.Denom) {
+			if lock.Boosted {
+				lockedStakedAmt = lockedStakedAmt.Add(lock.Amount)
+			} else {
+				lockedQueuedAmt = lockedQueuedAmt.Add(lock.Amount)
+			}
+		}
+		unlockedQueuedAmt := sdk.MaxInt(queuedStaking.Amount.Sub(lockedQueuedAmt), sdk.ZeroInt())
+		unlockedStakedAmt := sdk.MaxInt(staking.Amount.Sub(lockedStakedAmt), sdk.ZeroInt())
+		unlockedAmt := unlockedQueuedAmt.Add(unlockedStakedAmt)
+		if unlockedAmt.LT(coin.Amount) {
+			return sdkerrors.Wrapf(
+				types.ErrStakingLocked, "unlocked %s%s is smaller than %s%s", unlockedAmt, coin.Denom, coin.Amount, coin.Denom)
+		}
+
+		// Coins not backed by receipts are unstaked first, and the receipts
+		// of the rest are burned.
+		notBackedAmt := sdk.MaxInt(unlockedAmt.Sub(k.GetReceiptStaking(ctx, coin.Denom, farmerAcc)), sdk.ZeroInt())
+		if coin.Amount.GT(notBackedAmt) {
+			burningReceipts = burningReceipts.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(notBackedAmt)))
+		}
+
 		if staking.Amount.IsPositive() {
 			if _, err := k.WithdrawRewards(ctx, farmerAcc, coin.Denom); err != nil {
 				return err
@@ -244,11 +398,11 @@ func (k Keeper) Unstake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Co
 
 		removedFromStaking := sdk.ZeroInt()
 
-		queuedStaking.Amount = queuedStaking.Amount.Sub(coin.Amount)
-		if queuedStaking.Amount.IsNegative() {
-			staking.Amount = staking.Amount.Add(queuedStaking.Amount)
-			removedFromStaking = queuedStaking.Amount.Neg()
-			queuedStaking.Amount = sdk.ZeroInt()
+		removedFromQueued := sdk.MinInt(coin.Amount, unlockedQueuedAmt)
+		queuedStaking.Amount = queuedStaking.Amount.Sub(removedFromQueued)
+		if removedFromQueued.LT(coin.Amount) {
+			removedFromStaking = coin.Amount.Sub(removedFromQueued)
+			staking.Amount = staking.Amount.Sub(removedFromStaking)
 			if staking.Amount.IsPositive() {
 				currentEpoch := k.GetCurrentEpoch(ctx, coin.Denom)
 				staking.StartingEpoch = currentEpoch
@@ -266,6 +420,110 @@ func (k Keeper) Unstake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Co
 
 		if removedFromStaking.IsPositive() {
 			k.DecreaseTotalStakings(ctx, coin.Denom, removedFromStaking)
+			k.DecreasePlanTotalStakingsByFarmer(ctx, farmerAcc, coin.Denom, removedFromStaking)
+			unbondingCoins = unbondingCoins.Add(sdk.NewCoin(coin.Denom, removedFromStaking))
+		}
+	}
+
+	if !burningReceipts.IsZero() {
+		if err := k.BurnReceipts(ctx, farmerAcc, burningReceipts); err != nil {
+			return err
+		}
+	}
+
+	// Coins removed from the staking have to wait for the unstaking period before
+	// they are paid out, while queued coins are released immediately since they
+	// have never earned rewards.
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+		sdk.NewAttribute(types.AttributeKeyUnstakingCoins, amount.String()),
+	}
+	releasingCoins := amount
+	if unstakingPeriod := k.GetParams(ctx).UnstakingPeriod; unstakingPeriod > 0 && !unbondingCoins.IsZero() {
+		completionTime := ctx.BlockTime().Add(unstakingPeriod)
+		k.SetUnbondingStakingEntry(ctx, farmerAcc, ctx.BlockHeight(), completionTime, unbondingCoins)
+		releasingCoins = amount.Sub(unbondingCoins)
+		attrs = append(attrs,
+			sdk.NewAttribute(types.AttributeKeyUnbondingCoins, unbondingCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.String()),
+		)
+	}
+
+	if !releasingCoins.IsZero() {
+		if err := k.ReleaseStakingCoins(ctx, farmerAcc, releasingCoins); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeUnstake, attrs...),
+	})
+
+	k.AfterUnstake(ctx, farmerAcc, amount)
+
+	return nil
+}
+
+// CancelQueuedStaking releases queued coins of the farmer which are not staked yet.
+// Unlike Unstake, it never touches the staked coins, so neither the rewards are
+// withdrawn nor the starting epoch of the staking is reset.
+// Queued coins of locked stakings can't be cancelled, and the receipts of
+// receipt-backed queued coins are burned.
+func (k Keeper) CancelQueuedStaking(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coins) error {
+	burningReceipts := sdk.NewCoins()
+	for _, coin := range amount {
+		if err := k.SettleQueuedStaking(ctx, coin.Denom, farmerAcc); err != nil {
+			return err
+		}
+		queuedStaking, found := k.GetQueuedStaking(ctx, coin.Denom, farmerAcc)
+		if !found {
+			queuedStaking.Amount = sdk.ZeroInt()
+		}
+
+		if queuedStaking.Amount.LT(coin.Amount) {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "queued %s%s is smaller than %s%s", queuedStaking.Amount, coin.Denom, coin.Amount, coin.Denom)
+		}
+
+		// Locked coins are queued until the locked staking is boosted.
+		lockedQueuedAmt, lockedStakedAmt := sdk.ZeroInt(), sdk.ZeroInt()
+		for _, lock := range k.GetLockedStakingsByFarmer(ctx, farmerAcc, coin.Denom) {
+			if lock.Boosted {
+				lockedStakedAmt = lockedStakedAmt.Add(lock.Amount)
+			} else {
+				lockedQueuedAmt = lockedQueuedAmt.Add(lock.Amount)
+			}
+		}
+		unlockedQueuedAmt := sdk.MaxInt(queuedStaking.Amount.Sub(lockedQueuedAmt), sdk.ZeroInt())
+		if unlockedQueuedAmt.LT(coin.Amount) {
+			return sdkerrors.Wrapf(
+				types.ErrStakingLocked, "unlocked queued %s%s is smaller than %s%s", unlockedQueuedAmt, coin.Denom, coin.Amount, coin.Denom)
+		}
+
+		// Coins not backed by receipts are cancelled first, and the receipts
+		// of the rest are burned.
+		staking, found := k.GetStaking(ctx, coin.Denom, farmerAcc)
+		if !found {
+			staking.Amount = sdk.ZeroInt()
+		}
+		unlockedStakedAmt := sdk.MaxInt(staking.Amount.Sub(lockedStakedAmt), sdk.ZeroInt())
+		unlockedAmt := unlockedQueuedAmt.Add(unlockedStakedAmt)
+		notBackedAmt := sdk.MaxInt(unlockedAmt.Sub(k.GetReceiptStaking(ctx, coin.Denom, farmerAcc)), sdk.ZeroInt())
+		if coin.Amount.GT(notBackedAmt) {
+			burningReceipts = burningReceipts.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(notBackedAmt)))
+		}
+
+		queuedStaking.Amount = queuedStaking.Amount.Sub(coin.Amount)
+		if queuedStaking.Amount.IsPositive() {
+			k.SetQueuedStaking(ctx, coin.Denom, farmerAcc, queuedStaking)
+		} else {
+			k.DeleteQueuedStaking(ctx, coin.Denom, farmerAcc)
+		}
+	}
+
+	if !burningReceipts.IsZero() {
+		if err := k.BurnReceipts(ctx, farmerAcc, burningReceipts); err != nil {
+			return err
 		}
 	}
 
@@ -275,48 +533,280 @@ func (k Keeper) Unstake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Co
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeUnstake,
+			types.EventTypeCancelQueuedStaking,
 			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
-			sdk.NewAttribute(types.AttributeKeyUnstakingCoins, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyQueuedCoins, amount.String()),
 		),
 	})
 
+	k.AfterUnstake(ctx, farmerAcc, amount)
+
 	return nil
 }
 
-// ProcessQueuedCoins moves queued coins into staked coins.
-func (k Keeper) ProcessQueuedCoins(ctx sdk.Context) {
-	k.IterateQueuedStakings(ctx, func(stakingCoinDenom string, farmerAcc sdk.AccAddress, queuedStaking types.QueuedStaking) (stop bool) {
-		staking, found := k.GetStaking(ctx, stakingCoinDenom, farmerAcc)
-		if found {
-			if _, err := k.WithdrawRewards(ctx, farmerAcc, stakingCoinDenom); err != nil {
-				panic(err)
+// TransferStaking moves the staked and queued coins of the farmer for the staking coin denoms
+// to the recipient without unstaking.
+// The rewards of both the farmer and the recipient are withdrawn before the transfer,
+// so the recipient's staking starts accumulating rewards from the current epoch.
+func (k Keeper) TransferStaking(ctx sdk.Context, farmerAcc, recipientAcc sdk.AccAddress, stakingCoinDenoms []string) error {
+	if k.blockedAddrs[recipientAcc.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", recipientAcc)
+	}
+
+	transferredCoins := sdk.NewCoins()
+	for _, denom := range stakingCoinDenoms {
+		if err := k.SettleQueuedStaking(ctx, denom, farmerAcc); err != nil {
+			return err
+		}
+		if err := k.SettleQueuedStaking(ctx, denom, recipientAcc); err != nil {
+			return err
+		}
+		if err := k.UnlockExpiredStakings(ctx, denom, farmerAcc); err != nil {
+			return err
+		}
+
+		// Locked stakings are bound to the farmer, so they can't be transferred until they expire.
+		if len(k.GetLockedStakingsByFarmer(ctx, farmerAcc, denom)) > 0 {
+			return sdkerrors.Wrapf(types.ErrStakingLocked, "staking of %s has locked stakings", denom)
+		}
+		// Receipt-backed stakings are transferred by sending the receipts instead.
+		if k.GetReceiptStaking(ctx, denom, farmerAcc).IsPositive() {
+			return sdkerrors.Wrapf(types.ErrReceiptStaking, "staking of %s is backed by receipts", denom)
+		}
+
+		staking, stakingFound := k.GetStaking(ctx, denom, farmerAcc)
+		queuedStaking, queuedStakingFound := k.GetQueuedStaking(ctx, denom, farmerAcc)
+		if !stakingFound && !queuedStakingFound {
+			return sdkerrors.Wrapf(types.ErrStakingNotExists, "no staking for %s", denom)
+		}
+
+		if stakingFound {
+			if _, err := k.WithdrawRewards(ctx, farmerAcc, denom); err != nil {
+				return err
 			}
-		} else {
-			staking.Amount = sdk.ZeroInt()
+
+			recipientStaking, found := k.GetStaking(ctx, denom, recipientAcc)
+			if found {
+				if _, err := k.WithdrawRewards(ctx, recipientAcc, denom); err != nil {
+					return err
+				}
+			} else {
+				recipientStaking.Amount = sdk.ZeroInt()
+			}
+
+			k.DeleteStaking(ctx, denom, farmerAcc)
+			k.SetStaking(ctx, denom, recipientAcc, types.Staking{
+				Amount:        recipientStaking.Amount.Add(staking.Amount),
+				StartingEpoch: k.GetCurrentEpoch(ctx, denom),
+				BoostAmount:   recipientStaking.GetBoostAmount(),
+			})
+
+			// The total stakings stay the same, but the plans' total stakings
+			// depend on which farmer holds the staking.
+			k.DecreasePlanTotalStakingsByFarmer(ctx, farmerAcc, denom, staking.Amount)
+			k.IncreasePlanTotalStakingsByFarmer(ctx, recipientAcc, denom, staking.Amount)
+
+			transferredCoins = transferredCoins.Add(sdk.NewCoin(denom, staking.Amount))
 		}
 
-		k.DeleteQueuedStaking(ctx, stakingCoinDenom, farmerAcc)
-		k.SetStaking(ctx, stakingCoinDenom, farmerAcc, types.Staking{
-			Amount:        staking.Amount.Add(queuedStaking.Amount),
-			StartingEpoch: k.GetCurrentEpoch(ctx, stakingCoinDenom),
-		})
+		if queuedStakingFound {
+			recipientQueuedStaking, found := k.GetQueuedStaking(ctx, denom, recipientAcc)
+			if !found {
+				recipientQueuedStaking.Amount = sdk.ZeroInt()
+			}
+			recipientQueuedStaking.Amount = recipientQueuedStaking.Amount.Add(queuedStaking.Amount)
+			recipientQueuedStaking.QueuedEpoch = k.GetEpochNumber(ctx)
 
-		k.IncreaseTotalStakings(ctx, stakingCoinDenom, queuedStaking.Amount)
+			k.DeleteQueuedStaking(ctx, denom, farmerAcc)
+			k.SetQueuedStaking(ctx, denom, recipientAcc, recipientQueuedStaking)
 
-		return false
+			transferredCoins = transferredCoins.Add(sdk.NewCoin(denom, queuedStaking.Amount))
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferStaking,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipientAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyStakingCoins, transferredCoins.String()),
+		),
+	})
+
+	k.AfterUnstake(ctx, farmerAcc, transferredCoins)
+	k.AfterStake(ctx, recipientAcc, transferredCoins)
+
+	return nil
+}
+
+// MaxQueuedStakingsPerBlock is the maximum number of queued stakings processed in a block
+// after an epoch advancement. The rest of them are processed in the following blocks.
+const MaxQueuedStakingsPerBlock = 500
+
+// GetQueuedStakingsCursor returns the progress of processing the queued stakings
+// staked by the last epoch advancement. It returns false if there are no queued
+// stakings left to be processed.
+func (k Keeper) GetQueuedStakingsCursor(ctx sdk.Context) (cursor types.QueuedStakingsCursor, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.QueuedStakingsCursorKey)
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &cursor)
+	found = true
+	return
+}
+
+// SetQueuedStakingsCursor sets the progress of processing the queued stakings.
+func (k Keeper) SetQueuedStakingsCursor(ctx sdk.Context, cursor types.QueuedStakingsCursor) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&cursor)
+	store.Set(types.QueuedStakingsCursorKey, bz)
+}
+
+// DeleteQueuedStakingsCursor deletes the progress of processing the queued stakings.
+func (k Keeper) DeleteQueuedStakingsCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.QueuedStakingsCursorKey)
+}
+
+// stakeQueuedCoins moves the farmer's queued coins into the staked coins.
+// The locked stakings are boosted as of given time t.
+func (k Keeper) stakeQueuedCoins(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress, queuedStaking types.QueuedStaking, t time.Time) error {
+	staking, found := k.GetStaking(ctx, stakingCoinDenom, farmerAcc)
+	if found {
+		if _, err := k.WithdrawRewards(ctx, farmerAcc, stakingCoinDenom); err != nil {
+			return err
+		}
+	} else {
+		staking.Amount = sdk.ZeroInt()
+	}
+
+	boostAmt := k.BoostLockedStakings(ctx, stakingCoinDenom, farmerAcc, t)
+
+	k.DeleteQueuedStaking(ctx, stakingCoinDenom, farmerAcc)
+	k.SetStaking(ctx, stakingCoinDenom, farmerAcc, types.Staking{
+		Amount:        staking.Amount.Add(queuedStaking.Amount),
+		StartingEpoch: k.GetCurrentEpoch(ctx, stakingCoinDenom),
+		BoostAmount:   staking.GetBoostAmount().Add(boostAmt),
 	})
+
+	k.IncreaseTotalStakings(ctx, stakingCoinDenom, queuedStaking.Amount.Add(boostAmt))
+	k.IncreasePlanTotalStakingsByFarmer(ctx, farmerAcc, stakingCoinDenom, queuedStaking.Amount.Add(boostAmt))
+
+	return nil
 }
 
-// ValidateStakingReservedAmount checks that the balance of StakingReserveAcc greater than the amount of staked, queued coins in all staking objects.
+// StartProcessingQueuedStakings matures the queued coins queued so far and starts
+// processing them from the first queued staking. It is called on every epoch advancement
+// with the epoch time, and the first MaxQueuedStakingsPerBlock queued stakings are
+// processed right away.
+func (k Keeper) StartProcessingQueuedStakings(ctx sdk.Context, epochTime time.Time) error {
+	k.SetEpochNumber(ctx, k.GetEpochNumber(ctx)+1)
+	k.SetQueuedStakingsCursor(ctx, types.QueuedStakingsCursor{
+		NextKey:   types.QueuedStakingKeyPrefix,
+		EpochTime: epochTime,
+	})
+	return k.ProcessMaturedQueuedStakings(ctx, MaxQueuedStakingsPerBlock)
+}
+
+// ProcessMaturedQueuedStakings moves the matured queued coins into staked coins, for up to
+// limit queued stakings from the cursor. All the rest are processed if limit is zero.
+// The queued stakings not matured yet are skipped, but counted toward the limit so that
+// the number of queued stakings scanned in a block is bounded as well.
+// The result is the same as if the queued coins were staked at the epoch advancement,
+// since the stakings and the total stakings are only used on the next epoch advancement,
+// which waits until all the matured queued coins are processed.
+func (k Keeper) ProcessMaturedQueuedStakings(ctx sdk.Context, limit int) error {
+	cursor, found := k.GetQueuedStakingsCursor(ctx)
+	if !found {
+		return nil
+	}
+
+	type maturedQueuedStaking struct {
+		stakingCoinDenom string
+		farmerAcc        sdk.AccAddress
+		queuedStaking    types.QueuedStaking
+	}
+
+	epochNumber := k.GetEpochNumber(ctx)
+	var matured []maturedQueuedStaking
+	var nextKey []byte
+
+	scanned := 0
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(cursor.NextKey, sdk.PrefixEndBytes(types.QueuedStakingKeyPrefix))
+	for ; iter.Valid(); iter.Next() {
+		if limit > 0 && scanned == limit {
+			nextKey = append([]byte{}, iter.Key()...)
+			break
+		}
+		scanned++
+		var queuedStaking types.QueuedStaking
+		k.cdc.MustUnmarshal(iter.Value(), &queuedStaking)
+		if !queuedStaking.IsMaturedAt(epochNumber) {
+			continue
+		}
+		stakingCoinDenom, farmerAcc := types.ParseQueuedStakingKey(iter.Key())
+		matured = append(matured, maturedQueuedStaking{stakingCoinDenom, farmerAcc, queuedStaking})
+	}
+	iter.Close()
+
+	for _, m := range matured {
+		if err := k.stakeQueuedCoins(ctx, m.stakingCoinDenom, m.farmerAcc, m.queuedStaking, cursor.EpochTime); err != nil {
+			return err
+		}
+	}
+
+	if nextKey == nil {
+		k.DeleteQueuedStakingsCursor(ctx)
+	} else {
+		cursor.NextKey = nextKey
+		k.SetQueuedStakingsCursor(ctx, cursor)
+	}
+
+	return nil
+}
+
+// SettleQueuedStaking moves the farmer's queued coins into staked coins if they are
+// matured but not processed yet. It must be called before the farmer's queued coins
+// or staked coins are modified.
+func (k Keeper) SettleQueuedStaking(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress) error {
+	queuedStaking, found := k.GetQueuedStaking(ctx, stakingCoinDenom, farmerAcc)
+	if !found || !queuedStaking.IsMaturedAt(k.GetEpochNumber(ctx)) {
+		return nil
+	}
+	cursor, _ := k.GetQueuedStakingsCursor(ctx)
+	return k.stakeQueuedCoins(ctx, stakingCoinDenom, farmerAcc, queuedStaking, cursor.EpochTime)
+}
+
+// ValidateStakingReservedAmount checks that the balance of StakingReserveAcc greater than the amount of staked, queued, unbonding and unclaimed receipt-backed coins in all staking objects.
 func (k Keeper) ValidateStakingReservedAmount(ctx sdk.Context) error {
-	reservedCoins := sdk.NewCoins()
+	stakingCoins := sdk.NewCoins()
 	k.IterateStakings(ctx, func(stakingCoinDenom string, _ sdk.AccAddress, staking types.Staking) (stop bool) {
-		reservedCoins = reservedCoins.Add(sdk.NewCoin(stakingCoinDenom, staking.Amount))
+		stakingCoins = stakingCoins.Add(sdk.NewCoin(stakingCoinDenom, staking.Amount))
 		return false
 	})
 	k.IterateQueuedStakings(ctx, func(stakingCoinDenom string, _ sdk.AccAddress, queuedStaking types.QueuedStaking) (stop bool) {
-		reservedCoins = reservedCoins.Add(sdk.NewCoin(stakingCoinDenom, queuedStaking.Amount))
+		stakingCoins = stakingCoins.Add(sdk.NewCoin(stakingCoinDenom, queuedStaking.Amount))
+		return false
+	})
+	totalStakingCoins := sdk.NewCoins()
+	k.IterateTotalStakingCoins(ctx, func(stakingCoinDenom string, amt sdk.Int) (stop bool) {
+		totalStakingCoins = totalStakingCoins.Add(sdk.NewCoin(stakingCoinDenom, amt))
+		return false
+	})
+	if !totalStakingCoins.IsEqual(stakingCoins) {
+		return types.ErrInvalidStakingReservedAmount
+	}
+
+	reservedCoins := stakingCoins
+	k.IterateUnbondingStakings(ctx, func(ubd types.UnbondingStaking) (stop bool) {
+		reservedCoins = reservedCoins.Add(ubd.Balance()...)
+		return false
+	})
+	k.IterateUnclaimedReceiptStakings(ctx, func(stakingCoinDenom string, amt sdk.Int) (stop bool) {
+		reservedCoins = reservedCoins.Add(sdk.NewCoin(stakingCoinDenom, amt))
 		return false
 	})
 
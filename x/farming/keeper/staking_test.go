@@ -2,10 +2,18 @@ package keeper_test
 
 import (
 	"math/rand"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
 	_ "github.com/stretchr/testify/suite"
+
+	simapp "github.com/tendermint/farming/app"
+	"github.com/tendermint/farming/x/farming/keeper"
+	"github.com/tendermint/farming/x/farming/types"
 )
 
 func (suite *KeeperTestSuite) TestStake() {
@@ -114,7 +122,7 @@ func (suite *KeeperTestSuite) TestUnstake() {
 				sdk.NewInt64Coin(denom2, 1_000_000)))
 
 			// Make queued coins be staked.
-			suite.keeper.ProcessQueuedCoins(suite.ctx)
+			suite.processQueuedStakings()
 
 			suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)))
 
@@ -134,6 +142,141 @@ func (suite *KeeperTestSuite) TestUnstake() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCancelQueuedStaking() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	staking, _ := suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[0])
+	rewards := suite.keeper.AllRewards(suite.ctx, suite.addrs[0])
+	suite.Require().False(rewards.IsZero())
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)))
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+
+	// Staked coins can't be cancelled.
+	err := suite.keeper.CancelQueuedStaking(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500001)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	err = suite.keeper.CancelQueuedStaking(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 300000)))
+	suite.Require().NoError(err)
+
+	// Only the queued coins are returned, without withdrawing rewards.
+	suite.Require().True(coinsEq(
+		balancesBefore.Add(sdk.NewInt64Coin(denom1, 300000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(rewards, suite.keeper.AllRewards(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 200000)),
+		suite.keeper.GetAllQueuedStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	staking2, _ := suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().Equal(staking, staking2)
+
+	err = suite.keeper.CancelQueuedStaking(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 200000)))
+	suite.Require().NoError(err)
+	_, found := suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().False(found)
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestCancelQueuedStaking_Locked() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)))
+	err := suite.keeper.LockStake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)), 90*24*time.Hour)
+	suite.Require().NoError(err)
+
+	err = suite.keeper.CancelQueuedStaking(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500001)))
+	suite.Require().ErrorIs(err, types.ErrStakingLocked)
+	err = suite.keeper.CancelQueuedStaking(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestCancelQueuedStaking_Receipt() {
+	suite.LiquidStake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+
+	err := suite.keeper.CancelQueuedStaking(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 400000)))
+	suite.Require().NoError(err)
+
+	// The receipts of the cancelled coins are burned.
+	suite.Require().True(intEq(sdk.NewInt(600000), suite.keeper.GetReceiptStaking(suite.ctx, denom1, suite.addrs[0])))
+	suite.Require().True(intEq(
+		sdk.NewInt(600000),
+		suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[0], types.ReceiptDenom(denom1)).Amount))
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestStake_StakingParams() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.AllowedStakingDenoms = []string{denom1, denom2}
+	params.MaxTotalStakings = sdk.NewCoins(sdk.NewInt64Coin(denom1, 2000000))
+	params.MinStakingAmounts = sdk.NewCoins(sdk.NewInt64Coin(denom2, 1000))
+	suite.keeper.SetParams(suite.ctx, params)
+
+	err := suite.keeper.Stake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)))
+	suite.Require().ErrorIs(err, types.ErrStakingDenomNotAllowed)
+
+	err = suite.keeper.Stake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 999)))
+	suite.Require().ErrorIs(err, types.ErrStakingAmountTooSmall)
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 1000)))
+
+	// The max total staking applies to the coins of all farmers, including the queued ones.
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)))
+	err = suite.keeper.Stake(suite.ctx, suite.addrs[2], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500001)))
+	suite.Require().ErrorIs(err, types.ErrMaxTotalStakingExceeded)
+	suite.Stake(suite.addrs[2], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)))
+
+	// Locked and receipt-backed stakings are checked as well.
+	err = suite.keeper.LockStake(suite.ctx, suite.addrs[3], sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), 30*24*time.Hour)
+	suite.Require().ErrorIs(err, types.ErrStakingDenomNotAllowed)
+	err = suite.keeper.LiquidStake(suite.ctx, suite.addrs[3], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1)))
+	suite.Require().ErrorIs(err, types.ErrMaxTotalStakingExceeded)
+
+	// Unstaked coins free up room under the max total staking.
+	err = suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 300000)))
+	suite.Require().NoError(err)
+	suite.Stake(suite.addrs[3], sdk.NewCoins(sdk.NewInt64Coin(denom1, 300000)))
+
+	// Unbonding coins are no longer staked, so they don't count toward the max total staking.
+	suite.SetUnstakingPeriod(7 * 24 * time.Hour)
+	err = suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 200000)))
+	suite.Require().NoError(err)
+	_, found := suite.keeper.GetUnbondingStaking(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Stake(suite.addrs[3], sdk.NewCoins(sdk.NewInt64Coin(denom1, 200000)))
+	suite.Require().True(intEq(sdk.NewInt(2000000), suite.keeper.GetTotalStakingCoins(suite.ctx, denom1)))
+}
+
+func (suite *KeeperTestSuite) TestStake_StakingParamsProposal() {
+	handler := params.NewParamChangeProposalHandler(suite.app.ParamsKeeper)
+	content := proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+		proposal.NewParamChange(types.ModuleName, string(types.KeyAllowedStakingDenoms), `["denom1"]`),
+		proposal.NewParamChange(types.ModuleName, string(types.KeyMinStakingAmounts), `[{"denom":"denom1","amount":"1000"}]`),
+	})
+	suite.Require().NoError(handler(suite.ctx, content))
+
+	farmingParams := suite.keeper.GetParams(suite.ctx)
+	suite.Require().Equal([]string{denom1}, farmingParams.AllowedStakingDenoms)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000)), farmingParams.MinStakingAmounts))
+
+	err := suite.keeper.Stake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 1000)))
+	suite.Require().ErrorIs(err, types.ErrStakingDenomNotAllowed)
+	err = suite.keeper.Stake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 999)))
+	suite.Require().ErrorIs(err, types.ErrStakingAmountTooSmall)
+
+	// Invalid values are rejected.
+	content = proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+		proposal.NewParamChange(types.ModuleName, string(types.KeyAllowedStakingDenoms), `["denom1","denom1"]`),
+	})
+	suite.Require().Error(handler(suite.ctx, content))
+}
+
 func (suite *KeeperTestSuite) TestMultipleUnstake() {
 	// TODO: implement
 }
@@ -142,7 +285,7 @@ func (suite *KeeperTestSuite) TestTotalStaking() {
 	// TODO: implement
 }
 
-func (suite *KeeperTestSuite) TestProcessQueuedCoins() {
+func (suite *KeeperTestSuite) TestProcessQueuedStakings() {
 	for seed := int64(0); seed < 10; seed++ {
 		suite.SetupTest()
 
@@ -171,7 +314,7 @@ func (suite *KeeperTestSuite) TestProcessQueuedCoins() {
 			suite.Require().True(coinsEq(queuedCoins, suite.keeper.GetAllQueuedStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
 			suite.Require().True(coinsEq(stakedCoins, suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
 
-			suite.keeper.ProcessQueuedCoins(suite.ctx)
+			suite.processQueuedStakings()
 			stakedCoins = stakedCoins.Add(queuedCoins...)
 			queuedCoins = sdk.NewCoins()
 
@@ -180,3 +323,114 @@ func (suite *KeeperTestSuite) TestProcessQueuedCoins() {
 		}
 	}
 }
+
+// startQueuedStakingsPass matures the queued coins like an epoch advancement does,
+// but without processing any of them.
+func (suite *KeeperTestSuite) startQueuedStakingsPass() {
+	suite.keeper.SetEpochNumber(suite.ctx, suite.keeper.GetEpochNumber(suite.ctx)+1)
+	suite.keeper.SetQueuedStakingsCursor(suite.ctx, types.QueuedStakingsCursor{
+		NextKey:   types.QueuedStakingKeyPrefix,
+		EpochTime: suite.ctx.BlockTime(),
+	})
+}
+
+// processQueuedStakings stakes all the queued coins like an epoch advancement does,
+// but without allocating rewards.
+func (suite *KeeperTestSuite) processQueuedStakings() {
+	suite.startQueuedStakingsPass()
+	err := suite.keeper.ProcessMaturedQueuedStakings(suite.ctx, 0)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestProcessMaturedQueuedStakings() {
+	for _, addr := range suite.addrs[:3] {
+		suite.Stake(addr, sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	}
+	suite.startQueuedStakingsPass()
+
+	// Coins queued after the epoch advancement are not staked.
+	suite.Stake(suite.addrs[3], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	// Every queued staking scanned is counted toward the limit, whether it is matured or not.
+	for i := 0; i < 3; i++ {
+		err := suite.keeper.ProcessMaturedQueuedStakings(suite.ctx, 1)
+		suite.Require().NoError(err)
+		_, found := suite.keeper.GetQueuedStakingsCursor(suite.ctx)
+		suite.Require().True(found)
+	}
+
+	// The processing ends as soon as all the queued stakings are scanned.
+	err := suite.keeper.ProcessMaturedQueuedStakings(suite.ctx, 1)
+	suite.Require().NoError(err)
+	_, found := suite.keeper.GetQueuedStakingsCursor(suite.ctx)
+	suite.Require().False(found)
+
+	for _, addr := range suite.addrs[:3] {
+		suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, addr)))
+		suite.Require().True(suite.keeper.GetAllQueuedStakedCoinsByFarmer(suite.ctx, addr).IsZero())
+	}
+	suite.Require().True(suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[3]).IsZero())
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), suite.keeper.GetAllQueuedStakedCoinsByFarmer(suite.ctx, suite.addrs[3])))
+	totalStakings, _ := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(intEq(sdk.NewInt(3_000_000), totalStakings.Amount))
+}
+
+func (suite *KeeperTestSuite) TestSettleQueuedStaking() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.startQueuedStakingsPass()
+
+	// The matured queued coins are staked before the new coins are queued.
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)), suite.keeper.GetAllQueuedStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	totalStakings, _ := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), totalStakings.Amount))
+
+	queuedStaking, _ := suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().False(queuedStaking.IsMaturedAt(suite.keeper.GetEpochNumber(suite.ctx)))
+
+	// Nothing is left to be processed for the farmer.
+	err := suite.keeper.ProcessMaturedQueuedStakings(suite.ctx, 0)
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+}
+
+func (suite *KeeperTestSuite) TestAdvanceEpochProcessesQueuedStakingsAcrossBlocks() {
+	numFarmers := keeper.MaxQueuedStakingsPerBlock + 10
+	farmers := simapp.AddTestAddrs(suite.app, suite.ctx, numFarmers, sdk.ZeroInt())
+	for _, farmer := range farmers {
+		err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, farmer, sdk.NewCoins(sdk.NewInt64Coin(denom1, 2_000)))
+		suite.Require().NoError(err)
+		suite.Stake(farmer, sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000)))
+	}
+
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: int64(numFarmers) * 1_000})
+
+	// Only the first page of the queued stakings is processed on the epoch advancement.
+	suite.AdvanceEpoch()
+	_, found := suite.keeper.GetQueuedStakingsCursor(suite.ctx)
+	suite.Require().True(found)
+	totalStakings, _ := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(intEq(sdk.NewInt(keeper.MaxQueuedStakingsPerBlock*1_000), totalStakings.Amount))
+
+	// A farmer whose queued coins are not processed yet stakes more coins.
+	var pendingFarmer sdk.AccAddress
+	for _, farmer := range farmers {
+		if !suite.keeper.GetAllQueuedStakedCoinsByFarmer(suite.ctx, farmer).IsZero() {
+			pendingFarmer = farmer
+			break
+		}
+	}
+	suite.Require().NotNil(pendingFarmer)
+	suite.Stake(pendingFarmer, sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000)))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000)), suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, pendingFarmer)))
+
+	// The rest are processed before the rewards are allocated on the next epoch advancement,
+	// so every farmer earns the same rewards as if all of them were staked at once.
+	suite.AdvanceEpoch()
+	for _, farmer := range farmers {
+		// The rewards may have been withdrawn when the additional coins of the farmer are staked.
+		rewards := suite.keeper.Rewards(suite.ctx, farmer, denom1).Add(suite.app.BankKeeper.GetBalance(suite.ctx, farmer, denom3))
+		suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000)), rewards))
+	}
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/keeper"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+func (suite *KeeperTestSuite) TestTransferStaking() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)))
+
+	rewards0 := suite.keeper.AllRewards(suite.ctx, suite.addrs[0])
+	rewards1 := suite.keeper.AllRewards(suite.ctx, suite.addrs[1])
+	suite.Require().False(rewards0.IsZero())
+	suite.Require().False(rewards1.IsZero())
+	balances0 := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	balances1 := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])
+
+	err := suite.keeper.TransferStaking(suite.ctx, suite.addrs[0], suite.addrs[1], []string{denom1})
+	suite.Require().NoError(err)
+
+	// The rewards of both farmers are settled before the transfer.
+	suite.Require().True(coinsEq(balances0.Add(rewards0...), suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(balances1.Add(rewards1...), suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])))
+
+	_, found := suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().False(found)
+	_, found = suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().False(found)
+
+	staking, found := suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(2000000), staking.Amount))
+	suite.Require().Equal(suite.keeper.GetCurrentEpoch(suite.ctx, denom1), staking.StartingEpoch)
+	queuedStaking, found := suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(500000), queuedStaking.Amount))
+
+	totalStakings, _ := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(intEq(sdk.NewInt(2000000), totalStakings.Amount))
+	suite.Require().True(suite.keeper.AllRewards(suite.ctx, suite.addrs[1]).IsZero())
+
+	// The transferred staking earns rewards for the recipient from the next epoch.
+	// They are withdrawn automatically when the transferred queued coins are staked.
+	balances1 = suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])
+	suite.AdvanceEpoch()
+	balances1 = balances1.Add(sdk.NewInt64Coin(denom3, 1000000))
+	suite.Require().True(coinsEq(balances1, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])))
+	suite.Require().True(suite.keeper.AllRewards(suite.ctx, suite.addrs[0]).IsZero())
+	staking, _ = suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().True(intEq(sdk.NewInt(2500000), staking.Amount))
+
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), suite.keeper.AllRewards(suite.ctx, suite.addrs[1])))
+	suite.Require().True(suite.keeper.AllRewards(suite.ctx, suite.addrs[0]).IsZero())
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestTransferStaking_Errors() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+
+	err := suite.keeper.TransferStaking(suite.ctx, suite.addrs[0], suite.addrs[1], []string{denom1})
+	suite.Require().ErrorIs(err, types.ErrStakingNotExists)
+
+	err = suite.keeper.LockStake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)), 30*24*time.Hour)
+	suite.Require().NoError(err)
+	err = suite.keeper.TransferStaking(suite.ctx, suite.addrs[0], suite.addrs[1], []string{denom1})
+	suite.Require().ErrorIs(err, types.ErrStakingLocked)
+
+	moduleAcc := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	err = suite.keeper.TransferStaking(suite.ctx, suite.addrs[0], moduleAcc, []string{denom1})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// Expired locked stakings are unlocked before the transfer.
+	suite.AdvanceEpoch()
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-09-01T00:00:00Z"))
+	err = suite.keeper.TransferStaking(suite.ctx, suite.addrs[0], suite.addrs[1], []string{denom1})
+	suite.Require().NoError(err)
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestTransferStaking_RestrictedPlan() {
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetPlanType(1, types.PlanTypePrivate)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+
+	err := suite.keeper.AddPlanFarmers(suite.ctx, suite.addrs[4], 1, []string{suite.addrs[0].String()})
+	suite.Require().NoError(err)
+
+	// The plan total stakings follow the staking to the recipient.
+	err = suite.keeper.TransferStaking(suite.ctx, suite.addrs[0], suite.addrs[1], []string{denom1})
+	suite.Require().NoError(err)
+	_, found := suite.keeper.GetPlanTotalStakings(suite.ctx, 1, denom1)
+	suite.Require().False(found)
+
+	err = suite.keeper.TransferStaking(suite.ctx, suite.addrs[1], suite.addrs[0], []string{denom1})
+	suite.Require().NoError(err)
+	planTotalStakings, found := suite.keeper.GetPlanTotalStakings(suite.ctx, 1, denom1)
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(1000000), planTotalStakings.Amount))
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
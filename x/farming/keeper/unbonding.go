@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// GetUnbondingStaking returns the unbonding staking of the farmer.
+func (k Keeper) GetUnbondingStaking(ctx sdk.Context, farmerAcc sdk.AccAddress) (ubd types.UnbondingStaking, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUnbondingStakingKey(farmerAcc))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &ubd)
+	found = true
+	return
+}
+
+// SetUnbondingStaking sets the unbonding staking of the farmer.
+func (k Keeper) SetUnbondingStaking(ctx sdk.Context, ubd types.UnbondingStaking) {
+	farmerAcc, err := sdk.AccAddressFromBech32(ubd.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&ubd)
+	store.Set(types.GetUnbondingStakingKey(farmerAcc), bz)
+}
+
+// RemoveUnbondingStaking removes the unbonding staking of the farmer.
+func (k Keeper) RemoveUnbondingStaking(ctx sdk.Context, farmerAcc sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetUnbondingStakingKey(farmerAcc))
+}
+
+// IterateUnbondingStakings iterates through all unbonding stakings
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateUnbondingStakings(ctx sdk.Context, cb func(ubd types.UnbondingStaking) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.UnbondingStakingKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var ubd types.UnbondingStaking
+		k.cdc.MustUnmarshal(iter.Value(), &ubd)
+		if cb(ubd) {
+			break
+		}
+	}
+}
+
+// InsertUnbondingQueue inserts the farmer into the unbonding queue at the completion time.
+func (k Keeper) InsertUnbondingQueue(ctx sdk.Context, completionTime time.Time, farmerAcc sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUnbondingQueueKey(completionTime, farmerAcc), []byte{})
+}
+
+// DequeueAllMatureUnbondingQueue removes all farmers whose unbonding entries
+// are completed at given time t from the unbonding queue and returns them.
+func (k Keeper) DequeueAllMatureUnbondingQueue(ctx sdk.Context, t time.Time) (farmers []sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.UnbondingQueueKeyPrefix, sdk.PrefixEndBytes(types.GetUnbondingQueueByTimePrefix(t)))
+	defer iter.Close()
+
+	seen := map[string]bool{}
+	for ; iter.Valid(); iter.Next() {
+		_, farmerAcc := types.ParseUnbondingQueueKey(iter.Key())
+		if !seen[farmerAcc.String()] {
+			seen[farmerAcc.String()] = true
+			farmers = append(farmers, farmerAcc)
+		}
+		store.Delete(iter.Key())
+	}
+	return
+}
+
+// SetUnbondingStakingEntry adds an entry to the unbonding staking of the farmer
+// and inserts the farmer into the unbonding queue.
+func (k Keeper) SetUnbondingStakingEntry(ctx sdk.Context, farmerAcc sdk.AccAddress, creationHeight int64, completionTime time.Time, balance sdk.Coins) types.UnbondingStaking {
+	ubd, found := k.GetUnbondingStaking(ctx, farmerAcc)
+	if found {
+		ubd.AddEntry(creationHeight, completionTime, balance)
+	} else {
+		ubd = types.NewUnbondingStaking(farmerAcc, creationHeight, completionTime, balance)
+	}
+	k.SetUnbondingStaking(ctx, ubd)
+	k.InsertUnbondingQueue(ctx, completionTime, farmerAcc)
+	return ubd
+}
+
+// CompleteUnbonding pays out all mature unbonding entries of the farmer
+// from the staking reserve pool and returns the coins paid out.
+func (k Keeper) CompleteUnbonding(ctx sdk.Context, farmerAcc sdk.AccAddress) (sdk.Coins, error) {
+	ubd, found := k.GetUnbondingStaking(ctx, farmerAcc)
+	if !found {
+		return sdk.NewCoins(), nil
+	}
+
+	balances := sdk.NewCoins()
+	for i := 0; i < len(ubd.Entries); i++ {
+		entry := ubd.Entries[i]
+		if entry.IsMature(ctx.BlockTime()) {
+			ubd.RemoveEntry(i)
+			i--
+			balances = balances.Add(entry.Balance...)
+		}
+	}
+
+	if !balances.IsZero() {
+		if err := k.ReleaseStakingCoins(ctx, farmerAcc, balances); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingStaking(ctx, farmerAcc)
+	} else {
+		k.SetUnbondingStaking(ctx, ubd)
+	}
+
+	return balances, nil
+}
+
+// CompleteMatureUnbondings pays out all unbonding entries which are completed at the block time.
+// It is called in the end blocker.
+func (k Keeper) CompleteMatureUnbondings(ctx sdk.Context) error {
+	for _, farmerAcc := range k.DequeueAllMatureUnbondingQueue(ctx, ctx.BlockTime()) {
+		balances, err := k.CompleteUnbonding(ctx, farmerAcc)
+		if err != nil {
+			return err
+		}
+		if balances.IsZero() {
+			continue
+		}
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCompleteUnbonding,
+				sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, balances.String()),
+			),
+		})
+	}
+	return nil
+}
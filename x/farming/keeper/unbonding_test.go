@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/keeper"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+func (suite *KeeperTestSuite) SetUnstakingPeriod(unstakingPeriod time.Duration) {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.UnstakingPeriod = unstakingPeriod
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+func (suite *KeeperTestSuite) TestUnbonding() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+	suite.SetUnstakingPeriod(7 * 24 * time.Hour)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)))
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+
+	// Queued coins are released immediately, and the rest enters the unbonding queue.
+	err := suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 800000)))
+	suite.Require().NoError(err)
+
+	balances := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(balancesBefore.Add(sdk.NewInt64Coin(denom1, 500000)), balances))
+
+	ubd, found := suite.keeper.GetUnbondingStaking(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().Len(ubd.Entries, 1)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 300000)), ubd.Entries[0].Balance))
+	suite.Require().True(types.ParseTime("2021-08-08T00:00:00Z").Equal(ubd.Entries[0].CompletionTime))
+
+	staking, _ := suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().True(intEq(sdk.NewInt(700000), staking.Amount))
+	totalStakings, _ := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(intEq(sdk.NewInt(700000), totalStakings.Amount))
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-03T00:00:00Z"))
+	err = suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 200000)))
+	suite.Require().NoError(err)
+
+	resp, err := suite.querier.UnbondingStakings(sdk.WrapSDKContext(suite.ctx), &types.QueryUnbondingStakingsRequest{
+		Farmer: suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Entries, 2)
+
+	// Unbonding entries are not paid out before their completion time.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-07T23:59:59Z"))
+	suite.Require().NoError(suite.keeper.CompleteMatureUnbondings(suite.ctx))
+	suite.Require().True(coinsEq(balances, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-08T00:00:00Z"))
+	suite.Require().NoError(suite.keeper.CompleteMatureUnbondings(suite.ctx))
+	balances = balances.Add(sdk.NewInt64Coin(denom1, 300000))
+	suite.Require().True(coinsEq(balances, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+
+	ubd, found = suite.keeper.GetUnbondingStaking(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().Len(ubd.Entries, 1)
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-10T00:00:00Z"))
+	suite.Require().NoError(suite.keeper.CompleteMatureUnbondings(suite.ctx))
+	balances = balances.Add(sdk.NewInt64Coin(denom1, 200000))
+	suite.Require().True(coinsEq(balances, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+
+	_, found = suite.keeper.GetUnbondingStaking(suite.ctx, suite.addrs[0])
+	suite.Require().False(found)
+
+	_, broken = keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestUnbonding_ZeroUnstakingPeriod() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	err := suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Require().NoError(err)
+
+	balances := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(balancesBefore.Add(sdk.NewInt64Coin(denom1, 1000000)), balances))
+
+	_, found := suite.keeper.GetUnbondingStaking(suite.ctx, suite.addrs[0])
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestUnbonding_Genesis() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+	suite.SetUnstakingPeriod(7 * 24 * time.Hour)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	err := suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 400000)))
+	suite.Require().NoError(err)
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genState.UnbondingStakings, 1)
+
+	bz, err := suite.app.AppCodec().MarshalJSON(genState)
+	suite.Require().NoError(err)
+	var genState2 types.GenesisState
+	suite.Require().NoError(suite.app.AppCodec().UnmarshalJSON(bz, &genState2))
+	suite.Require().NoError(types.ValidateGenesis(genState2))
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, genState2)
+	})
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+
+	// The unbonding queue is restored from genesis.
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-08T00:00:00Z"))
+	suite.Require().NoError(suite.keeper.CompleteMatureUnbondings(suite.ctx))
+	balances := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(balancesBefore.Add(sdk.NewInt64Coin(denom1, 400000)), balances))
+}
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// GetRewardVesting returns a reward vesting of the farmer started at the start time.
+func (k Keeper) GetRewardVesting(ctx sdk.Context, farmerAcc sdk.AccAddress, startTime time.Time, vestingDuration time.Duration) (vesting types.RewardVesting, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRewardVestingKey(farmerAcc, startTime, vestingDuration))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &vesting)
+	found = true
+	return
+}
+
+// SetRewardVesting sets a reward vesting of the farmer.
+func (k Keeper) SetRewardVesting(ctx sdk.Context, farmerAcc sdk.AccAddress, vesting types.RewardVesting) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&vesting)
+	store.Set(types.GetRewardVestingKey(farmerAcc, vesting.StartTime, vesting.VestingDuration), bz)
+}
+
+// DeleteRewardVesting deletes a reward vesting of the farmer.
+func (k Keeper) DeleteRewardVesting(ctx sdk.Context, farmerAcc sdk.AccAddress, vesting types.RewardVesting) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRewardVestingKey(farmerAcc, vesting.StartTime, vesting.VestingDuration))
+}
+
+// IterateRewardVestings iterates through all reward vestings stored in the store
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateRewardVestings(ctx sdk.Context, cb func(farmerAcc sdk.AccAddress, vesting types.RewardVesting) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RewardVestingKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vesting types.RewardVesting
+		k.cdc.MustUnmarshal(iter.Value(), &vesting)
+		farmerAcc := types.ParseRewardVestingKey(iter.Key())
+		if cb(farmerAcc, vesting) {
+			break
+		}
+	}
+}
+
+// IterateRewardVestingsByFarmer iterates through all reward vestings of the farmer
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateRewardVestingsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress, cb func(vesting types.RewardVesting) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetRewardVestingsByFarmerPrefix(farmerAcc))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vesting types.RewardVesting
+		k.cdc.MustUnmarshal(iter.Value(), &vesting)
+		if cb(vesting) {
+			break
+		}
+	}
+}
+
+// AddRewardVesting adds rewards to the farmer's reward vesting which starts at the current block time.
+func (k Keeper) AddRewardVesting(ctx sdk.Context, farmerAcc sdk.AccAddress, vestingDuration time.Duration, amt sdk.Coins) {
+	vesting, found := k.GetRewardVesting(ctx, farmerAcc, ctx.BlockTime(), vestingDuration)
+	if !found {
+		vesting = types.NewRewardVesting(ctx.BlockTime(), vestingDuration, sdk.NewCoins())
+	}
+	vesting.TotalRewards = vesting.TotalRewards.Add(amt...)
+	k.SetRewardVesting(ctx, farmerAcc, vesting)
+}
+
+// VestRewards locks the part of the farmer's rewards allocated by plans with reward vesting
+// in the vesting rewards pool and returns the total amount of the vested rewards.
+// The amount is truncated for each vesting duration.
+func (k Keeper) VestRewards(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, endingEpoch uint64) (sdk.Coins, error) {
+	totalVested := sdk.NewCoins()
+	for _, vestingRewards := range k.CalculateVestingRewards(ctx, farmerAcc, stakingCoinDenom, endingEpoch) {
+		truncatedRewards, _ := vestingRewards.Rewards.TruncateDecimal()
+		if truncatedRewards.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, k.GetRewardsReservePoolAcc(ctx), types.VestingRewardsAcc, truncatedRewards); err != nil {
+			return nil, err
+		}
+		k.AddRewardVesting(ctx, farmerAcc, vestingRewards.VestingDuration, truncatedRewards)
+
+		totalVested = totalVested.Add(truncatedRewards...)
+	}
+	return totalVested, nil
+}
+
+// VestingRewards returns the locked and unlocked(claimable) vesting rewards of the farmer.
+func (k Keeper) VestingRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) (locked, unlocked sdk.Coins) {
+	locked, unlocked = sdk.NewCoins(), sdk.NewCoins()
+	k.IterateRewardVestingsByFarmer(ctx, farmerAcc, func(vesting types.RewardVesting) (stop bool) {
+		locked = locked.Add(vesting.LockedRewards(ctx.BlockTime())...)
+		unlocked = unlocked.Add(vesting.ClaimableRewards(ctx.BlockTime())...)
+		return false
+	})
+	return
+}
+
+// ClaimVestedRewards sends all unlocked vesting rewards of the farmer to the farmer's reward withdraw address.
+// Fully claimed reward vestings are deleted.
+func (k Keeper) ClaimVestedRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) (sdk.Coins, error) {
+	var vestings []types.RewardVesting
+	k.IterateRewardVestingsByFarmer(ctx, farmerAcc, func(vesting types.RewardVesting) (stop bool) {
+		vestings = append(vestings, vesting)
+		return false
+	})
+
+	totalClaimed := sdk.NewCoins()
+	for _, vesting := range vestings {
+		claimable := vesting.ClaimableRewards(ctx.BlockTime())
+		if claimable.IsZero() {
+			continue
+		}
+
+		vesting.ClaimedRewards = vesting.ClaimedRewards.Add(claimable...)
+		if vesting.IsFullyClaimed() {
+			k.DeleteRewardVesting(ctx, farmerAcc, vesting)
+		} else {
+			k.SetRewardVesting(ctx, farmerAcc, vesting)
+		}
+
+		totalClaimed = totalClaimed.Add(claimable...)
+	}
+
+	if totalClaimed.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrRewardNotExists, "no unlocked vesting rewards for farmer %s", farmerAcc)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, types.VestingRewardsAcc, k.GetRewardWithdrawAddr(ctx, farmerAcc), totalClaimed); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimVestedRewards,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyRewardCoins, totalClaimed.String()),
+		),
+	})
+
+	return totalClaimed, nil
+}
+
+// ValidateVestingRewardsAmount checks that the balance of the vesting rewards pool
+// is greater than or equal to the amount of all unclaimed vesting rewards.
+func (k Keeper) ValidateVestingRewardsAmount(ctx sdk.Context) error {
+	unclaimed := sdk.NewCoins()
+	k.IterateRewardVestings(ctx, func(_ sdk.AccAddress, vesting types.RewardVesting) (stop bool) {
+		unclaimed = unclaimed.Add(vesting.TotalRewards.Sub(vesting.ClaimedRewards)...)
+		return false
+	})
+
+	vestingRewardsPoolBalances := k.bankKeeper.GetAllBalances(ctx, types.VestingRewardsAcc)
+	if !vestingRewardsPoolBalances.IsAllGTE(unclaimed) {
+		return types.ErrInvalidVestingRewardsAmount
+	}
+
+	return nil
+}
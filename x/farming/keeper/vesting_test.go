@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/keeper"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+func (suite *KeeperTestSuite) SetRewardVestingDuration(planId uint64, vestingDuration time.Duration) {
+	plan, found := suite.keeper.GetPlan(suite.ctx, planId)
+	suite.Require().True(found)
+	suite.Require().NoError(plan.SetRewardVestingDuration(vestingDuration))
+	suite.keeper.SetPlan(suite.ctx, plan)
+}
+
+func (suite *KeeperTestSuite) TestVestingRewards() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetFixedAmountPlan(2, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetRewardVestingDuration(1, 10*24*time.Hour)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2000000)), suite.keeper.AllRewards(suite.ctx, suite.addrs[0])))
+
+	// Only the rewards from the plan without reward vesting are sent to the farmer on harvest.
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Harvest(suite.addrs[0], []string{denom1})
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(balancesBefore.Add(sdk.NewInt64Coin(denom3, 1000000)), balancesAfter))
+	suite.Require().True(suite.keeper.AllRewards(suite.ctx, suite.addrs[0]).IsZero())
+
+	locked, unlocked := suite.keeper.VestingRewards(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), locked))
+	suite.Require().True(unlocked.IsZero())
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), suite.app.BankKeeper.GetAllBalances(suite.ctx, types.VestingRewardsAcc)))
+
+	_, err := suite.keeper.ClaimVestedRewards(suite.ctx, suite.addrs[0])
+	suite.Require().ErrorIs(err, types.ErrRewardNotExists)
+
+	// Half of the vesting duration has passed.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-06T00:00:00Z"))
+	locked, unlocked = suite.keeper.VestingRewards(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000)), locked))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000)), unlocked))
+
+	claimed, err := suite.keeper.ClaimVestedRewards(suite.ctx, suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000)), claimed))
+	locked, unlocked = suite.keeper.VestingRewards(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000)), locked))
+	suite.Require().True(unlocked.IsZero())
+
+	// The vesting has ended.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-20T00:00:00Z"))
+	claimed, err = suite.keeper.ClaimVestedRewards(suite.ctx, suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000)), claimed))
+
+	balancesAfter = suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(balancesBefore.Add(sdk.NewInt64Coin(denom3, 2000000)), balancesAfter))
+
+	// Fully claimed reward vestings are deleted.
+	count := 0
+	suite.keeper.IterateRewardVestings(suite.ctx, func(_ sdk.AccAddress, _ types.RewardVesting) (stop bool) {
+		count++
+		return false
+	})
+	suite.Require().Equal(0, count)
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestVestingRewards_Unstake() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetRewardVestingDuration(1, 10*24*time.Hour)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	// Rewards withdrawn on unstake are vested, too.
+	err := suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Require().NoError(err)
+
+	locked, unlocked := suite.keeper.VestingRewards(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), locked))
+	suite.Require().True(unlocked.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestVestingRewards_Genesis() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetRewardVestingDuration(1, 10*24*time.Hour)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.Harvest(suite.addrs[0], []string{denom1})
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genState.RewardVestingRecords, 1)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2000000)), genState.VestingRewardsCoins))
+
+	bz, err := suite.app.AppCodec().MarshalJSON(genState)
+	suite.Require().NoError(err)
+	var genState2 types.GenesisState
+	suite.Require().NoError(suite.app.AppCodec().UnmarshalJSON(bz, &genState2))
+	suite.Require().NoError(types.ValidateGenesis(genState2))
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, genState2)
+	})
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+
+	genState2.VestingRewardsCoins = sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000))
+	suite.Require().Panics(func() {
+		suite.keeper.InitGenesis(suite.ctx, genState2)
+	})
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// GetRewardWithdrawAddr returns the address that the rewards of the farmer are sent to.
+// It returns the farmer's address if the farmer has not set a reward withdraw address.
+func (k Keeper) GetRewardWithdrawAddr(ctx sdk.Context, farmerAcc sdk.AccAddress) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRewardWithdrawAddrKey(farmerAcc))
+	if bz == nil {
+		return farmerAcc
+	}
+	return bz
+}
+
+// SetRewardWithdrawAddr sets the reward withdraw address of the farmer.
+func (k Keeper) SetRewardWithdrawAddr(ctx sdk.Context, farmerAcc, withdrawAcc sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRewardWithdrawAddrKey(farmerAcc), withdrawAcc)
+}
+
+// DeleteRewardWithdrawAddr deletes the reward withdraw address of the farmer.
+func (k Keeper) DeleteRewardWithdrawAddr(ctx sdk.Context, farmerAcc sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRewardWithdrawAddrKey(farmerAcc))
+}
+
+// IterateRewardWithdrawAddrs iterates through all reward withdraw addresses
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateRewardWithdrawAddrs(ctx sdk.Context, cb func(farmerAcc, withdrawAcc sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RewardWithdrawAddrKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		farmerAcc := types.ParseRewardWithdrawAddrKey(iter.Key())
+		if cb(farmerAcc, iter.Value()) {
+			break
+		}
+	}
+}
+
+// SetRewardWithdrawAddress changes the address that the rewards of the farmer are sent to.
+// Setting the farmer's own address removes the reward withdraw address.
+func (k Keeper) SetRewardWithdrawAddress(ctx sdk.Context, farmerAcc, withdrawAcc sdk.AccAddress) error {
+	if k.blockedAddrs[withdrawAcc.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", withdrawAcc)
+	}
+
+	if withdrawAcc.Equals(farmerAcc) {
+		k.DeleteRewardWithdrawAddr(ctx, farmerAcc)
+	} else {
+		k.SetRewardWithdrawAddr(ctx, farmerAcc, withdrawAcc)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetRewardWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, withdrawAcc.String()),
+		),
+	})
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+func (suite *KeeperTestSuite) TestRewardWithdrawAddress() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+
+	suite.Require().Equal(suite.addrs[0], suite.keeper.GetRewardWithdrawAddr(suite.ctx, suite.addrs[0]))
+	err := suite.keeper.SetRewardWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[5])
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.addrs[5], suite.keeper.GetRewardWithdrawAddr(suite.ctx, suite.addrs[0]))
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	farmerBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	withdrawBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[5])
+
+	// Rewards withdrawn automatically when the queued coins are staked are sent to the withdraw address.
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	farmerBalances = farmerBalances.Sub(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	withdrawBalances = withdrawBalances.Add(sdk.NewInt64Coin(denom3, 2000000))
+	suite.Require().True(coinsEq(farmerBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(withdrawBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[5])))
+
+	// So are the rewards withdrawn on unstaking, while the unstaked coins are sent to the farmer.
+	suite.AdvanceEpoch()
+	err = suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)))
+	suite.Require().NoError(err)
+	farmerBalances = farmerBalances.Add(sdk.NewInt64Coin(denom1, 500000))
+	withdrawBalances = withdrawBalances.Add(sdk.NewInt64Coin(denom3, 1000000))
+	suite.Require().True(coinsEq(farmerBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(withdrawBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[5])))
+
+	// Setting the farmer's own address removes the withdraw address.
+	err = suite.keeper.SetRewardWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.addrs[0], suite.keeper.GetRewardWithdrawAddr(suite.ctx, suite.addrs[0]))
+	suite.Require().Empty(suite.keeper.ExportGenesis(suite.ctx).RewardWithdrawAddressRecords)
+
+	suite.AdvanceEpoch()
+	rewards := suite.keeper.AllRewards(suite.ctx, suite.addrs[0])
+	suite.Harvest(suite.addrs[0], []string{denom1})
+	farmerBalances = farmerBalances.Add(rewards...)
+	suite.Require().True(coinsEq(farmerBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+}
+
+func (suite *KeeperTestSuite) TestRewardWithdrawAddress_VestedRewards() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+
+	suite.SetFixedAmountPlan(1, suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.SetRewardVestingDuration(1, 24*time.Hour)
+	suite.Require().NoError(suite.keeper.SetRewardWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[5]))
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.Harvest(suite.addrs[0], []string{denom1})
+
+	withdrawBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[5])
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-02T00:00:00Z"))
+	claimed, err := suite.keeper.ClaimVestedRewards(suite.ctx, suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), claimed))
+	suite.Require().True(coinsEq(withdrawBalances.Add(claimed...), suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[5])))
+}
+
+func (suite *KeeperTestSuite) TestRewardWithdrawAddress_Genesis() {
+	suite.Require().NoError(suite.keeper.SetRewardWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[5]))
+	suite.Require().NoError(suite.keeper.SetRewardWithdrawAddress(suite.ctx, suite.addrs[1], suite.addrs[5]))
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genState.RewardWithdrawAddressRecords, 2)
+
+	bz, err := suite.app.AppCodec().MarshalJSON(genState)
+	suite.Require().NoError(err)
+	var genState2 types.GenesisState
+	suite.Require().NoError(suite.app.AppCodec().UnmarshalJSON(bz, &genState2))
+	suite.Require().NoError(types.ValidateGenesis(genState2))
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, genState2)
+	})
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+	suite.Require().Equal(suite.addrs[5], suite.keeper.GetRewardWithdrawAddr(suite.ctx, suite.addrs[1]))
+}
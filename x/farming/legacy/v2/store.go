@@ -0,0 +1,122 @@
+package v2
+
+import (
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2.
+// The migration includes:
+//
+// - Migrating the current epoch days to the current epoch duration.
+// - Setting the next epoch duration param to zero, so that the next epoch days param keeps being used.
+// - Setting the other params added since v1 to their default values.
+// - Recomputing the reference counts of the historical rewards and deleting the unreferenced ones.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec, paramSpace paramtypes.Subspace) error {
+	store := ctx.KVStore(storeKey)
+
+	if bz := store.Get(types.CurrentEpochDaysKey); bz != nil {
+		var epochDays gogotypes.UInt32Value
+		if err := cdc.Unmarshal(bz, &epochDays); err != nil {
+			return err
+		}
+		epochDuration := time.Duration(epochDays.Value) * 24 * time.Hour
+		store.Set(types.CurrentEpochDurationKey, cdc.MustMarshal(gogotypes.DurationProto(epochDuration)))
+		store.Delete(types.CurrentEpochDaysKey)
+	}
+
+	paramSpace.Set(ctx, types.KeyNextEpochDuration, types.DefaultNextEpochDuration)
+	paramSpace.Set(ctx, types.KeyLockMultipliers, types.DefaultLockMultipliers)
+	paramSpace.Set(ctx, types.KeyUnstakingPeriod, types.DefaultUnstakingPeriod)
+	paramSpace.Set(ctx, types.KeyAllowedStakingDenoms, types.DefaultAllowedStakingDenoms)
+	paramSpace.Set(ctx, types.KeyMaxTotalStakings, types.DefaultMaxTotalStakings)
+	paramSpace.Set(ctx, types.KeyMinStakingAmounts, types.DefaultMinStakingAmounts)
+	paramSpace.Set(ctx, types.KeyPausedOperations, types.DefaultPausedOperations)
+
+	return migrateHistoricalRewards(store, cdc)
+}
+
+// migrateHistoricalRewards sets the reference count of each historical rewards to
+// the number of stakings referring to them, plus one if they are the latest historical
+// rewards of the staking coin denom.
+// Historical rewards that are not referenced at all are deleted.
+func migrateHistoricalRewards(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	referenceCounts := map[string]uint32{}
+
+	err := iterate(store, types.StakingKeyPrefix, func(key, value []byte) error {
+		var staking types.Staking
+		if err := cdc.Unmarshal(value, &staking); err != nil {
+			return err
+		}
+		if staking.StartingEpoch > 0 {
+			stakingCoinDenom, _ := types.ParseStakingKey(key)
+			referenceCounts[string(types.GetHistoricalRewardsKey(stakingCoinDenom, staking.StartingEpoch-1))]++
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = iterate(store, types.CurrentEpochKeyPrefix, func(key, value []byte) error {
+		var currentEpoch gogotypes.UInt64Value
+		if err := cdc.Unmarshal(value, &currentEpoch); err != nil {
+			return err
+		}
+		if currentEpoch.Value > 0 {
+			stakingCoinDenom := types.ParseCurrentEpochKey(key)
+			referenceCounts[string(types.GetHistoricalRewardsKey(stakingCoinDenom, currentEpoch.Value-1))]++
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	var historicalRewards []types.HistoricalRewards
+	err = iterate(store, types.HistoricalRewardsKeyPrefix, func(key, value []byte) error {
+		var rewards types.HistoricalRewards
+		if err := cdc.Unmarshal(value, &rewards); err != nil {
+			return err
+		}
+		keys = append(keys, key)
+		historicalRewards = append(historicalRewards, rewards)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, key := range keys {
+		rewards := historicalRewards[i]
+		rewards.ReferenceCount = referenceCounts[string(key)]
+		if rewards.ReferenceCount == 0 {
+			store.Delete(key)
+		} else {
+			store.Set(key, cdc.MustMarshal(&rewards))
+		}
+	}
+
+	return nil
+}
+
+// iterate calls cb for every key-value pair under the prefix, stopping at the first error.
+// The iterator is closed before returning, so that the store can be written afterwards.
+func iterate(store sdk.KVStore, prefix []byte, cb func(key, value []byte) error) error {
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if err := cb(iter.Key(), iter.Value()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
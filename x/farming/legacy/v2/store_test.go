@@ -0,0 +1,124 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	simapp "github.com/tendermint/farming/app"
+	v2 "github.com/tendermint/farming/x/farming/legacy/v2"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	cdc := app.AppCodec()
+	storeKey := app.GetKey(types.StoreKey)
+	paramSpace := app.GetSubspace(types.ModuleName)
+
+	// Make the store look like v1.
+	store := ctx.KVStore(storeKey)
+	store.Delete(types.CurrentEpochDurationKey)
+	store.Set(types.CurrentEpochDaysKey, cdc.MustMarshal(&gogotypes.UInt32Value{Value: 3}))
+	for _, key := range [][]byte{
+		types.KeyNextEpochDuration,
+		types.KeyLockMultipliers,
+		types.KeyUnstakingPeriod,
+		types.KeyAllowedStakingDenoms,
+		types.KeyMaxTotalStakings,
+		types.KeyMinStakingAmounts,
+		types.KeyPausedOperations,
+	} {
+		ctx.KVStore(app.GetKey(paramstypes.StoreKey)).Delete(append([]byte(types.ModuleName+"/"), key...))
+		require.False(t, paramSpace.Has(ctx, key))
+	}
+	require.Panics(t, func() { app.FarmingKeeper.GetParams(ctx) })
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc, paramSpace))
+
+	require.Nil(t, store.Get(types.CurrentEpochDaysKey))
+	require.Equal(t, 72*time.Hour, app.FarmingKeeper.GetCurrentEpochDuration(ctx))
+
+	params := app.FarmingKeeper.GetParams(ctx)
+	require.Equal(t, time.Duration(0), params.NextEpochDuration)
+	require.Equal(t, types.DefaultNextEpochDays, params.NextEpochDays)
+	require.Equal(t, types.DefaultLockMultipliers, params.LockMultipliers)
+	require.Equal(t, types.DefaultUnstakingPeriod, params.UnstakingPeriod)
+	require.Equal(t, types.DefaultAllowedStakingDenoms, params.AllowedStakingDenoms)
+	require.True(t, params.MaxTotalStakings.IsEqual(types.DefaultMaxTotalStakings))
+	require.True(t, params.MinStakingAmounts.IsEqual(types.DefaultMinStakingAmounts))
+	require.Equal(t, types.DefaultPausedOperations, params.PausedOperations)
+	require.NoError(t, params.Validate())
+}
+
+func TestMigrateStoreHistoricalRewards(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(types.ParseTime("2022-01-01T00:00:00Z"))
+	cdc := app.AppCodec()
+	storeKey := app.GetKey(types.StoreKey)
+	paramSpace := app.GetSubspace(types.ModuleName)
+	k := app.FarmingKeeper
+
+	farmingPoolAcc := simapp.AddTestAddrs(app, ctx, 1, sdk.ZeroInt())[0]
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, farmingPoolAcc, sdk.NewCoins(sdk.NewInt64Coin("denom2", 1_000_000_000))))
+	k.SetPlan(ctx, types.NewFixedAmountPlan(
+		types.NewBasePlan(
+			1, "", types.PlanTypePublic, farmingPoolAcc.String(), farmingPoolAcc.String(),
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom1", sdk.OneDec())),
+			types.ParseTime("0001-01-01T00:00:00Z"), types.ParseTime("9999-12-31T00:00:00Z"),
+		),
+		sdk.NewCoins(sdk.NewInt64Coin("denom2", 1_000_000)),
+	))
+
+	farmers := simapp.AddTestAddrs(app, ctx, 2, sdk.ZeroInt())
+	for _, farmer := range farmers {
+		require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, farmer, sdk.NewCoins(sdk.NewInt64Coin("denom1", 1_000_000))))
+		require.NoError(t, k.Stake(ctx, farmer, sdk.NewCoins(sdk.NewInt64Coin("denom1", 1_000_000))))
+		for i := 0; i < 2; i++ {
+			require.NoError(t, k.AdvanceEpoch(ctx))
+		}
+	}
+
+	// Make the store look like v1, where the historical rewards of every epoch are kept
+	// and the reference counts are not set.
+	currentEpoch := k.GetCurrentEpoch(ctx, "denom1")
+	for epoch := uint64(0); epoch < currentEpoch; epoch++ {
+		historical, found := k.GetHistoricalRewards(ctx, "denom1", epoch)
+		if !found {
+			historical, _ = k.GetHistoricalRewards(ctx, "denom1", currentEpoch-1)
+		}
+		historical.ReferenceCount = 0
+		k.SetHistoricalRewards(ctx, "denom1", epoch, historical)
+	}
+	require.Error(t, k.ValidateHistoricalRewardsReferenceCount(ctx))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc, paramSpace))
+
+	require.NoError(t, k.ValidateHistoricalRewardsReferenceCount(ctx))
+	for epoch := uint64(0); epoch < currentEpoch; epoch++ {
+		_, found := k.GetHistoricalRewards(ctx, "denom1", epoch)
+		referenced := epoch == currentEpoch-1
+		for _, farmer := range farmers {
+			staking, _ := k.GetStaking(ctx, "denom1", farmer)
+			referenced = referenced || epoch == staking.StartingEpoch-1
+		}
+		require.Equal(t, referenced, found, "epoch %d", epoch)
+	}
+
+	// Allocating rewards must not panic on the migrated reference counts.
+	for i := 0; i < 2; i++ {
+		require.NoError(t, k.AdvanceEpoch(ctx))
+	}
+	for _, farmer := range farmers {
+		_, err := k.WithdrawAllRewards(ctx, farmer)
+		require.NoError(t, err)
+	}
+	require.NoError(t, k.ValidateHistoricalRewardsReferenceCount(ctx))
+}
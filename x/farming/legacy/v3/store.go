@@ -0,0 +1,89 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3.
+// The migration includes:
+//
+// - Building the plan end time queue and the active plan index for the plans not terminated yet.
+// - Setting the total staked and queued coins of each staking coin denom.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var plans []types.PlanI
+	if err := iterate(store, types.PlanKeyPrefix, func(_, value []byte) error {
+		var plan types.PlanI
+		if err := cdc.UnmarshalInterface(value, &plan); err != nil {
+			return err
+		}
+		plans = append(plans, plan)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, plan := range plans {
+		if plan.GetTerminated() {
+			continue
+		}
+		store.Set(types.GetActivePlanKey(plan.GetId()), sdk.FormatTimeBytes(plan.GetEndTime()))
+		store.Set(types.GetPlanEndTimeQueueKey(plan.GetEndTime(), plan.GetId()), []byte{})
+	}
+
+	return migrateTotalStakingCoins(store, cdc)
+}
+
+// migrateTotalStakingCoins sets the total staking coins from the stakings and the queued stakings.
+func migrateTotalStakingCoins(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	totalStakingCoins := sdk.NewCoins()
+	if err := iterate(store, types.StakingKeyPrefix, func(key, value []byte) error {
+		var staking types.Staking
+		if err := cdc.Unmarshal(value, &staking); err != nil {
+			return err
+		}
+		stakingCoinDenom, _ := types.ParseStakingKey(key)
+		totalStakingCoins = totalStakingCoins.Add(sdk.NewCoin(stakingCoinDenom, staking.Amount))
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := iterate(store, types.QueuedStakingKeyPrefix, func(key, value []byte) error {
+		var queuedStaking types.QueuedStaking
+		if err := cdc.Unmarshal(value, &queuedStaking); err != nil {
+			return err
+		}
+		stakingCoinDenom, _ := types.ParseQueuedStakingKey(key)
+		totalStakingCoins = totalStakingCoins.Add(sdk.NewCoin(stakingCoinDenom, queuedStaking.Amount))
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, coin := range totalStakingCoins {
+		bz, err := cdc.Marshal(&sdk.IntProto{Int: coin.Amount})
+		if err != nil {
+			return err
+		}
+		store.Set(types.GetTotalStakingCoinsKey(coin.Denom), bz)
+	}
+
+	return nil
+}
+
+// iterate calls cb for every key-value pair under the prefix, stopping at the first error.
+// The iterator is closed before returning, so that the store can be written afterwards.
+func iterate(store sdk.KVStore, prefix []byte, cb func(key, value []byte) error) error {
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if err := cb(iter.Key(), iter.Value()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
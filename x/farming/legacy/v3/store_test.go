@@ -0,0 +1,93 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/tendermint/farming/app"
+	v3 "github.com/tendermint/farming/x/farming/legacy/v3"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	cdc := app.AppCodec()
+	storeKey := app.GetKey(types.StoreKey)
+	k := app.FarmingKeeper
+
+	farmingPoolAcc := simapp.AddTestAddrs(app, ctx, 1, sdk.ZeroInt())[0]
+	newPlan := func(id uint64, endTime string, terminated bool) types.PlanI {
+		plan := types.NewFixedAmountPlan(
+			types.NewBasePlan(
+				id, "", types.PlanTypePublic, farmingPoolAcc.String(), farmingPoolAcc.String(),
+				sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom1", sdk.OneDec())),
+				types.ParseTime("2021-08-01T00:00:00Z"), types.ParseTime(endTime),
+			),
+			sdk.NewCoins(sdk.NewInt64Coin("denom2", 1000000)),
+		)
+		require.NoError(t, plan.SetTerminated(terminated))
+		return plan
+	}
+	for _, plan := range []types.PlanI{
+		newPlan(1, "2021-08-10T00:00:00Z", false),
+		newPlan(2, "2021-08-05T00:00:00Z", true),
+		newPlan(3, "2021-08-03T00:00:00Z", false),
+	} {
+		k.SetPlan(ctx, plan)
+	}
+
+	// Make the store look like v2.
+	store := ctx.KVStore(storeKey)
+	for _, prefix := range [][]byte{types.PlanEndTimeQueueKeyPrefix, types.ActivePlanKeyPrefix} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	require.Empty(t, k.GetEndedPlans(ctx, types.ParseTime("9999-12-31T00:00:00Z")))
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc))
+
+	var activePlanIds []uint64
+	k.IterateActivePlans(ctx, func(plan types.PlanI) (stop bool) {
+		activePlanIds = append(activePlanIds, plan.GetId())
+		return false
+	})
+	require.Equal(t, []uint64{1, 3}, activePlanIds)
+
+	endedPlans := k.GetEndedPlans(ctx, types.ParseTime("2021-08-06T00:00:00Z"))
+	require.Len(t, endedPlans, 1)
+	require.Equal(t, uint64(3), endedPlans[0].GetId())
+}
+
+func TestMigrateStoreTotalStakingCoins(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	storeKey := app.GetKey(types.StoreKey)
+	k := app.FarmingKeeper
+
+	cdc := app.AppCodec()
+	store := ctx.KVStore(storeKey)
+
+	farmers := simapp.AddTestAddrs(app, ctx, 2, sdk.ZeroInt())
+	store.Set(types.GetStakingKey("denom1", farmers[0]), cdc.MustMarshal(&types.Staking{Amount: sdk.NewInt(1000000), StartingEpoch: 1}))
+	store.Set(types.GetStakingKey("denom1", farmers[1]), cdc.MustMarshal(&types.Staking{Amount: sdk.NewInt(500000), StartingEpoch: 1}))
+	store.Set(types.GetQueuedStakingKey("denom1", farmers[0]), cdc.MustMarshal(&types.QueuedStaking{Amount: sdk.NewInt(300000)}))
+	store.Set(types.GetQueuedStakingKey("denom2", farmers[1]), cdc.MustMarshal(&types.QueuedStaking{Amount: sdk.NewInt(200000)}))
+	require.True(t, k.GetTotalStakingCoins(ctx, "denom1").IsZero())
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc))
+
+	require.Equal(t, sdk.NewInt(1800000), k.GetTotalStakingCoins(ctx, "denom1"))
+	require.Equal(t, sdk.NewInt(200000), k.GetTotalStakingCoins(ctx, "denom2"))
+}
@@ -132,6 +132,18 @@ func (suite *ModuleTestSuite) Stake(farmerAcc sdk.AccAddress, amt sdk.Coins) {
 	suite.Require().NoError(err)
 }
 
+// ProcessQueuedStakings stakes all the queued coins like an epoch advancement does,
+// but without allocating rewards.
+func (suite *ModuleTestSuite) ProcessQueuedStakings() {
+	suite.keeper.SetEpochNumber(suite.ctx, suite.keeper.GetEpochNumber(suite.ctx)+1)
+	suite.keeper.SetQueuedStakingsCursor(suite.ctx, types.QueuedStakingsCursor{
+		NextKey:   types.QueuedStakingKeyPrefix,
+		EpochTime: suite.ctx.BlockTime(),
+	})
+	err := suite.keeper.ProcessMaturedQueuedStakings(suite.ctx, 0)
+	suite.Require().NoError(err)
+}
+
 // Rewards is a convenient method to test Keeper.WithdrawAllRewards.
 func (suite *ModuleTestSuite) Rewards(farmerAcc sdk.AccAddress) sdk.Coins {
 	cacheCtx, _ := suite.ctx.CacheContext()
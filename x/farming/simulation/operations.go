@@ -22,7 +22,9 @@ const (
 	OpWeightMsgCreateRatioPlan       = "op_weight_msg_create_ratio_plan"
 	OpWeightMsgStake                 = "op_weight_msg_stake"
 	OpWeightMsgUnstake               = "op_weight_msg_unstake"
+	OpWeightMsgCancelQueuedStaking   = "op_weight_msg_cancel_queued_staking"
 	OpWeightMsgHarvest               = "op_weight_msg_harvest"
+	OpWeightMsgTerminatePrivatePlan  = "op_weight_msg_terminate_private_plan"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights.
@@ -59,6 +61,13 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgCancelQueuedStaking int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelQueuedStaking, &weightMsgCancelQueuedStaking, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelQueuedStaking = params.DefaultWeightMsgCancelQueuedStaking
+		},
+	)
+
 	var weightMsgHarvest int
 	appParams.GetOrGenerate(cdc, OpWeightMsgHarvest, &weightMsgHarvest, nil,
 		func(_ *rand.Rand) {
@@ -66,6 +75,13 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgTerminatePrivatePlan int
+	appParams.GetOrGenerate(cdc, OpWeightMsgTerminatePrivatePlan, &weightMsgTerminatePrivatePlan, nil,
+		func(_ *rand.Rand) {
+			weightMsgTerminatePrivatePlan = params.DefaultWeightMsgTerminatePrivatePlan
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateFixedAmountPlan,
@@ -83,10 +99,18 @@ func WeightedOperations(
 			weightMsgUnstake,
 			SimulateMsgUnstake(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelQueuedStaking,
+			SimulateMsgCancelQueuedStaking(ak, bk, k),
+		),
 		simulation.NewWeightedOperation(
 			weightMsgHarvest,
 			SimulateMsgHarvest(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgTerminatePrivatePlan,
+			SimulateMsgTerminatePrivatePlan(ak, bk, k),
+		),
 	}
 }
 
@@ -309,6 +333,65 @@ func SimulateMsgUnstake(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Ke
 	}
 }
 
+// SimulateMsgCancelQueuedStaking generates a MsgCancelQueuedStaking with random values
+// nolint: interfacer
+func SimulateMsgCancelQueuedStaking(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		farmer := account.GetAddress()
+
+		// queued staking must exist in order to cancel
+		queuedStaking, found := k.GetQueuedStaking(ctx, sdk.DefaultBondDenom, farmer)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelQueuedStaking, "unable to find queued staking"), nil, nil
+		}
+		// matured queued coins are staked before being cancelled
+		if queuedStaking.IsMaturedAt(k.GetEpochNumber(ctx)) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelQueuedStaking, "queued coins are matured"), nil, nil
+		}
+
+		// queued coins of locked stakings can't be cancelled
+		cancellableAmt := queuedStaking.Amount
+		for _, lock := range k.GetLockedStakingsByFarmer(ctx, farmer, sdk.DefaultBondDenom) {
+			if !lock.Boosted {
+				cancellableAmt = cancellableAmt.Sub(lock.Amount)
+			}
+		}
+		if !cancellableAmt.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelQueuedStaking, "queued coins are locked"), nil, nil
+		}
+
+		cancellingAmt := simtypes.RandomAmount(r, cancellableAmt)
+		if !cancellingAmt.IsPositive() {
+			cancellingAmt = cancellableAmt
+		}
+		queuedCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, cancellingAmt))
+
+		msg := types.NewMsgCancelQueuedStaking(farmer, queuedCoins)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
 // SimulateMsgHarvest generates a MsgHarvest with random values
 // nolint: interfacer
 func SimulateMsgHarvest(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
@@ -372,6 +455,55 @@ func SimulateMsgHarvest(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Ke
 	}
 }
 
+// SimulateMsgTerminatePrivatePlan generates a MsgTerminatePrivatePlan with random values
+// nolint: interfacer
+func SimulateMsgTerminatePrivatePlan(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var simAccount simtypes.Account
+		var plan types.PlanI
+
+		// find a private plan that is not terminated yet and is created by one of the simulated accounts
+		for _, p := range k.GetPlans(ctx) {
+			if p.GetType() != types.PlanTypePrivate || p.GetTerminated() {
+				continue
+			}
+			if acc, found := simtypes.FindAccount(accs, p.GetTerminationAddress()); found {
+				simAccount = acc
+				plan = p
+				break
+			}
+		}
+
+		if plan == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTerminatePrivatePlan, "no private plan to terminate"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg := types.NewMsgTerminatePrivatePlan(simAccount.Address, plan.GetId())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
 // mintPoolCoins mints random amount of coins with the provided pool coin denoms and
 // send them to the simulated account.
 func mintPoolCoins(ctx sdk.Context, r *rand.Rand, bk types.BankKeeper, acc simtypes.Account) (mintCoins sdk.Coins, err error) {
@@ -43,7 +43,9 @@ func TestWeightedOperations(t *testing.T) {
 		{params.DefaultWeightMsgCreateRatioPlan, types.ModuleName, types.TypeMsgCreateRatioPlan},
 		{params.DefaultWeightMsgStake, types.ModuleName, types.TypeMsgStake},
 		{params.DefaultWeightMsgUnstake, types.ModuleName, types.TypeMsgUnstake},
+		{params.DefaultWeightMsgCancelQueuedStaking, types.ModuleName, types.TypeMsgCancelQueuedStaking},
 		{params.DefaultWeightMsgHarvest, types.ModuleName, types.TypeMsgHarvest},
+		{params.DefaultWeightMsgTerminatePrivatePlan, types.ModuleName, types.TypeMsgTerminatePrivatePlan},
 	}
 
 	for i, w := range weightedOps {
@@ -208,6 +210,41 @@ func TestSimulateMsgUnstake(t *testing.T) {
 	require.Len(t, futureOperations, 0)
 }
 
+// TestSimulateMsgCancelQueuedStaking tests the normal scenario of a valid message of type TypeMsgCancelQueuedStaking.
+// Abnormal scenarios, where the message are created by an errors are not tested here.
+func TestSimulateMsgCancelQueuedStaking(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// setup a single account
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	accounts := getTestingAccounts(t, r, app, ctx, 1)
+
+	// queued staking must exist in order to simulate cancellation
+	stakingCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000))
+	err := app.FarmingKeeper.Stake(ctx, accounts[0].Address, stakingCoins)
+	require.NoError(t, err)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgCancelQueuedStaking(app.AccountKeeper, app.BankKeeper, app.FarmingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgCancelQueuedStaking
+	err = app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg)
+	require.NoError(t, err)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgCancelQueuedStaking, msg.Type())
+	require.Equal(t, "cosmos1tnh2q55v8wyygtt9srz5safamzdengsnqeycj3", msg.Farmer)
+	require.Equal(t, "100000000stake", msg.QueuedCoins.String())
+	require.Len(t, futureOperations, 0)
+}
+
 // TestSimulateMsgHarvest tests the normal scenario of a valid message of type TypeMsgHarvest.
 // Abnormal scenarios, where the message are created by an errors are not tested here.
 func TestSimulateMsgHarvest(t *testing.T) {
@@ -290,6 +327,61 @@ func TestSimulateMsgHarvest(t *testing.T) {
 	require.Len(t, futureOperations, 0)
 }
 
+// TestSimulateMsgTerminatePrivatePlan tests the normal scenario of a valid message of type TypeMsgTerminatePrivatePlan.
+// Abnormal scenarios, where the message are created by an errors are not tested here.
+func TestSimulateMsgTerminatePrivatePlan(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// setup a single account
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	accounts := getTestingAccounts(t, r, app, ctx, 1)
+
+	// setup a private fixed amount plan
+	msgPlan := &types.MsgCreateFixedAmountPlan{
+		Name:    "simulation",
+		Creator: accounts[0].Address.String(),
+		StakingCoinWeights: sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(10, 1)), // 100%
+		),
+		StartTime:   types.ParseTime("0001-01-01T00:00:00Z"),
+		EndTime:     types.ParseTime("9999-01-01T00:00:00Z"),
+		EpochAmount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200_000_000)),
+	}
+
+	_, err := app.FarmingKeeper.CreateFixedAmountPlan(
+		ctx,
+		msgPlan,
+		accounts[0].Address,
+		accounts[0].Address,
+		types.PlanTypePrivate,
+	)
+	require.NoError(t, err)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgTerminatePrivatePlan(app.AccountKeeper, app.BankKeeper, app.FarmingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgTerminatePrivatePlan
+	err = app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg)
+	require.NoError(t, err)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgTerminatePrivatePlan, msg.Type())
+	require.Equal(t, "cosmos1tnh2q55v8wyygtt9srz5safamzdengsnqeycj3", msg.Creator)
+	require.Equal(t, uint64(1), msg.PlanId)
+	require.Len(t, futureOperations, 0)
+
+	plan, found := app.FarmingKeeper.GetPlan(ctx, 1)
+	require.True(t, found)
+	require.True(t, plan.GetTerminated())
+}
+
 func createTestApp(isCheckTx bool) (*farmingapp.FarmingApp, sdk.Context) {
 	app := farmingapp.Setup(isCheckTx)
 
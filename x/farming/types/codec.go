@@ -12,9 +12,22 @@ import (
 // func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 // 	cdc.RegisterConcrete(&MsgCreateFixedAmountPlan{}, "farming/MsgCreateFixedAmountPlan", nil)
 // 	cdc.RegisterConcrete(&MsgCreateRatioPlan{}, "farming/MsgCreateRatioPlan", nil)
+// 	cdc.RegisterConcrete(&MsgCreateDecayingPlan{}, "farming/MsgCreateDecayingPlan", nil)
+// 	cdc.RegisterConcrete(&MsgCreateSchedulePlan{}, "farming/MsgCreateSchedulePlan", nil)
 // 	cdc.RegisterConcrete(&MsgStake{}, "farming/MsgStake", nil)
 // 	cdc.RegisterConcrete(&MsgUnstake{}, "farming/MsgUnstake", nil)
+// 	cdc.RegisterConcrete(&MsgCancelQueuedStaking{}, "farming/MsgCancelQueuedStaking", nil)
+// 	cdc.RegisterConcrete(&MsgEmergencyUnstake{}, "farming/MsgEmergencyUnstake", nil)
 // 	cdc.RegisterConcrete(&MsgHarvest{}, "farming/MsgHarvest", nil)
+// 	cdc.RegisterConcrete(&MsgTransferStaking{}, "farming/MsgTransferStaking", nil)
+// 	cdc.RegisterConcrete(&MsgSyncReceiptStaking{}, "farming/MsgSyncReceiptStaking", nil)
+// 	cdc.RegisterConcrete(&MsgSetAutoCompound{}, "farming/MsgSetAutoCompound", nil)
+// 	cdc.RegisterConcrete(&MsgTerminatePrivatePlan{}, "farming/MsgTerminatePrivatePlan", nil)
+// 	cdc.RegisterConcrete(&MsgUpdatePrivatePlan{}, "farming/MsgUpdatePrivatePlan", nil)
+// 	cdc.RegisterConcrete(&MsgClaimVestedRewards{}, "farming/MsgClaimVestedRewards", nil)
+// 	cdc.RegisterConcrete(&MsgAddPlanFarmers{}, "farming/MsgAddPlanFarmers", nil)
+// 	cdc.RegisterConcrete(&MsgRemovePlanFarmers{}, "farming/MsgRemovePlanFarmers", nil)
+// 	cdc.RegisterConcrete(&MsgSetRewardWithdrawAddress{}, "farming/MsgSetRewardWithdrawAddress", nil)
 // }
 
 // RegisterInterfaces registers the x/farming interfaces types with the interface registry
@@ -23,9 +36,22 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreateFixedAmountPlan{},
 		&MsgCreateRatioPlan{},
+		&MsgCreateDecayingPlan{},
+		&MsgCreateSchedulePlan{},
 		&MsgStake{},
 		&MsgUnstake{},
+		&MsgCancelQueuedStaking{},
+		&MsgEmergencyUnstake{},
 		&MsgHarvest{},
+		&MsgTransferStaking{},
+		&MsgSyncReceiptStaking{},
+		&MsgSetAutoCompound{},
+		&MsgTerminatePrivatePlan{},
+		&MsgUpdatePrivatePlan{},
+		&MsgClaimVestedRewards{},
+		&MsgAddPlanFarmers{},
+		&MsgRemovePlanFarmers{},
+		&MsgSetRewardWithdrawAddress{},
 	)
 
 	registry.RegisterImplementations(
@@ -38,6 +64,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*PlanI)(nil),
 		&FixedAmountPlan{},
 		&RatioPlan{},
+		&DecayingPlan{},
+		&SchedulePlan{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
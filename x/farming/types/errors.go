@@ -6,15 +6,30 @@ import (
 
 // farming module sentinel errors
 var (
-	ErrPlanNotExists                  = sdkerrors.Register(ModuleName, 2, "plan does not exist")
-	ErrInvalidPlanType                = sdkerrors.Register(ModuleName, 3, "invalid plan type")
-	ErrInvalidPlanEndTime             = sdkerrors.Register(ModuleName, 4, "invalid plan end time")
-	ErrStakingNotExists               = sdkerrors.Register(ModuleName, 5, "staking not exists")
-	ErrRewardNotExists                = sdkerrors.Register(ModuleName, 6, "reward not exists")
-	ErrFeeCollectionFailure           = sdkerrors.Register(ModuleName, 7, "fee collection failure")
-	ErrInvalidPlanNameLength          = sdkerrors.Register(ModuleName, 8, "invalid plan name length")
-	ErrInvalidPlanName                = sdkerrors.Register(ModuleName, 9, "invalid plan name")
-	ErrConflictPrivatePlanFarmingPool = sdkerrors.Register(ModuleName, 10, "the address is already in use, please use a different plan name")
-	ErrInvalidStakingReservedAmount   = sdkerrors.Register(ModuleName, 11, "staking reserved amount invariant broken")
-	ErrInvalidRemainingRewardsAmount  = sdkerrors.Register(ModuleName, 12, "remaining rewards amount invariant broken")
+	ErrPlanNotExists                          = sdkerrors.Register(ModuleName, 2, "plan does not exist")
+	ErrInvalidPlanType                        = sdkerrors.Register(ModuleName, 3, "invalid plan type")
+	ErrInvalidPlanEndTime                     = sdkerrors.Register(ModuleName, 4, "invalid plan end time")
+	ErrStakingNotExists                       = sdkerrors.Register(ModuleName, 5, "staking not exists")
+	ErrRewardNotExists                        = sdkerrors.Register(ModuleName, 6, "reward not exists")
+	ErrFeeCollectionFailure                   = sdkerrors.Register(ModuleName, 7, "fee collection failure")
+	ErrInvalidPlanNameLength                  = sdkerrors.Register(ModuleName, 8, "invalid plan name length")
+	ErrInvalidPlanName                        = sdkerrors.Register(ModuleName, 9, "invalid plan name")
+	ErrConflictPrivatePlanFarmingPool         = sdkerrors.Register(ModuleName, 10, "the address is already in use, please use a different plan name")
+	ErrInvalidStakingReservedAmount           = sdkerrors.Register(ModuleName, 11, "staking reserved amount invariant broken")
+	ErrInvalidRemainingRewardsAmount          = sdkerrors.Register(ModuleName, 12, "remaining rewards amount invariant broken")
+	ErrAlreadyTerminatedPlan                  = sdkerrors.Register(ModuleName, 13, "plan is already terminated")
+	ErrInvalidDecayRate                       = sdkerrors.Register(ModuleName, 14, "invalid decay rate")
+	ErrInvalidDecayEpochs                     = sdkerrors.Register(ModuleName, 15, "invalid decay epochs")
+	ErrInvalidSchedulePhases                  = sdkerrors.Register(ModuleName, 16, "invalid schedule phases")
+	ErrInvalidRewardVestingDuration           = sdkerrors.Register(ModuleName, 17, "invalid reward vesting duration")
+	ErrInvalidVestingRewardsAmount            = sdkerrors.Register(ModuleName, 18, "vesting rewards amount invariant broken")
+	ErrInvalidLockDuration                    = sdkerrors.Register(ModuleName, 19, "invalid lock duration")
+	ErrStakingLocked                          = sdkerrors.Register(ModuleName, 20, "staking is locked")
+	ErrInvalidReceiptSupply                   = sdkerrors.Register(ModuleName, 21, "receipt supply invariant broken")
+	ErrReceiptStaking                         = sdkerrors.Register(ModuleName, 22, "staking is backed by receipts")
+	ErrStakingDenomNotAllowed                 = sdkerrors.Register(ModuleName, 23, "staking denom is not allowed")
+	ErrMaxTotalStakingExceeded                = sdkerrors.Register(ModuleName, 24, "max total staking exceeded")
+	ErrStakingAmountTooSmall                  = sdkerrors.Register(ModuleName, 25, "staking amount is less than the minimum")
+	ErrOperationPaused                        = sdkerrors.Register(ModuleName, 26, "operation is paused")
+	ErrInvalidHistoricalRewardsReferenceCount = sdkerrors.Register(ModuleName, 27, "historical rewards reference count invariant broken")
 )
@@ -2,13 +2,32 @@ package types
 
 // Event types for the farming module.
 const (
-	EventTypeCreateFixedAmountPlan = "create_fixed_amount_plan"
-	EventTypeCreateRatioPlan       = "create_ratio_plan"
-	EventTypeStake                 = "stake"
-	EventTypeUnstake               = "unstake"
-	EventTypeHarvest               = "harvest"
-	EventTypePlanTerminated        = "plan_terminated"
-	EventTypeRewardsAllocated      = "rewards_allocated"
+	EventTypeCreateFixedAmountPlan    = "create_fixed_amount_plan"
+	EventTypeCreateRatioPlan          = "create_ratio_plan"
+	EventTypeCreateDecayingPlan       = "create_decaying_plan"
+	EventTypeCreateSchedulePlan       = "create_schedule_plan"
+	EventTypeStake                    = "stake"
+	EventTypeLockStaking              = "lock_staking"
+	EventTypeUnstake                  = "unstake"
+	EventTypeCancelQueuedStaking      = "cancel_queued_staking"
+	EventTypeEmergencyUnstake         = "emergency_unstake"
+	EventTypeCompleteUnbonding        = "complete_unbonding"
+	EventTypeHarvest                  = "harvest"
+	EventTypeTransferStaking          = "transfer_staking"
+	EventTypeMintReceipt              = "mint_receipt"
+	EventTypeBurnReceipt              = "burn_receipt"
+	EventTypeSyncReceiptStaking       = "sync_receipt_staking"
+	EventTypeSetAutoCompound          = "set_auto_compound"
+	EventTypeCompoundRewards          = "compound_rewards"
+	EventTypeClaimVestedRewards       = "claim_vested_rewards"
+	EventTypeUpdatePrivatePlan        = "update_private_plan"
+	EventTypeAddPlanFarmers           = "add_plan_farmers"
+	EventTypeRemovePlanFarmers        = "remove_plan_farmers"
+	EventTypeSetRewardWithdrawAddress = "set_reward_withdraw_address"
+	EventTypePlanTerminated           = "plan_terminated"
+	EventTypeRewardsAllocated         = "rewards_allocated"
+	EventTypeSweepRewardsDust         = "sweep_rewards_dust"
+	EventTypeAdvanceEpoch             = "advance_epoch"
 
 	AttributeKeyPlanId             = "plan_id" //nolint:golint
 	AttributeKeyPlanName           = "plan_name"
@@ -16,11 +35,29 @@ const (
 	AttributeKeyTerminationAddress = "termination_address"
 	AttributeKeyStakingCoins       = "staking_coins"
 	AttributeKeyUnstakingCoins     = "unstaking_coins"
+	AttributeKeyQueuedCoins        = "queued_coins"
+	AttributeKeyWithdrawnCoins     = "withdrawn_coins"
+	AttributeKeyUnbondingCoins     = "unbonding_coins"
+	AttributeKeyCompletionTime     = "completion_time"
 	AttributeKeyRewardCoins        = "reward_coins"
 	AttributeKeyStartTime          = "start_time"
 	AttributeKeyEndTime            = "end_time"
 	AttributeKeyEpochAmount        = "epoch_amount"
 	AttributeKeyEpochRatio         = "epoch_ratio"
+	AttributeKeyDecayRate          = "decay_rate"
+	AttributeKeyDecayEpochs        = "decay_epochs"
+	AttributeKeyPhases             = "phases"
+	AttributeKeyLockDuration       = "lock_duration"
 	AttributeKeyFarmer             = "farmer"
+	AttributeKeyFarmers            = "farmers"
+	AttributeKeyRecipient          = "recipient"
+	AttributeKeyReceiptCoins       = "receipt_coins"
+	AttributeKeyReleasedCoins      = "released_coins"
+	AttributeKeyClaimedCoins       = "claimed_coins"
+	AttributeKeyEnabled            = "enabled"
+	AttributeKeyCompoundedCoins    = "compounded_coins"
+	AttributeKeyForfeitedCoins     = "forfeited_coins"
+	AttributeKeyWithdrawAddress    = "withdraw_address"
 	AttributeKeyAmount             = "amount"
+	AttributeKeyEpochTime          = "epoch_time"
 )
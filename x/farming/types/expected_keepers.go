@@ -9,11 +9,15 @@ import (
 type BankKeeper interface {
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
-	//GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
 
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
 
 // AccountKeeper defines the expected account keeper
@@ -23,3 +27,15 @@ type AccountKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
 	SetModuleAccount(sdk.Context, authtypes.ModuleAccountI)
 }
+
+// FarmingHooks defines the hooks which other modules can register to react to
+// the lifecycle events of the farming module.
+type FarmingHooks interface {
+	BeforeAdvanceEpoch(ctx sdk.Context)                                                                    // Must be called before an epoch is advanced
+	AfterAdvanceEpoch(ctx sdk.Context)                                                                     // Must be called after an epoch is advanced
+	AfterAllocateRewards(ctx sdk.Context, plan PlanI, allocatedCoins sdk.Coins)                            // Must be called after the rewards of a plan are allocated
+	AfterStake(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoins sdk.Coins)                          // Must be called after coins are staked, including transferred, compounded and claimed receipt-backed stakings
+	AfterUnstake(ctx sdk.Context, farmerAcc sdk.AccAddress, unstakingCoins sdk.Coins)                      // Must be called after coins are unstaked, including queued coins, emergency unstaking, transferred and released receipt-backed stakings
+	AfterHarvest(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenoms []string, rewards sdk.Coins) // Must be called after rewards are harvested
+	AfterPlanTerminated(ctx sdk.Context, plan PlanI)                                                       // Must be called after a plan is terminated
+}
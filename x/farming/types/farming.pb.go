@@ -11,6 +11,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -73,6 +74,27 @@ type Params struct {
 	NextEpochDays uint32 `protobuf:"varint,2,opt,name=next_epoch_days,json=nextEpochDays,proto3" json:"next_epoch_days,omitempty" yaml:"next_epoch_days"`
 	// farming_fee_collector is the module account address to collect fees within the farming module
 	FarmingFeeCollector string `protobuf:"bytes,3,opt,name=farming_fee_collector,json=farmingFeeCollector,proto3" json:"farming_fee_collector,omitempty" yaml:"farming_fee_collector"`
+	// lock_multipliers specifies the allowed lock durations for locked staking
+	// and the reward multiplier applied to the staking locked for each duration
+	LockMultipliers []LockMultiplier `protobuf:"bytes,4,rep,name=lock_multipliers,json=lockMultipliers,proto3" json:"lock_multipliers" yaml:"lock_multipliers"`
+	// unstaking_period is the duration for which unstaked coins are unbonding
+	// before they are paid out to the farmer; zero means unstaked coins are released immediately
+	UnstakingPeriod time.Duration `protobuf:"bytes,5,opt,name=unstaking_period,json=unstakingPeriod,proto3,stdduration" json:"unstaking_period" yaml:"unstaking_period"`
+	// allowed_staking_denoms specifies the denoms of the coins which can be staked;
+	// empty means coins of any denom can be staked
+	AllowedStakingDenoms []string `protobuf:"bytes,6,rep,name=allowed_staking_denoms,json=allowedStakingDenoms,proto3" json:"allowed_staking_denoms,omitempty" yaml:"allowed_staking_denoms"`
+	// max_total_stakings specifies the maximum amount of the coins of each denom which can be
+	// reserved for staking; denoms not listed are not capped
+	MaxTotalStakings github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=max_total_stakings,json=maxTotalStakings,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_total_stakings" yaml:"max_total_stakings"`
+	// min_staking_amounts specifies the minimum amount of the coins of each denom which can be
+	// staked at once; denoms not listed have no minimum
+	MinStakingAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=min_staking_amounts,json=minStakingAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_staking_amounts" yaml:"min_staking_amounts"`
+	// paused_operations specifies the operations which are paused in an emergency;
+	// one of "staking", "harvesting", "plan_creation" and "epoch_advancement"
+	PausedOperations []string `protobuf:"bytes,9,rep,name=paused_operations,json=pausedOperations,proto3" json:"paused_operations,omitempty" yaml:"paused_operations"`
+	// next_epoch_duration is the epoch length as a duration, which allows epochs shorter than a day
+	// it takes precedence over next_epoch_days unless it is zero
+	NextEpochDuration time.Duration `protobuf:"bytes,10,opt,name=next_epoch_duration,json=nextEpochDuration,proto3,stdduration" json:"next_epoch_duration" yaml:"next_epoch_duration"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -107,6 +129,47 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// LockMultiplier defines the reward multiplier of a lock duration.
+type LockMultiplier struct {
+	// lock_duration specifies the duration for which staked coins are locked
+	LockDuration time.Duration `protobuf:"bytes,1,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration" yaml:"lock_duration"`
+	// multiplier specifies the factor that the reward weight of the locked coins is multiplied by
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+}
+
+func (m *LockMultiplier) Reset()         { *m = LockMultiplier{} }
+func (m *LockMultiplier) String() string { return proto.CompactTextString(m) }
+func (*LockMultiplier) ProtoMessage()    {}
+func (*LockMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{1}
+}
+func (m *LockMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockMultiplier.Merge(m, src)
+}
+func (m *LockMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockMultiplier proto.InternalMessageInfo
+
 // BasePlan defines a base plan type. It contains all the necessary fields
 // for basic farming plan functionality. Any custom farming plan type should
 // extend this type for additional functionality (e.g. fixed amount plan, ratio
@@ -138,12 +201,18 @@ type BasePlan struct {
 	LastDistributionTime *time.Time `protobuf:"bytes,10,opt,name=last_distribution_time,json=lastDistributionTime,proto3,stdtime" json:"last_distribution_time,omitempty" yaml:"last_distribution_time"`
 	// distributed_coins specifies the total coins distributed by this plan
 	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins" yaml:"distributed_coins"`
+	// reward_vesting_duration specifies the duration over which harvested rewards from the plan
+	// unlock linearly; zero means rewards are not vested
+	RewardVestingDuration time.Duration `protobuf:"bytes,12,opt,name=reward_vesting_duration,json=rewardVestingDuration,proto3,stdduration" json:"reward_vesting_duration" yaml:"reward_vesting_duration"`
+	// restricted specifies whether the rewards of the plan are distributed only to
+	// the farmers in the allowlist of the plan; only private plans can be restricted
+	Restricted bool `protobuf:"varint,13,opt,name=restricted,proto3" json:"restricted,omitempty"`
 }
 
 func (m *BasePlan) Reset()      { *m = BasePlan{} }
 func (*BasePlan) ProtoMessage() {}
 func (*BasePlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{1}
+	return fileDescriptor_5b657e0809d9de86, []int{2}
 }
 func (m *BasePlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,7 +252,7 @@ type FixedAmountPlan struct {
 func (m *FixedAmountPlan) Reset()      { *m = FixedAmountPlan{} }
 func (*FixedAmountPlan) ProtoMessage() {}
 func (*FixedAmountPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{2}
+	return fileDescriptor_5b657e0809d9de86, []int{3}
 }
 func (m *FixedAmountPlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -223,7 +292,7 @@ type RatioPlan struct {
 func (m *RatioPlan) Reset()      { *m = RatioPlan{} }
 func (*RatioPlan) ProtoMessage() {}
 func (*RatioPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{3}
+	return fileDescriptor_5b657e0809d9de86, []int{4}
 }
 func (m *RatioPlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -252,16 +321,151 @@ func (m *RatioPlan) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RatioPlan proto.InternalMessageInfo
 
+// DecayingPlan defines a decaying plan that distributes a fixed amount of coins
+// for every epoch, but the amount decays by the decay rate every decay epochs.
+type DecayingPlan struct {
+	*BasePlan `protobuf:"bytes,1,opt,name=base_plan,json=basePlan,proto3,embedded=base_plan" json:"base_plan,omitempty" yaml:"base_plan"`
+	// epoch_amount specifies the initial distributing amount for each epoch
+	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
+	// decay_rate specifies the factor that the distributing amount is multiplied by
+	// every decay_epochs, e.g. 0.5 halves the amount
+	DecayRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=decay_rate,json=decayRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_rate" yaml:"decay_rate"`
+	// decay_epochs specifies the number of epochs between decays
+	DecayEpochs uint32 `protobuf:"varint,4,opt,name=decay_epochs,json=decayEpochs,proto3" json:"decay_epochs,omitempty" yaml:"decay_epochs"`
+	// allocated_epochs specifies the number of epochs that the plan has allocated rewards
+	AllocatedEpochs uint64 `protobuf:"varint,5,opt,name=allocated_epochs,json=allocatedEpochs,proto3" json:"allocated_epochs,omitempty" yaml:"allocated_epochs"`
+}
+
+func (m *DecayingPlan) Reset()      { *m = DecayingPlan{} }
+func (*DecayingPlan) ProtoMessage() {}
+func (*DecayingPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{5}
+}
+func (m *DecayingPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecayingPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecayingPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecayingPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecayingPlan.Merge(m, src)
+}
+func (m *DecayingPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecayingPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecayingPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecayingPlan proto.InternalMessageInfo
+
+// SchedulePlan defines a schedule-based plan that distributes a fixed amount of coins
+// for every epoch, where the amount is determined by the phase active at the time.
+type SchedulePlan struct {
+	*BasePlan `protobuf:"bytes,1,opt,name=base_plan,json=basePlan,proto3,embedded=base_plan" json:"base_plan,omitempty" yaml:"base_plan"`
+	// phases specifies the ordered, non-overlapping emission phases of the plan
+	Phases []SchedulePhase `protobuf:"bytes,2,rep,name=phases,proto3" json:"phases"`
+	// current_phase specifies the phase active at the block time of a query;
+	// it is only populated in query responses and is not stored in state
+	CurrentPhase *SchedulePhase `protobuf:"bytes,3,opt,name=current_phase,json=currentPhase,proto3" json:"current_phase,omitempty" yaml:"current_phase"`
+}
+
+func (m *SchedulePlan) Reset()      { *m = SchedulePlan{} }
+func (*SchedulePlan) ProtoMessage() {}
+func (*SchedulePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{6}
+}
+func (m *SchedulePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchedulePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchedulePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulePlan.Merge(m, src)
+}
+func (m *SchedulePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulePlan proto.InternalMessageInfo
+
+// SchedulePhase defines an emission phase of a schedule-based plan.
+type SchedulePhase struct {
+	// start_time specifies the start time of the phase
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// end_time specifies the end time of the phase
+	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// epoch_amount specifies the distributing amount for each epoch during the phase
+	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
+}
+
+func (m *SchedulePhase) Reset()         { *m = SchedulePhase{} }
+func (m *SchedulePhase) String() string { return proto.CompactTextString(m) }
+func (*SchedulePhase) ProtoMessage()    {}
+func (*SchedulePhase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{7}
+}
+func (m *SchedulePhase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulePhase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchedulePhase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchedulePhase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulePhase.Merge(m, src)
+}
+func (m *SchedulePhase) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulePhase) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulePhase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulePhase proto.InternalMessageInfo
+
 // Staking defines a farmer's staking information.
 type Staking struct {
 	Amount        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 	StartingEpoch uint64                                 `protobuf:"varint,2,opt,name=starting_epoch,json=startingEpoch,proto3" json:"starting_epoch,omitempty" yaml:"starting_epoch"`
+	// boost_amount specifies the additional reward weight of the staking given by its locked stakings
+	BoostAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=boost_amount,json=boostAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"boost_amount" yaml:"boost_amount"`
 }
 
 func (m *Staking) Reset()      { *m = Staking{} }
 func (*Staking) ProtoMessage() {}
 func (*Staking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{4}
+	return fileDescriptor_5b657e0809d9de86, []int{8}
 }
 func (m *Staking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,13 +496,16 @@ var xxx_messageInfo_Staking proto.InternalMessageInfo
 
 type QueuedStaking struct {
 	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// queued_epoch specifies the epoch number at which the coins are queued, the coins are staked
+	// once an epoch is advanced after the epoch number
+	QueuedEpoch uint64 `protobuf:"varint,2,opt,name=queued_epoch,json=queuedEpoch,proto3" json:"queued_epoch,omitempty" yaml:"queued_epoch"`
 }
 
 func (m *QueuedStaking) Reset()         { *m = QueuedStaking{} }
 func (m *QueuedStaking) String() string { return proto.CompactTextString(m) }
 func (*QueuedStaking) ProtoMessage()    {}
 func (*QueuedStaking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{5}
+	return fileDescriptor_5b657e0809d9de86, []int{9}
 }
 func (m *QueuedStaking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,6 +534,191 @@ func (m *QueuedStaking) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueuedStaking proto.InternalMessageInfo
 
+// QueuedStakingsCursor defines the progress of processing the queued stakings which are staked
+// by an epoch advancement. The processing is continued in the following blocks from next_key.
+type QueuedStakingsCursor struct {
+	// next_key specifies the store key of the queued staking from which the processing is continued
+	NextKey []byte `protobuf:"bytes,1,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty" yaml:"next_key"`
+	// epoch_time specifies the block time at which the epoch was advanced, which is used to
+	// boost the locked stakings as if the queued coins were staked at the epoch advancement
+	EpochTime time.Time `protobuf:"bytes,2,opt,name=epoch_time,json=epochTime,proto3,stdtime" json:"epoch_time" yaml:"epoch_time"`
+}
+
+func (m *QueuedStakingsCursor) Reset()         { *m = QueuedStakingsCursor{} }
+func (m *QueuedStakingsCursor) String() string { return proto.CompactTextString(m) }
+func (*QueuedStakingsCursor) ProtoMessage()    {}
+func (*QueuedStakingsCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{10}
+}
+func (m *QueuedStakingsCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedStakingsCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedStakingsCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedStakingsCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedStakingsCursor.Merge(m, src)
+}
+func (m *QueuedStakingsCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedStakingsCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedStakingsCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedStakingsCursor proto.InternalMessageInfo
+
+func (m *QueuedStakingsCursor) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+func (m *QueuedStakingsCursor) GetEpochTime() time.Time {
+	if m != nil {
+		return m.EpochTime
+	}
+	return time.Time{}
+}
+
+// LockedStaking defines an amount of staked coins of a farmer that can't be unstaked until the end time.
+type LockedStaking struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// boost_amount specifies the additional reward weight given to the locked coins
+	BoostAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=boost_amount,json=boostAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"boost_amount" yaml:"boost_amount"`
+	// end_time specifies the time when the locked coins are unlocked
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// boosted specifies whether the boost amount has been applied to the staking;
+	// it is false while the locked coins are queued
+	Boosted bool `protobuf:"varint,4,opt,name=boosted,proto3" json:"boosted,omitempty"`
+}
+
+func (m *LockedStaking) Reset()         { *m = LockedStaking{} }
+func (m *LockedStaking) String() string { return proto.CompactTextString(m) }
+func (*LockedStaking) ProtoMessage()    {}
+func (*LockedStaking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{11}
+}
+func (m *LockedStaking) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockedStaking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockedStaking.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockedStaking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedStaking.Merge(m, src)
+}
+func (m *LockedStaking) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockedStaking) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedStaking.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedStaking proto.InternalMessageInfo
+
+// UnbondingStaking stores all of a farmer's unstaked coins which are unbonding.
+type UnbondingStaking struct {
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	// entries are the unbonding entries of the farmer
+	Entries []UnbondingStakingEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *UnbondingStaking) Reset()         { *m = UnbondingStaking{} }
+func (m *UnbondingStaking) String() string { return proto.CompactTextString(m) }
+func (*UnbondingStaking) ProtoMessage()    {}
+func (*UnbondingStaking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{12}
+}
+func (m *UnbondingStaking) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingStaking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingStaking.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingStaking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingStaking.Merge(m, src)
+}
+func (m *UnbondingStaking) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingStaking) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingStaking.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingStaking proto.InternalMessageInfo
+
+// UnbondingStakingEntry defines an unbonding entry of unstaked coins.
+type UnbondingStakingEntry struct {
+	// creation_height is the height which the unbonding took place
+	CreationHeight int64 `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
+	// completion_time is the time when the unbonding is completed and the coins are paid out
+	CompletionTime time.Time `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+	// balance specifies the unbonding coins to be paid out at completion
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *UnbondingStakingEntry) Reset()         { *m = UnbondingStakingEntry{} }
+func (m *UnbondingStakingEntry) String() string { return proto.CompactTextString(m) }
+func (*UnbondingStakingEntry) ProtoMessage()    {}
+func (*UnbondingStakingEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{13}
+}
+func (m *UnbondingStakingEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingStakingEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingStakingEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingStakingEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingStakingEntry.Merge(m, src)
+}
+func (m *UnbondingStakingEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingStakingEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingStakingEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingStakingEntry proto.InternalMessageInfo
+
 type TotalStakings struct {
 	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
@@ -335,7 +727,7 @@ func (m *TotalStakings) Reset()         { *m = TotalStakings{} }
 func (m *TotalStakings) String() string { return proto.CompactTextString(m) }
 func (*TotalStakings) ProtoMessage()    {}
 func (*TotalStakings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{6}
+	return fileDescriptor_5b657e0809d9de86, []int{14}
 }
 func (m *TotalStakings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,13 +758,26 @@ var xxx_messageInfo_TotalStakings proto.InternalMessageInfo
 
 type HistoricalRewards struct {
 	CumulativeUnitRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_unit_rewards,json=cumulativeUnitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_unit_rewards" yaml:"cumulative_unit_rewards"`
+	// cumulative_vesting_unit_rewards specifies the part of cumulative_unit_rewards
+	// allocated by plans with reward vesting, grouped by the vesting duration
+	CumulativeVestingUnitRewards []VestingUnitRewards `protobuf:"bytes,2,rep,name=cumulative_vesting_unit_rewards,json=cumulativeVestingUnitRewards,proto3" json:"cumulative_vesting_unit_rewards" yaml:"cumulative_vesting_unit_rewards"`
+	// cumulative_plan_unit_rewards specifies the cumulative unit rewards allocated by
+	// restricted plans, which are shared only among the farmers in the allowlist of each plan
+	CumulativePlanUnitRewards []PlanUnitRewards `protobuf:"bytes,3,rep,name=cumulative_plan_unit_rewards,json=cumulativePlanUnitRewards,proto3" json:"cumulative_plan_unit_rewards" yaml:"cumulative_plan_unit_rewards"`
+	// reference_count is the number of stakings which start right after the epoch,
+	// plus one if the epoch is the latest epoch of the staking coin denom;
+	// the historical rewards are deleted once they are no longer referenced
+	ReferenceCount uint32 `protobuf:"varint,4,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty" yaml:"reference_count"`
+	// cumulative_unit_rewards_by_plan specifies the part of cumulative_unit_rewards
+	// allocated by each plan
+	CumulativeUnitRewardsByPlan []PlanUnitRewards `protobuf:"bytes,5,rep,name=cumulative_unit_rewards_by_plan,json=cumulativeUnitRewardsByPlan,proto3" json:"cumulative_unit_rewards_by_plan" yaml:"cumulative_unit_rewards_by_plan"`
 }
 
 func (m *HistoricalRewards) Reset()         { *m = HistoricalRewards{} }
 func (m *HistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*HistoricalRewards) ProtoMessage()    {}
 func (*HistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{7}
+	return fileDescriptor_5b657e0809d9de86, []int{15}
 }
 func (m *HistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,24 +806,24 @@ func (m *HistoricalRewards) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HistoricalRewards proto.InternalMessageInfo
 
-// OutstandingRewards represents outstanding(un-withdrawn) rewards
-// for a staking coin denom.
-type OutstandingRewards struct {
-	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+// PlanUnitRewards defines cumulative unit rewards allocated by a plan.
+type PlanUnitRewards struct {
+	PlanId                uint64                                      `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty" yaml:"plan_id"`
+	CumulativeUnitRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=cumulative_unit_rewards,json=cumulativeUnitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_unit_rewards" yaml:"cumulative_unit_rewards"`
 }
 
-func (m *OutstandingRewards) Reset()         { *m = OutstandingRewards{} }
-func (m *OutstandingRewards) String() string { return proto.CompactTextString(m) }
-func (*OutstandingRewards) ProtoMessage()    {}
-func (*OutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{8}
+func (m *PlanUnitRewards) Reset()         { *m = PlanUnitRewards{} }
+func (m *PlanUnitRewards) String() string { return proto.CompactTextString(m) }
+func (*PlanUnitRewards) ProtoMessage()    {}
+func (*PlanUnitRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{16}
 }
-func (m *OutstandingRewards) XXX_Unmarshal(b []byte) error {
+func (m *PlanUnitRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OutstandingRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PlanUnitRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OutstandingRewards.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PlanUnitRewards.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -428,124 +833,373 @@ func (m *OutstandingRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *OutstandingRewards) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OutstandingRewards.Merge(m, src)
+func (m *PlanUnitRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanUnitRewards.Merge(m, src)
 }
-func (m *OutstandingRewards) XXX_Size() int {
+func (m *PlanUnitRewards) XXX_Size() int {
 	return m.Size()
 }
-func (m *OutstandingRewards) XXX_DiscardUnknown() {
-	xxx_messageInfo_OutstandingRewards.DiscardUnknown(m)
+func (m *PlanUnitRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanUnitRewards.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OutstandingRewards proto.InternalMessageInfo
+var xxx_messageInfo_PlanUnitRewards proto.InternalMessageInfo
 
-func (m *OutstandingRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
-	if m != nil {
-		return m.Rewards
-	}
-	return nil
+// VestingUnitRewards defines cumulative unit rewards that vest over the vesting duration.
+type VestingUnitRewards struct {
+	VestingDuration       time.Duration                               `protobuf:"bytes,1,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
+	CumulativeUnitRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=cumulative_unit_rewards,json=cumulativeUnitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_unit_rewards" yaml:"cumulative_unit_rewards"`
 }
 
-func init() {
-	proto.RegisterEnum("cosmos.farming.v1beta1.PlanType", PlanType_name, PlanType_value)
-	proto.RegisterType((*Params)(nil), "cosmos.farming.v1beta1.Params")
-	proto.RegisterType((*BasePlan)(nil), "cosmos.farming.v1beta1.BasePlan")
-	proto.RegisterType((*FixedAmountPlan)(nil), "cosmos.farming.v1beta1.FixedAmountPlan")
-	proto.RegisterType((*RatioPlan)(nil), "cosmos.farming.v1beta1.RatioPlan")
-	proto.RegisterType((*Staking)(nil), "cosmos.farming.v1beta1.Staking")
-	proto.RegisterType((*QueuedStaking)(nil), "cosmos.farming.v1beta1.QueuedStaking")
-	proto.RegisterType((*TotalStakings)(nil), "cosmos.farming.v1beta1.TotalStakings")
-	proto.RegisterType((*HistoricalRewards)(nil), "cosmos.farming.v1beta1.HistoricalRewards")
-	proto.RegisterType((*OutstandingRewards)(nil), "cosmos.farming.v1beta1.OutstandingRewards")
+func (m *VestingUnitRewards) Reset()         { *m = VestingUnitRewards{} }
+func (m *VestingUnitRewards) String() string { return proto.CompactTextString(m) }
+func (*VestingUnitRewards) ProtoMessage()    {}
+func (*VestingUnitRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{17}
 }
-
-func init() {
-	proto.RegisterFile("tendermint/farming/v1beta1/farming.proto", fileDescriptor_5b657e0809d9de86)
+func (m *VestingUnitRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingUnitRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingUnitRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingUnitRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingUnitRewards.Merge(m, src)
+}
+func (m *VestingUnitRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingUnitRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingUnitRewards.DiscardUnknown(m)
 }
 
-var fileDescriptor_5b657e0809d9de86 = []byte{
-	// 1146 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcb, 0x6f, 0xdb, 0xc6,
-	0x13, 0xd6, 0x2a, 0x8a, 0x2d, 0xad, 0x7e, 0xb6, 0xe5, 0xf5, 0xe3, 0x47, 0x2b, 0x89, 0x48, 0x10,
-	0x68, 0x21, 0xa4, 0x88, 0x84, 0x38, 0x3d, 0xf9, 0x54, 0xcb, 0x8f, 0x54, 0x80, 0xe1, 0x28, 0x8c,
-	0xdc, 0xb4, 0x05, 0x0a, 0x62, 0x25, 0xae, 0x65, 0xc2, 0x14, 0x29, 0x70, 0x57, 0x7e, 0x1c, 0x7b,
-	0x28, 0x60, 0xf8, 0x14, 0x14, 0x3d, 0xf4, 0xe2, 0x22, 0x68, 0x6f, 0xe9, 0xb5, 0xff, 0x43, 0x73,
-	0x34, 0x0a, 0x14, 0x68, 0x7b, 0x60, 0x0a, 0xfb, 0x3f, 0xd0, 0xb9, 0x87, 0x62, 0x1f, 0x54, 0xd8,
-	0x56, 0xae, 0x23, 0x20, 0x3d, 0x91, 0x3b, 0x3b, 0xf3, 0xcd, 0xf7, 0xed, 0xce, 0x0c, 0x09, 0xcb,
-	0x8c, 0xf8, 0x0e, 0x09, 0xbb, 0xae, 0xcf, 0xaa, 0xbb, 0x98, 0x3f, 0x3b, 0xd5, 0x83, 0xfb, 0x2d,
-	0xc2, 0xf0, 0xfd, 0x78, 0x5d, 0xe9, 0x85, 0x01, 0x0b, 0xd0, 0x62, 0x3b, 0xa0, 0xdd, 0x80, 0x56,
-	0x62, 0xab, 0xf2, 0x2a, 0xce, 0x77, 0x82, 0x4e, 0x20, 0x5c, 0xaa, 0xfc, 0x4d, 0x7a, 0x17, 0x97,
-	0xa4, 0xb7, 0x2d, 0x37, 0x54, 0xa8, 0xdc, 0x2a, 0xc9, 0x55, 0xb5, 0x85, 0x29, 0x19, 0xe6, 0x6a,
-	0x07, 0xae, 0xaf, 0xf6, 0xf5, 0x4e, 0x10, 0x74, 0x3c, 0x52, 0x15, 0xab, 0x56, 0x7f, 0xb7, 0xca,
-	0xdc, 0x2e, 0xa1, 0x0c, 0x77, 0x7b, 0xd2, 0xc1, 0xfc, 0x35, 0x0d, 0x27, 0x1a, 0x38, 0xc4, 0x5d,
-	0x8a, 0x5e, 0x00, 0xb8, 0xd4, 0x0b, 0xdd, 0x03, 0xcc, 0x88, 0xdd, 0xf3, 0xb0, 0x6f, 0xb7, 0x43,
-	0x82, 0x99, 0x1b, 0xf8, 0xf6, 0x2e, 0x21, 0x1a, 0x30, 0x6e, 0x94, 0xf3, 0xcb, 0x4b, 0x15, 0x95,
-	0x9e, 0x27, 0x8c, 0x69, 0x57, 0xd6, 0x02, 0xd7, 0xaf, 0x35, 0x5f, 0x46, 0x7a, 0x6a, 0x10, 0xe9,
-	0xc6, 0x31, 0xee, 0x7a, 0x2b, 0xe6, 0x95, 0x48, 0xe6, 0x8b, 0x57, 0x7a, 0xb9, 0xe3, 0xb2, 0xbd,
-	0x7e, 0xab, 0xd2, 0x0e, 0xba, 0x4a, 0x8f, 0x7a, 0xdc, 0xa3, 0xce, 0x7e, 0x95, 0x1d, 0xf7, 0x08,
-	0x15, 0xa0, 0xd4, 0x5a, 0x54, 0x38, 0x0d, 0x0f, 0xfb, 0x6b, 0x0a, 0x65, 0x93, 0x10, 0x54, 0x83,
-	0x33, 0x3e, 0x39, 0x62, 0x36, 0xe9, 0x05, 0xed, 0x3d, 0xdb, 0xc1, 0xc7, 0x54, 0x4b, 0x1b, 0xa0,
-	0x3c, 0x55, 0x2b, 0x0e, 0x22, 0x7d, 0x51, 0x52, 0xf8, 0x9b, 0x83, 0x69, 0x4d, 0x71, 0xcb, 0x06,
-	0x37, 0xac, 0xe3, 0x63, 0x8a, 0x9a, 0x70, 0x41, 0x5d, 0x00, 0xe7, 0x65, 0xb7, 0x03, 0xcf, 0x23,
-	0x6d, 0x16, 0x84, 0xda, 0x0d, 0x03, 0x94, 0x73, 0x35, 0x63, 0x10, 0xe9, 0xb7, 0x25, 0xd2, 0x48,
-	0x37, 0xd3, 0x9a, 0x53, 0xf6, 0x4d, 0x42, 0xd6, 0x62, 0xeb, 0x4a, 0xf6, 0xe4, 0xb9, 0x9e, 0xfa,
-	0xfa, 0xb9, 0x9e, 0x32, 0xbf, 0x99, 0x84, 0xd9, 0x1a, 0xa6, 0x82, 0x3b, 0x9a, 0x86, 0x69, 0xd7,
-	0xd1, 0x80, 0x01, 0xca, 0x19, 0x2b, 0xed, 0x3a, 0x08, 0xc1, 0x8c, 0x8f, 0xbb, 0x44, 0xb0, 0xce,
-	0x59, 0xe2, 0x1d, 0xbd, 0x0f, 0x33, 0x5c, 0xbb, 0xc8, 0x3f, 0xbd, 0x6c, 0x54, 0x46, 0x57, 0x49,
-	0x85, 0xe3, 0x35, 0x8f, 0x7b, 0xc4, 0x12, 0xde, 0xe8, 0x31, 0x9c, 0x8f, 0xf9, 0xf5, 0x82, 0xc0,
-	0xb3, 0xb1, 0xe3, 0x84, 0x84, 0x52, 0x2d, 0x23, 0x54, 0xe8, 0x83, 0x48, 0xbf, 0xf5, 0x57, 0x15,
-	0x49, 0x2f, 0xd3, 0x42, 0xca, 0xdc, 0x08, 0x02, 0x6f, 0x55, 0x1a, 0xd1, 0x23, 0x38, 0xc7, 0x44,
-	0x21, 0xcb, 0x5b, 0x8b, 0x11, 0x6f, 0x0a, 0xc4, 0xd2, 0x20, 0xd2, 0x8b, 0x12, 0x71, 0x84, 0x93,
-	0x69, 0xa1, 0x84, 0x35, 0x06, 0xfc, 0x16, 0xc0, 0x79, 0xca, 0xf0, 0x3e, 0x4f, 0xcf, 0xcb, 0xd3,
-	0x3e, 0x24, 0x6e, 0x67, 0x8f, 0x51, 0x6d, 0x42, 0x94, 0xd5, 0xed, 0x91, 0x65, 0xb5, 0x4e, 0xda,
-	0xa2, 0xb2, 0x2c, 0x55, 0x59, 0x4a, 0xc6, 0x28, 0x1c, 0x5e, 0x54, 0xef, 0xbd, 0x41, 0x51, 0x29,
-	0x48, 0x6a, 0x21, 0x85, 0xc2, 0x57, 0x4f, 0x25, 0x06, 0xfa, 0x18, 0x42, 0xca, 0x70, 0xc8, 0x6c,
-	0xde, 0x24, 0xda, 0xa4, 0x01, 0xca, 0xf9, 0xe5, 0x62, 0x45, 0x76, 0x50, 0x25, 0xee, 0xa0, 0x4a,
-	0x33, 0xee, 0xa0, 0xda, 0x1d, 0xc5, 0x6b, 0x76, 0xc8, 0x4b, 0xc5, 0x9a, 0xcf, 0x5e, 0xe9, 0xc0,
-	0xca, 0x09, 0x03, 0x77, 0x47, 0x16, 0xcc, 0x12, 0xdf, 0x91, 0xb8, 0xd9, 0x6b, 0x71, 0x6f, 0x29,
-	0xdc, 0x19, 0x89, 0x1b, 0x47, 0x4a, 0xd4, 0x49, 0xe2, 0x3b, 0x02, 0xb3, 0x04, 0x61, 0x7c, 0xd0,
-	0xc4, 0xd1, 0x72, 0x06, 0x28, 0x67, 0xad, 0x84, 0x05, 0x1d, 0xc2, 0x45, 0x0f, 0x53, 0x66, 0x3b,
-	0x2e, 0x65, 0xa1, 0xdb, 0xea, 0x8b, 0x4b, 0x12, 0x0c, 0xe0, 0xb5, 0x0c, 0xde, 0x19, 0x44, 0xfa,
-	0x1d, 0x99, 0x7d, 0x34, 0x86, 0xe4, 0x32, 0xcf, 0x37, 0xd7, 0x13, 0x7b, 0x82, 0xd8, 0x57, 0x00,
-	0xce, 0x0e, 0x03, 0x88, 0x23, 0xee, 0x89, 0x6a, 0xf9, 0xeb, 0xe6, 0xc7, 0x96, 0x52, 0xad, 0xc9,
-	0xbc, 0xff, 0x40, 0x18, 0x6f, 0x6e, 0x14, 0x12, 0xf1, 0xc2, 0xb2, 0x32, 0x1b, 0xf7, 0xe5, 0x4f,
-	0x3f, 0xdc, 0xbb, 0xc9, 0x5b, 0xa8, 0x6e, 0xfe, 0x01, 0xe0, 0xcc, 0xa6, 0x7b, 0x44, 0x9c, 0xd5,
-	0x6e, 0xd0, 0xf7, 0x99, 0xe8, 0xd3, 0xa7, 0x30, 0xc7, 0xb9, 0x89, 0xb9, 0x25, 0xda, 0x35, 0x7f,
-	0x75, 0x23, 0xc6, 0xcd, 0x5d, 0xd3, 0xce, 0x23, 0x1d, 0x0c, 0x22, 0xbd, 0x20, 0xb9, 0x0f, 0x01,
-	0x4c, 0x2b, 0xdb, 0x8a, 0x07, 0xc0, 0x17, 0x00, 0xfe, 0x4f, 0x0e, 0x23, 0x2c, 0xb2, 0x69, 0xe9,
-	0xeb, 0x4e, 0xe4, 0xa1, 0x3a, 0x91, 0x39, 0x55, 0x07, 0x89, 0xe0, 0xf1, 0x0e, 0x23, 0x2f, 0x42,
-	0xa5, 0xc8, 0xc4, 0x7c, 0xfa, 0x19, 0xc0, 0x9c, 0xc5, 0xdb, 0xf4, 0xbf, 0x15, 0x4e, 0xa0, 0xcc,
-	0x6f, 0x87, 0x3c, 0x97, 0x1c, 0x78, 0xb5, 0x75, 0xae, 0xed, 0xb7, 0x48, 0x7f, 0xf7, 0xcd, 0x9a,
-	0x76, 0x10, 0xe9, 0x28, 0x79, 0x0a, 0x02, 0xca, 0xb4, 0xa0, 0x58, 0x09, 0x0d, 0x09, 0x5d, 0x67,
-	0x00, 0x4e, 0x3e, 0x91, 0xed, 0x8d, 0x36, 0xe1, 0x84, 0x3a, 0x6e, 0x20, 0xf2, 0x56, 0xc6, 0xc8,
-	0x5b, 0xf7, 0x99, 0xa5, 0xa2, 0xd1, 0x07, 0x70, 0x5a, 0xb4, 0x33, 0x1f, 0x3c, 0x22, 0xa9, 0xd0,
-	0x91, 0xa9, 0x2d, 0x0d, 0x22, 0x7d, 0x21, 0xd1, 0xff, 0xc3, 0x7d, 0xd3, 0x9a, 0x8a, 0x0d, 0xe2,
-	0x8b, 0x93, 0xe0, 0xf7, 0x19, 0x9c, 0x7a, 0xdc, 0x27, 0x7d, 0xe2, 0xbc, 0x65, 0x92, 0x2b, 0x99,
-	0x13, 0x05, 0xdf, 0x0c, 0x18, 0xf6, 0x14, 0x3a, 0x7d, 0xcb, 0xf0, 0x3f, 0x02, 0x38, 0xfb, 0xa1,
-	0x4b, 0x59, 0x10, 0xba, 0x6d, 0xec, 0x59, 0xe4, 0x10, 0x87, 0x0e, 0x45, 0xdf, 0x03, 0xf8, 0xff,
-	0x76, 0xbf, 0xdb, 0xf7, 0x30, 0x73, 0x0f, 0x88, 0xdd, 0xf7, 0x5d, 0x66, 0x87, 0x72, 0x4f, 0xfd,
-	0x3a, 0xfc, 0xfb, 0x8c, 0xdf, 0x51, 0xb5, 0x5e, 0x92, 0x67, 0x79, 0x05, 0xd4, 0xd8, 0x63, 0x7e,
-	0xe1, 0x35, 0xd0, 0x8e, 0xef, 0x32, 0xc5, 0x56, 0x29, 0xf9, 0x1c, 0x40, 0xf4, 0xa8, 0xcf, 0x28,
-	0xc3, 0xbe, 0xe3, 0xfa, 0x9d, 0x58, 0xca, 0x3e, 0x9c, 0x1c, 0x87, 0xf9, 0x03, 0xce, 0x7c, 0x5c,
-	0x5e, 0x71, 0x86, 0xbb, 0x5f, 0x02, 0x98, 0x8d, 0xbf, 0xe7, 0xe8, 0x2e, 0x5c, 0x68, 0x6c, 0xad,
-	0x6e, 0xdb, 0xcd, 0x4f, 0x1a, 0x1b, 0xf6, 0xce, 0xf6, 0x93, 0xc6, 0xc6, 0x5a, 0x7d, 0xb3, 0xbe,
-	0xb1, 0x5e, 0x48, 0x15, 0x67, 0x4e, 0xcf, 0x8c, 0x7c, 0xec, 0xb8, 0xed, 0x7a, 0xa8, 0x0c, 0x0b,
-	0xaf, 0x7d, 0x1b, 0x3b, 0xb5, 0xad, 0xfa, 0x5a, 0x01, 0x14, 0xd1, 0xe9, 0x99, 0x31, 0x1d, 0xbb,
-	0x35, 0xfa, 0x2d, 0xcf, 0x6d, 0xa3, 0xbb, 0x70, 0x36, 0xe1, 0x69, 0xd5, 0x3f, 0x5a, 0x6d, 0x6e,
-	0x14, 0xd2, 0xc5, 0xb9, 0xd3, 0x33, 0x63, 0x66, 0xe8, 0x2a, 0xff, 0xb2, 0x8a, 0x99, 0x93, 0xef,
-	0x4a, 0xa9, 0xda, 0xc3, 0x97, 0x17, 0x25, 0x70, 0x7e, 0x51, 0x02, 0xbf, 0x5f, 0x94, 0xc0, 0xb3,
-	0xcb, 0x52, 0xea, 0xfc, 0xb2, 0x94, 0xfa, 0xe5, 0xb2, 0x94, 0xfa, 0xf4, 0x5e, 0x42, 0xe4, 0x88,
-	0xbf, 0xdd, 0xa3, 0xe1, 0x9b, 0xd0, 0xdb, 0x9a, 0x10, 0xdf, 0x96, 0x07, 0x7f, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0x49, 0xfd, 0xb5, 0xa0, 0x1a, 0x0b, 0x00, 0x00,
+var xxx_messageInfo_VestingUnitRewards proto.InternalMessageInfo
+
+// RewardVesting defines harvested rewards of a farmer that unlock linearly
+// from the start time over the vesting duration.
+type RewardVesting struct {
+	// start_time specifies the time when the rewards were harvested
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// vesting_duration specifies the duration over which the rewards unlock
+	VestingDuration time.Duration `protobuf:"bytes,2,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
+	// total_rewards specifies the total amount of vesting rewards
+	TotalRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_rewards,json=totalRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_rewards" yaml:"total_rewards"`
+	// claimed_rewards specifies the amount of rewards already claimed
+	ClaimedRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=claimed_rewards,json=claimedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed_rewards" yaml:"claimed_rewards"`
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RewardVesting) Reset()         { *m = RewardVesting{} }
+func (m *RewardVesting) String() string { return proto.CompactTextString(m) }
+func (*RewardVesting) ProtoMessage()    {}
+func (*RewardVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{18}
+}
+func (m *RewardVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardVesting.Merge(m, src)
+}
+func (m *RewardVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardVesting proto.InternalMessageInfo
+
+// OutstandingRewards represents outstanding(un-withdrawn) rewards
+// for a staking coin denom.
+type OutstandingRewards struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+}
+
+func (m *OutstandingRewards) Reset()         { *m = OutstandingRewards{} }
+func (m *OutstandingRewards) String() string { return proto.CompactTextString(m) }
+func (*OutstandingRewards) ProtoMessage()    {}
+func (*OutstandingRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{19}
+}
+func (m *OutstandingRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutstandingRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutstandingRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutstandingRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutstandingRewards.Merge(m, src)
+}
+func (m *OutstandingRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutstandingRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutstandingRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutstandingRewards proto.InternalMessageInfo
+
+func (m *OutstandingRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// RewardsDust defines the fractional rewards left over from the truncation of
+// withdrawn rewards, which are not owned by any farmer.
+type RewardsDust struct {
+	Amount github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"amount"`
+}
+
+func (m *RewardsDust) Reset()         { *m = RewardsDust{} }
+func (m *RewardsDust) String() string { return proto.CompactTextString(m) }
+func (*RewardsDust) ProtoMessage()    {}
+func (*RewardsDust) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{20}
+}
+func (m *RewardsDust) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsDust) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsDust.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardsDust) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsDust.Merge(m, src)
+}
+func (m *RewardsDust) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsDust) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsDust.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsDust proto.InternalMessageInfo
+
+func (m *RewardsDust) GetAmount() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.farming.v1beta1.PlanType", PlanType_name, PlanType_value)
+	proto.RegisterType((*Params)(nil), "cosmos.farming.v1beta1.Params")
+	proto.RegisterType((*LockMultiplier)(nil), "cosmos.farming.v1beta1.LockMultiplier")
+	proto.RegisterType((*BasePlan)(nil), "cosmos.farming.v1beta1.BasePlan")
+	proto.RegisterType((*FixedAmountPlan)(nil), "cosmos.farming.v1beta1.FixedAmountPlan")
+	proto.RegisterType((*RatioPlan)(nil), "cosmos.farming.v1beta1.RatioPlan")
+	proto.RegisterType((*DecayingPlan)(nil), "cosmos.farming.v1beta1.DecayingPlan")
+	proto.RegisterType((*SchedulePlan)(nil), "cosmos.farming.v1beta1.SchedulePlan")
+	proto.RegisterType((*SchedulePhase)(nil), "cosmos.farming.v1beta1.SchedulePhase")
+	proto.RegisterType((*Staking)(nil), "cosmos.farming.v1beta1.Staking")
+	proto.RegisterType((*QueuedStaking)(nil), "cosmos.farming.v1beta1.QueuedStaking")
+	proto.RegisterType((*QueuedStakingsCursor)(nil), "cosmos.farming.v1beta1.QueuedStakingsCursor")
+	proto.RegisterType((*LockedStaking)(nil), "cosmos.farming.v1beta1.LockedStaking")
+	proto.RegisterType((*UnbondingStaking)(nil), "cosmos.farming.v1beta1.UnbondingStaking")
+	proto.RegisterType((*UnbondingStakingEntry)(nil), "cosmos.farming.v1beta1.UnbondingStakingEntry")
+	proto.RegisterType((*TotalStakings)(nil), "cosmos.farming.v1beta1.TotalStakings")
+	proto.RegisterType((*HistoricalRewards)(nil), "cosmos.farming.v1beta1.HistoricalRewards")
+	proto.RegisterType((*PlanUnitRewards)(nil), "cosmos.farming.v1beta1.PlanUnitRewards")
+	proto.RegisterType((*VestingUnitRewards)(nil), "cosmos.farming.v1beta1.VestingUnitRewards")
+	proto.RegisterType((*RewardVesting)(nil), "cosmos.farming.v1beta1.RewardVesting")
+	proto.RegisterType((*OutstandingRewards)(nil), "cosmos.farming.v1beta1.OutstandingRewards")
+	proto.RegisterType((*RewardsDust)(nil), "cosmos.farming.v1beta1.RewardsDust")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/farming/v1beta1/farming.proto", fileDescriptor_5b657e0809d9de86)
+}
+
+var fileDescriptor_5b657e0809d9de86 = []byte{
+	// 2212 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0x59,
+	0x1d, 0xcf, 0x38, 0x6e, 0x6c, 0xff, 0xe3, 0xaf, 0xbc, 0x7c, 0xd4, 0x49, 0xbb, 0x1e, 0x33, 0xab,
+	0x2d, 0x51, 0xab, 0x3a, 0x6c, 0x97, 0x53, 0x4e, 0x74, 0xec, 0x64, 0x1b, 0xe8, 0x47, 0xf6, 0x35,
+	0xdd, 0x02, 0x12, 0x1a, 0xc6, 0x33, 0xaf, 0xc9, 0x28, 0xe3, 0x19, 0xef, 0xcc, 0x38, 0x8d, 0x0f,
+	0x08, 0x21, 0x81, 0xa8, 0x7a, 0x40, 0x2b, 0x04, 0x68, 0x57, 0xa2, 0x52, 0x81, 0xdb, 0x72, 0xe3,
+	0xe3, 0xc8, 0xbd, 0x12, 0x97, 0x0a, 0x09, 0x09, 0x71, 0xf0, 0x42, 0x7b, 0xe1, 0xec, 0x03, 0x27,
+	0x0e, 0xe8, 0x7d, 0x8c, 0x3d, 0x63, 0x3b, 0x75, 0x2c, 0x25, 0x02, 0xc4, 0x29, 0x7e, 0xff, 0xf7,
+	0x7f, 0xbf, 0xf7, 0xfb, 0x7f, 0xbe, 0x79, 0x2f, 0xb0, 0x1e, 0x10, 0xc7, 0x24, 0x5e, 0xd3, 0x72,
+	0x82, 0x8d, 0x47, 0x3a, 0xfd, 0xbb, 0xbf, 0x71, 0xf4, 0x6e, 0x83, 0x04, 0xfa, 0xbb, 0xe1, 0xb8,
+	0xda, 0xf2, 0xdc, 0xc0, 0x45, 0x2b, 0x86, 0xeb, 0x37, 0x5d, 0xbf, 0x1a, 0x4a, 0x85, 0xd6, 0xda,
+	0xd2, 0xbe, 0xbb, 0xef, 0x32, 0x95, 0x0d, 0xfa, 0x8b, 0x6b, 0xaf, 0xad, 0x72, 0x6d, 0x8d, 0x4f,
+	0x88, 0xa5, 0x7c, 0xaa, 0xcc, 0x47, 0x1b, 0x0d, 0xdd, 0x27, 0xfd, 0xbd, 0x0c, 0xd7, 0x72, 0xc4,
+	0xbc, 0xbc, 0xef, 0xba, 0xfb, 0x36, 0xd9, 0x60, 0xa3, 0x46, 0xfb, 0xd1, 0x46, 0x60, 0x35, 0x89,
+	0x1f, 0xe8, 0xcd, 0x56, 0x08, 0x30, 0xac, 0x60, 0xb6, 0x3d, 0x3d, 0xb0, 0x5c, 0x01, 0xa0, 0x7c,
+	0x3f, 0x03, 0x73, 0xbb, 0xba, 0xa7, 0x37, 0x7d, 0xf4, 0x99, 0x04, 0xab, 0x2d, 0xcf, 0x3a, 0xd2,
+	0x03, 0xa2, 0xb5, 0x6c, 0xdd, 0xd1, 0x0c, 0x8f, 0x30, 0x55, 0xed, 0x11, 0x21, 0x25, 0xa9, 0x32,
+	0xbb, 0x3e, 0x7f, 0x63, 0xb5, 0x2a, 0xe8, 0x51, 0x42, 0xa1, 0x59, 0xd5, 0x9a, 0x6b, 0x39, 0xea,
+	0xde, 0x8b, 0xae, 0x3c, 0xd3, 0xeb, 0xca, 0x95, 0x8e, 0xde, 0xb4, 0x37, 0x95, 0x13, 0x91, 0x94,
+	0xcf, 0x3e, 0x97, 0xd7, 0xf7, 0xad, 0xe0, 0xa0, 0xdd, 0xa8, 0x1a, 0x6e, 0x53, 0xd8, 0x2b, 0xfe,
+	0x5c, 0xf7, 0xcd, 0xc3, 0x8d, 0xa0, 0xd3, 0x22, 0x3e, 0x03, 0xf5, 0xf1, 0x8a, 0xc0, 0xd9, 0xb5,
+	0x75, 0xa7, 0x26, 0x50, 0xb6, 0x09, 0x41, 0x2a, 0x14, 0x1c, 0x72, 0x1c, 0x68, 0xa4, 0xe5, 0x1a,
+	0x07, 0x9a, 0xa9, 0x77, 0xfc, 0x52, 0xa2, 0x22, 0xad, 0xe7, 0xd4, 0xb5, 0x5e, 0x57, 0x5e, 0xe1,
+	0x14, 0x86, 0x14, 0x14, 0x9c, 0xa3, 0x92, 0x2d, 0x2a, 0xa8, 0xeb, 0x1d, 0x1f, 0xed, 0xc1, 0xb2,
+	0x08, 0x10, 0xe5, 0xa5, 0x19, 0xae, 0x6d, 0x13, 0x23, 0x70, 0xbd, 0xd2, 0x6c, 0x45, 0x5a, 0xcf,
+	0xa8, 0x95, 0x5e, 0x57, 0xbe, 0xcc, 0x91, 0xc6, 0xaa, 0x29, 0x78, 0x51, 0xc8, 0xb7, 0x09, 0xa9,
+	0x85, 0x52, 0xe4, 0x41, 0xd1, 0x76, 0x8d, 0x43, 0xad, 0xd9, 0xb6, 0x03, 0xab, 0x65, 0x5b, 0xc4,
+	0xf3, 0x4b, 0x49, 0xe6, 0xbc, 0x2b, 0xd5, 0xf1, 0x69, 0x51, 0xbd, 0xed, 0x1a, 0x87, 0x77, 0xfa,
+	0xea, 0xaa, 0x2c, 0x3c, 0x79, 0x91, 0x6f, 0x3e, 0x8c, 0xa6, 0xe0, 0x82, 0x1d, 0x5b, 0xe0, 0x23,
+	0x0b, 0x8a, 0x6d, 0xc7, 0x0f, 0xf4, 0x43, 0x4a, 0xb2, 0x45, 0x3c, 0xcb, 0x35, 0x4b, 0x17, 0x2a,
+	0x12, 0x0b, 0x18, 0x4f, 0x80, 0x6a, 0x98, 0x00, 0xd5, 0xba, 0x48, 0x00, 0xf5, 0xed, 0xf8, 0x36,
+	0xc3, 0x00, 0xca, 0x27, 0x9f, 0xcb, 0x12, 0x2e, 0xf4, 0xc5, 0xbb, 0x4c, 0x8a, 0x1e, 0xc2, 0x8a,
+	0x6e, 0xdb, 0xee, 0x63, 0x62, 0x6a, 0xa1, 0xbe, 0x49, 0x1c, 0xb7, 0xe9, 0x97, 0xe6, 0x2a, 0xb3,
+	0xeb, 0x19, 0xf5, 0x0b, 0xbd, 0xae, 0xfc, 0x16, 0x47, 0x1c, 0xaf, 0xa7, 0xe0, 0x25, 0x31, 0x71,
+	0x9f, 0xcb, 0xeb, 0x4c, 0x8c, 0x7e, 0x26, 0x01, 0x6a, 0xea, 0xc7, 0x5a, 0xe0, 0x06, 0xba, 0x1d,
+	0xae, 0xf1, 0x4b, 0xa9, 0x49, 0x79, 0x77, 0x47, 0x98, 0xb1, 0xca, 0x37, 0x1d, 0x85, 0x98, 0x2e,
+	0xe1, 0x8a, 0x4d, 0xfd, 0x78, 0x8f, 0xae, 0x17, 0xec, 0x7c, 0xf4, 0xa9, 0x04, 0x8b, 0x4d, 0xcb,
+	0xe9, 0x9b, 0xa1, 0x37, 0xdd, 0xb6, 0x13, 0xf8, 0xa5, 0xf4, 0x24, 0x66, 0x77, 0x05, 0xb3, 0x35,
+	0xc1, 0x6c, 0x14, 0x63, 0x3a, 0x6a, 0x0b, 0x4d, 0xcb, 0x11, 0xac, 0x6e, 0xf2, 0xf5, 0x68, 0x07,
+	0x16, 0x5a, 0x7a, 0xdb, 0x27, 0xa6, 0xe6, 0xb6, 0x08, 0x8f, 0xab, 0x5f, 0xca, 0xb0, 0x40, 0x5c,
+	0xee, 0x75, 0xe5, 0x92, 0xa8, 0xc5, 0x61, 0x15, 0x05, 0x17, 0xb9, 0xec, 0x5e, 0x5f, 0x84, 0x3e,
+	0x82, 0xc5, 0x68, 0xc1, 0x88, 0x2c, 0x29, 0xc1, 0xa4, 0x34, 0xba, 0x12, 0xb7, 0x72, 0x0c, 0x06,
+	0xcf, 0xa4, 0x85, 0x41, 0xf1, 0x09, 0xf9, 0x66, 0xfa, 0xc9, 0x73, 0x79, 0xe6, 0x93, 0xe7, 0xf2,
+	0x8c, 0xf2, 0x42, 0x82, 0x7c, 0xbc, 0x0a, 0xd0, 0xb7, 0x21, 0xc7, 0x32, 0xbf, 0xcf, 0x44, 0x9a,
+	0xc4, 0xa4, 0x22, 0x98, 0x2c, 0x45, 0xea, 0x26, 0xce, 0x21, 0x4b, 0x65, 0xa1, 0x3e, 0xba, 0x0b,
+	0x30, 0x28, 0x2b, 0xd6, 0x3e, 0x32, 0x6a, 0x95, 0x62, 0xfc, 0xb5, 0x2b, 0x5f, 0x39, 0x45, 0x54,
+	0xea, 0xc4, 0xc0, 0x11, 0x84, 0xcd, 0x24, 0x35, 0x47, 0xf9, 0x43, 0x1a, 0xd2, 0xaa, 0xee, 0xb3,
+	0x8e, 0x85, 0xf2, 0x90, 0xb0, 0x4c, 0xc6, 0x3c, 0x89, 0x13, 0x96, 0x89, 0x10, 0x24, 0x1d, 0xbd,
+	0x49, 0xf8, 0x66, 0x98, 0xfd, 0x46, 0x5f, 0x86, 0x24, 0xc5, 0x63, 0x5d, 0x27, 0x7f, 0xa3, 0x72,
+	0x52, 0x93, 0xa0, 0x78, 0x7b, 0x9d, 0x16, 0xc1, 0x4c, 0x1b, 0x7d, 0x00, 0x4b, 0x61, 0x57, 0x6a,
+	0xb9, 0xae, 0xad, 0xe9, 0xa6, 0xe9, 0x11, 0x9f, 0xb6, 0x1a, 0x6a, 0x86, 0xdc, 0xeb, 0xca, 0x97,
+	0xe2, 0xbd, 0x2b, 0xaa, 0xa5, 0x60, 0x24, 0xc4, 0xbb, 0xae, 0x6b, 0xdf, 0xe4, 0x42, 0x74, 0x0f,
+	0x16, 0x03, 0x76, 0xbc, 0xf1, 0x5e, 0x1d, 0x22, 0x5e, 0x60, 0x88, 0xe5, 0x41, 0x88, 0xc7, 0x28,
+	0x29, 0x18, 0x45, 0xa4, 0x21, 0xe0, 0x2f, 0x25, 0x58, 0x0a, 0x33, 0x9e, 0x1e, 0x5a, 0xda, 0x63,
+	0x62, 0xed, 0x1f, 0x04, 0xbc, 0x55, 0xcc, 0xdf, 0xb8, 0x3c, 0xb6, 0x74, 0xea, 0xc4, 0x60, 0xd5,
+	0x83, 0x45, 0x34, 0x85, 0x19, 0xe3, 0x70, 0x68, 0xf9, 0x5c, 0x3b, 0x5d, 0xa0, 0x78, 0x05, 0x21,
+	0x81, 0x42, 0x47, 0x0f, 0x39, 0x06, 0xfa, 0x3a, 0x80, 0x1f, 0xe8, 0x5e, 0xa0, 0xd1, 0xa3, 0xb3,
+	0x94, 0x62, 0x49, 0xb6, 0x36, 0x92, 0x64, 0x7b, 0xe1, 0xb9, 0xaa, 0xbe, 0x25, 0x78, 0x2d, 0xf4,
+	0x79, 0x89, 0xb5, 0xca, 0xc7, 0x34, 0xc5, 0x32, 0x4c, 0x40, 0xd5, 0x11, 0x86, 0x34, 0x71, 0x4c,
+	0x8e, 0x9b, 0x9e, 0x88, 0x7b, 0x49, 0xe0, 0x16, 0x38, 0x6e, 0xb8, 0x92, 0xa3, 0xa6, 0x88, 0x63,
+	0x32, 0xcc, 0x32, 0x40, 0xe8, 0x68, 0x62, 0x96, 0x32, 0x15, 0x69, 0x3d, 0x8d, 0x23, 0x12, 0xf4,
+	0x18, 0x56, 0x6c, 0xdd, 0x0f, 0x34, 0xd3, 0xf2, 0x03, 0xcf, 0x6a, 0xb4, 0x59, 0x90, 0x18, 0x03,
+	0x98, 0xc8, 0xe0, 0x9d, 0x41, 0xeb, 0x1e, 0x8f, 0xc1, 0xb9, 0x2c, 0xd1, 0xc9, 0x7a, 0x64, 0x8e,
+	0x11, 0xfb, 0x89, 0x04, 0x0b, 0xfd, 0x05, 0xc4, 0x64, 0x71, 0xf2, 0x4b, 0xf3, 0x93, 0x7a, 0xe4,
+	0x6d, 0x61, 0xb5, 0xe8, 0x54, 0x23, 0x08, 0x53, 0x36, 0xef, 0xc8, 0x7a, 0x26, 0x41, 0xdf, 0x81,
+	0x8b, 0x1e, 0x79, 0xac, 0x7b, 0xa6, 0x76, 0x44, 0xfc, 0x80, 0x9d, 0x42, 0x61, 0x3f, 0xc9, 0x4e,
+	0xea, 0x27, 0x57, 0x05, 0xb7, 0x32, 0xe7, 0x76, 0x02, 0x0e, 0xef, 0x2c, 0xcb, 0x7c, 0xf6, 0x43,
+	0x3e, 0xd9, 0x6f, 0x31, 0x65, 0x00, 0x8f, 0x50, 0x4a, 0x06, 0x0d, 0x57, 0x8e, 0x87, 0x6b, 0x20,
+	0xd9, 0x5c, 0x08, 0x3b, 0xe0, 0x9f, 0x7e, 0x77, 0xfd, 0x02, 0xad, 0xf0, 0x1d, 0xe5, 0x5f, 0x12,
+	0x14, 0xb6, 0xad, 0x63, 0x62, 0xf2, 0x1e, 0xcf, 0xda, 0xc8, 0x43, 0xc8, 0x50, 0xd7, 0xb1, 0x8f,
+	0x29, 0xd1, 0x07, 0x4f, 0xec, 0x13, 0x61, 0xef, 0x51, 0x4b, 0x2f, 0xbb, 0xb2, 0xd4, 0xeb, 0xca,
+	0x45, 0x4e, 0xbf, 0x0f, 0xa0, 0xe0, 0x74, 0x23, 0xec, 0x4f, 0x3f, 0x90, 0x20, 0xcb, 0x9b, 0x35,
+	0x3f, 0x91, 0x4a, 0x89, 0x49, 0x01, 0x7b, 0x5f, 0x38, 0x65, 0x51, 0xa4, 0x69, 0x64, 0xf1, 0x74,
+	0xb1, 0x9a, 0x67, 0x4b, 0xb9, 0x91, 0x91, 0x93, 0xe0, 0xcf, 0x12, 0x64, 0x30, 0x75, 0xde, 0xf9,
+	0x1a, 0x4e, 0x80, 0xef, 0xaf, 0xb1, 0x40, 0x89, 0xe6, 0x5f, 0x9f, 0xae, 0xf9, 0xf7, 0xba, 0x32,
+	0x8a, 0x7a, 0x81, 0x41, 0x29, 0x18, 0xd8, 0x88, 0xd9, 0x10, 0xb1, 0xeb, 0xef, 0xb3, 0x90, 0xad,
+	0x13, 0x43, 0xef, 0xd0, 0xa6, 0xfb, 0xff, 0x10, 0x53, 0xd4, 0x00, 0x30, 0xa9, 0xc1, 0xd4, 0x2f,
+	0x44, 0x7c, 0x53, 0xd7, 0xa6, 0xf6, 0xb0, 0x68, 0xb3, 0x03, 0x24, 0x05, 0x67, 0xd8, 0x00, 0xeb,
+	0x01, 0x41, 0x9b, 0x90, 0xe5, 0x33, 0x6c, 0x63, 0x7e, 0xfa, 0xe5, 0xd4, 0x8b, 0x03, 0x5b, 0xa2,
+	0xb3, 0x0a, 0x9e, 0x67, 0x43, 0xf6, 0x11, 0xe2, 0xa3, 0x6d, 0x28, 0xd2, 0x0f, 0x51, 0x83, 0xf6,
+	0xcd, 0x70, 0x3d, 0x3d, 0xeb, 0x92, 0xea, 0xa5, 0xc1, 0x57, 0xf1, 0xb0, 0x86, 0x82, 0x0b, 0x7d,
+	0x11, 0xc7, 0x89, 0xc4, 0xf8, 0xe7, 0x09, 0xc8, 0xde, 0x37, 0x0e, 0x88, 0xd9, 0xb6, 0xc9, 0xf9,
+	0xc6, 0xb8, 0x06, 0x73, 0xad, 0x03, 0xdd, 0x27, 0xbe, 0x08, 0xee, 0x3b, 0x27, 0xa1, 0xf6, 0xe9,
+	0x50, 0x6d, 0x35, 0x49, 0xdd, 0x8f, 0xc5, 0x52, 0x64, 0x42, 0xce, 0x68, 0x7b, 0x1e, 0x71, 0x02,
+	0x8d, 0x49, 0x58, 0x8c, 0x4e, 0x8d, 0x55, 0x1a, 0x7c, 0x69, 0xc5, 0x50, 0x14, 0x9c, 0x15, 0x63,
+	0xa6, 0x17, 0x71, 0xcf, 0x1f, 0x13, 0x90, 0x8b, 0x61, 0x0c, 0x9d, 0xbd, 0xd2, 0x39, 0x9d, 0xbd,
+	0x89, 0x33, 0x3a, 0x7b, 0x47, 0x0a, 0x6b, 0xf6, 0x3f, 0xd3, 0x2c, 0xf9, 0x77, 0xe6, 0x0f, 0x13,
+	0x90, 0x12, 0xb7, 0x01, 0xb4, 0x0d, 0x73, 0x82, 0x92, 0x34, 0xf5, 0x57, 0xec, 0x8e, 0x13, 0x60,
+	0xb1, 0x1a, 0x7d, 0x05, 0xf2, 0xcc, 0x85, 0xf4, 0x7c, 0x63, 0x3b, 0x32, 0xdf, 0x25, 0xd5, 0xd5,
+	0x5e, 0x57, 0x5e, 0x8e, 0xf8, 0xbc, 0x3f, 0xaf, 0xe0, 0x5c, 0x28, 0x60, 0xd5, 0x80, 0x0e, 0x20,
+	0xdb, 0x70, 0x5d, 0x3f, 0x18, 0xb8, 0x88, 0xf2, 0xd9, 0x9a, 0x8e, 0xcf, 0xc0, 0x63, 0x51, 0x2c,
+	0x05, 0xcf, 0xb3, 0xe1, 0xc8, 0x91, 0xf1, 0xa9, 0x04, 0xb9, 0x0f, 0xda, 0xa4, 0x4d, 0xcc, 0xb3,
+	0xf6, 0xc7, 0x26, 0x64, 0x3f, 0x62, 0xc0, 0x31, 0x6f, 0x44, 0xda, 0x4b, 0x74, 0x56, 0xc1, 0xf3,
+	0x7c, 0xc8, 0x3c, 0x21, 0xa2, 0xf4, 0x5c, 0x82, 0xa5, 0x18, 0x37, 0xbf, 0xd6, 0xf6, 0x7c, 0xd7,
+	0x43, 0x55, 0x48, 0xb3, 0xab, 0xd2, 0x21, 0xe9, 0x30, 0x92, 0x59, 0x75, 0x71, 0x90, 0x80, 0xe1,
+	0x8c, 0x82, 0x53, 0xf4, 0xe7, 0xd7, 0x48, 0x87, 0x96, 0x0a, 0x4f, 0x9f, 0x53, 0xa6, 0xf4, 0x50,
+	0xa9, 0x0c, 0xd6, 0x8a, 0x52, 0x61, 0x02, 0xaa, 0xae, 0xfc, 0x3e, 0x01, 0x39, 0x7a, 0xf7, 0x3a,
+	0x7b, 0xf7, 0x0d, 0x27, 0x43, 0xe2, 0xbc, 0x92, 0x21, 0x56, 0xee, 0xb3, 0x67, 0x54, 0xee, 0x25,
+	0x48, 0xb1, 0x2d, 0x88, 0xc9, 0x8e, 0x95, 0x34, 0x0e, 0x87, 0x22, 0xb4, 0xdf, 0x85, 0xe2, 0x03,
+	0xa7, 0xe1, 0x3a, 0xa6, 0xe5, 0xec, 0x87, 0x9e, 0x5b, 0x81, 0x39, 0xda, 0x35, 0x89, 0xc7, 0x3d,
+	0x87, 0xc5, 0x08, 0xdd, 0x81, 0x14, 0x71, 0x02, 0xcf, 0xea, 0x37, 0xec, 0xeb, 0x27, 0x35, 0xd9,
+	0x61, 0xc8, 0x2d, 0x27, 0xf0, 0x3a, 0xa2, 0x71, 0x87, 0x18, 0x82, 0xc0, 0x6f, 0x12, 0xb0, 0x3c,
+	0x56, 0x1d, 0xd5, 0xa0, 0xd0, 0x7f, 0x72, 0x3b, 0x60, 0xf7, 0x1c, 0xc6, 0x67, 0x36, 0xfa, 0x3a,
+	0x36, 0xa4, 0xa0, 0xe0, 0x7c, 0x28, 0xb9, 0xc5, 0x04, 0x68, 0x1f, 0x0a, 0x86, 0xdb, 0x6c, 0xd9,
+	0x64, 0x70, 0x87, 0x98, 0x9c, 0x76, 0x8a, 0x70, 0x6d, 0xb8, 0x49, 0x1c, 0x80, 0x7b, 0x38, 0x3f,
+	0x90, 0x32, 0x47, 0x13, 0x48, 0x35, 0x74, 0x5b, 0x77, 0x0c, 0x32, 0xb9, 0xa3, 0x7e, 0x89, 0xe2,
+	0x4f, 0xd5, 0x3a, 0x43, 0x6c, 0xe1, 0xb4, 0x6f, 0x41, 0x2e, 0xfe, 0xbc, 0x73, 0x46, 0xc9, 0x2e,
+	0xe0, 0xff, 0x71, 0x01, 0x16, 0x6e, 0x59, 0x7e, 0xe0, 0x7a, 0x96, 0xa1, 0xdb, 0x98, 0x5d, 0x0a,
+	0x7c, 0xf4, 0x6b, 0x09, 0x2e, 0x1a, 0xed, 0x66, 0xdb, 0xd6, 0x03, 0xeb, 0x88, 0x68, 0x6d, 0xc7,
+	0x0a, 0x34, 0x7e, 0x61, 0xf0, 0x4b, 0xd2, 0x29, 0xee, 0xc2, 0x0f, 0xe2, 0x37, 0x91, 0x13, 0xa0,
+	0xa6, 0xbe, 0x0e, 0x2f, 0x0f, 0x80, 0x1e, 0x38, 0x56, 0x10, 0xb2, 0xfd, 0x85, 0x04, 0x72, 0x64,
+	0x8b, 0xf0, 0xc2, 0x13, 0x63, 0xcd, 0xb3, 0xf8, 0xea, 0x49, 0x59, 0x2c, 0xee, 0x41, 0x11, 0x54,
+	0xee, 0xd7, 0x5e, 0x57, 0xbe, 0x32, 0x62, 0xc3, 0xb8, 0x0d, 0x14, 0x7c, 0x79, 0xa0, 0x31, 0x8a,
+	0x86, 0x7e, 0x2a, 0x41, 0x44, 0x81, 0xbf, 0x32, 0xc7, 0x08, 0xf2, 0x4c, 0xfa, 0xe2, 0x9b, 0x5e,
+	0x53, 0xa2, 0xec, 0xae, 0x09, 0x76, 0x6f, 0x8f, 0xb0, 0x1b, 0x81, 0x56, 0xf0, 0xea, 0x60, 0x7a,
+	0x08, 0x87, 0x56, 0x9e, 0x47, 0x1e, 0x11, 0x8f, 0x38, 0x06, 0x7d, 0x2a, 0xa6, 0x69, 0x95, 0x1c,
+	0x7e, 0x97, 0x1e, 0x52, 0x50, 0x70, 0xbe, 0x2f, 0xa9, 0xb1, 0x6e, 0xf6, 0x2c, 0x1e, 0x80, 0xe8,
+	0xe6, 0x5a, 0xa3, 0xc3, 0xbf, 0x26, 0x2f, 0x4c, 0x67, 0xdf, 0xc9, 0xde, 0x1f, 0x87, 0xae, 0xe0,
+	0x4b, 0x63, 0x53, 0x43, 0xed, 0x50, 0x5c, 0x91, 0xea, 0x3d, 0x09, 0x0a, 0xc3, 0xe6, 0x5f, 0x83,
+	0x14, 0xf3, 0x57, 0xf8, 0xe8, 0xa5, 0xa2, 0x5e, 0x57, 0xce, 0xf3, 0x3d, 0xc5, 0x84, 0x82, 0xe7,
+	0xe8, 0xaf, 0x1d, 0xf3, 0x8d, 0x55, 0x91, 0xf8, 0x6f, 0xab, 0x0a, 0x61, 0xf4, 0x6f, 0x13, 0x80,
+	0xc6, 0xa4, 0xa3, 0x05, 0xc5, 0x91, 0xf7, 0x05, 0x69, 0xca, 0x07, 0xf8, 0xf1, 0x0f, 0x0b, 0x85,
+	0xa3, 0xa1, 0x27, 0x85, 0xff, 0x45, 0xaf, 0xfd, 0x73, 0x16, 0x72, 0x38, 0xfa, 0x40, 0x72, 0x8e,
+	0x5f, 0xfe, 0xe3, 0x42, 0x91, 0x38, 0x9f, 0x50, 0x3c, 0x91, 0x20, 0xc7, 0xff, 0xd7, 0x10, 0xef,
+	0x3a, 0x6f, 0x38, 0xbf, 0x6e, 0xc5, 0xdf, 0xa8, 0x63, 0xab, 0xa7, 0xbb, 0x12, 0x64, 0xd9, 0xda,
+	0x30, 0x01, 0x7f, 0x24, 0x41, 0xc1, 0xb0, 0x75, 0xab, 0x49, 0xcc, 0x3e, 0x99, 0xe4, 0x24, 0x32,
+	0x5f, 0x1d, 0x3a, 0xac, 0xe3, 0xeb, 0xa7, 0xa3, 0x93, 0x17, 0xab, 0xe3, 0x81, 0xff, 0x9e, 0x04,
+	0xe8, 0x5e, 0x3b, 0xf0, 0x03, 0x9d, 0x7d, 0xa4, 0x84, 0x6c, 0x0f, 0x21, 0x35, 0xcd, 0xf1, 0xf7,
+	0x9e, 0x38, 0xf4, 0xa7, 0x4a, 0xc8, 0x70, 0x07, 0xe5, 0x18, 0xe6, 0xc5, 0xbe, 0xf5, 0xb6, 0x1f,
+	0x20, 0x2b, 0x72, 0xde, 0x9f, 0xd3, 0xd6, 0x62, 0x83, 0xab, 0x3f, 0x96, 0x20, 0x1d, 0x3e, 0xdb,
+	0xa3, 0xab, 0xb0, 0xbc, 0x7b, 0xfb, 0xe6, 0x5d, 0x6d, 0xef, 0x1b, 0xbb, 0x5b, 0xda, 0x83, 0xbb,
+	0xf7, 0x77, 0xb7, 0x6a, 0x3b, 0xdb, 0x3b, 0x5b, 0xf5, 0xe2, 0xcc, 0x5a, 0xe1, 0xe9, 0xb3, 0xca,
+	0x7c, 0xa8, 0x78, 0xd7, 0xb2, 0xd1, 0x3a, 0x14, 0x07, 0xba, 0xbb, 0x0f, 0xd4, 0xdb, 0x3b, 0xb5,
+	0xa2, 0xb4, 0x86, 0x9e, 0x3e, 0xab, 0xe4, 0x43, 0xb5, 0xdd, 0x76, 0xc3, 0xb6, 0x0c, 0x74, 0x15,
+	0x16, 0x22, 0x9a, 0x78, 0xe7, 0xc3, 0x9b, 0x7b, 0x5b, 0xc5, 0xc4, 0xda, 0xe2, 0xd3, 0x67, 0x95,
+	0x42, 0x5f, 0x95, 0xff, 0x0b, 0x75, 0x2d, 0xf9, 0xe4, 0x57, 0xe5, 0x19, 0xf5, 0xfd, 0x17, 0xaf,
+	0xca, 0xd2, 0xcb, 0x57, 0x65, 0xe9, 0x6f, 0xaf, 0xca, 0xd2, 0xc7, 0xaf, 0xcb, 0x33, 0x2f, 0x5f,
+	0x97, 0x67, 0xfe, 0xf2, 0xba, 0x3c, 0xf3, 0xcd, 0xeb, 0x11, 0x1b, 0xc7, 0xfc, 0xab, 0xfb, 0xb8,
+	0xff, 0x8b, 0x99, 0xdb, 0x98, 0x63, 0x65, 0xf4, 0xde, 0xbf, 0x07, 0x00, 0x2a, 0xa7, 0xd8, 0x5f,
+	0x17, 0x1f, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
@@ -560,6 +1214,82 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.NextEpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.NextEpochDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFarming(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	if len(m.PausedOperations) > 0 {
+		for iNdEx := len(m.PausedOperations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedOperations[iNdEx])
+			copy(dAtA[i:], m.PausedOperations[iNdEx])
+			i = encodeVarintFarming(dAtA, i, uint64(len(m.PausedOperations[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.MinStakingAmounts) > 0 {
+		for iNdEx := len(m.MinStakingAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinStakingAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MaxTotalStakings) > 0 {
+		for iNdEx := len(m.MaxTotalStakings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxTotalStakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AllowedStakingDenoms) > 0 {
+		for iNdEx := len(m.AllowedStakingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedStakingDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedStakingDenoms[iNdEx])
+			i = encodeVarintFarming(dAtA, i, uint64(len(m.AllowedStakingDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnstakingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnstakingPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintFarming(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.LockMultipliers) > 0 {
+		for iNdEx := len(m.LockMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.FarmingFeeCollector) > 0 {
 		i -= len(m.FarmingFeeCollector)
 		copy(dAtA[i:], m.FarmingFeeCollector)
@@ -589,6 +1319,47 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LockMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintFarming(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *BasePlan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -609,6 +1380,24 @@ func (m *BasePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardVestingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardVestingDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintFarming(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x62
 	if len(m.DistributedCoins) > 0 {
 		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -624,12 +1413,12 @@ func (m *BasePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.LastDistributionTime != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDistributionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDistributionTime):])
-		if err1 != nil {
-			return 0, err1
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDistributionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDistributionTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n1
-		i = encodeVarintFarming(dAtA, i, uint64(n1))
+		i -= n5
+		i = encodeVarintFarming(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -643,20 +1432,20 @@ func (m *BasePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x48
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err2 != nil {
-		return 0, err2
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n2
-	i = encodeVarintFarming(dAtA, i, uint64(n2))
+	i -= n6
+	i = encodeVarintFarming(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x42
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err3 != nil {
-		return 0, err3
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n3
-	i = encodeVarintFarming(dAtA, i, uint64(n3))
+	i -= n7
+	i = encodeVarintFarming(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x3a
 	if len(m.StakingCoinWeights) > 0 {
@@ -801,7 +1590,7 @@ func (m *RatioPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Staking) Marshal() (dAtA []byte, err error) {
+func (m *DecayingPlan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -811,35 +1600,66 @@ func (m *Staking) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Staking) MarshalTo(dAtA []byte) (int, error) {
+func (m *DecayingPlan) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Staking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DecayingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.StartingEpoch != 0 {
-		i = encodeVarintFarming(dAtA, i, uint64(m.StartingEpoch))
+	if m.AllocatedEpochs != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.AllocatedEpochs))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x28
+	}
+	if m.DecayEpochs != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.DecayEpochs))
+		i--
+		dAtA[i] = 0x20
 	}
 	{
-		size := m.Amount.Size()
+		size := m.DecayRate.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.DecayRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintFarming(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x1a
+	if len(m.EpochAmount) > 0 {
+		for iNdEx := len(m.EpochAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BasePlan != nil {
+		{
+			size, err := m.BasePlan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFarming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueuedStaking) Marshal() (dAtA []byte, err error) {
+func (m *SchedulePlan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -849,19 +1669,148 @@ func (m *QueuedStaking) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueuedStaking) MarshalTo(dAtA []byte) (int, error) {
+func (m *SchedulePlan) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueuedStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SchedulePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
+	if m.CurrentPhase != nil {
+		{
+			size, err := m.CurrentPhase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFarming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Phases) > 0 {
+		for iNdEx := len(m.Phases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Phases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BasePlan != nil {
+		{
+			size, err := m.BasePlan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFarming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulePhase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulePhase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulePhase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochAmount) > 0 {
+		for iNdEx := len(m.EpochAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintFarming(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintFarming(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Staking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Staking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Staking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BoostAmount.Size()
+		i -= size
+		if _, err := m.BoostAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.StartingEpoch != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.StartingEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
 		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
@@ -872,7 +1821,7 @@ func (m *QueuedStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TotalStakings) Marshal() (dAtA []byte, err error) {
+func (m *QueuedStaking) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -882,16 +1831,21 @@ func (m *TotalStakings) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TotalStakings) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueuedStaking) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalStakings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueuedStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.QueuedEpoch != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.QueuedEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -905,7 +1859,7 @@ func (m *TotalStakings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *HistoricalRewards) Marshal() (dAtA []byte, err error) {
+func (m *QueuedStakingsCursor) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -915,20 +1869,119 @@ func (m *HistoricalRewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HistoricalRewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueuedStakingsCursor) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueuedStakingsCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CumulativeUnitRewards) > 0 {
-		for iNdEx := len(m.CumulativeUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EpochTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintFarming(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x12
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintFarming(dAtA, i, uint64(len(m.NextKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockedStaking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockedStaking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Boosted {
+		i--
+		if m.Boosted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintFarming(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BoostAmount.Size()
+		i -= size
+		if _, err := m.BoostAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingStaking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingStaking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CumulativeUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -936,13 +1989,20 @@ func (m *HistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintFarming(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintFarming(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OutstandingRewards) Marshal() (dAtA []byte, err error) {
+func (m *UnbondingStakingEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -952,20 +2012,20 @@ func (m *OutstandingRewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OutstandingRewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnbondingStakingEntry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OutstandingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnbondingStakingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -973,202 +2033,2860 @@ func (m *OutstandingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintFarming(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
 		}
 	}
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintFarming(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x12
+	if m.CreationHeight != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFarming(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFarming(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TotalStakings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TotalStakings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TotalStakings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PrivatePlanCreationFee) > 0 {
-		for _, e := range m.PrivatePlanCreationFee {
-			l = e.Size()
-			n += 1 + l + sovFarming(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
 	}
-	if m.NextEpochDays != 0 {
-		n += 1 + sovFarming(uint64(m.NextEpochDays))
-	}
-	l = len(m.FarmingFeeCollector)
-	if l > 0 {
-		n += 1 + l + sovFarming(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *BasePlan) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HistoricalRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovFarming(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovFarming(uint64(l))
-	}
-	if m.Type != 0 {
-		n += 1 + sovFarming(uint64(m.Type))
-	}
-	l = len(m.FarmingPoolAddress)
-	if l > 0 {
-		n += 1 + l + sovFarming(uint64(l))
+	if len(m.CumulativeUnitRewardsByPlan) > 0 {
+		for iNdEx := len(m.CumulativeUnitRewardsByPlan) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeUnitRewardsByPlan[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.TerminationAddress)
-	if l > 0 {
-		n += 1 + l + sovFarming(uint64(l))
+	if m.ReferenceCount != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.ReferenceCount))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.StakingCoinWeights) > 0 {
-		for _, e := range m.StakingCoinWeights {
-			l = e.Size()
-			n += 1 + l + sovFarming(uint64(l))
+	if len(m.CumulativePlanUnitRewards) > 0 {
+		for iNdEx := len(m.CumulativePlanUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativePlanUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovFarming(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovFarming(uint64(l))
-	if m.Terminated {
-		n += 2
-	}
-	if m.LastDistributionTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDistributionTime)
-		n += 1 + l + sovFarming(uint64(l))
+	if len(m.CumulativeVestingUnitRewards) > 0 {
+		for iNdEx := len(m.CumulativeVestingUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeVestingUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.DistributedCoins) > 0 {
-		for _, e := range m.DistributedCoins {
-			l = e.Size()
-			n += 1 + l + sovFarming(uint64(l))
+	if len(m.CumulativeUnitRewards) > 0 {
+		for iNdEx := len(m.CumulativeUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FixedAmountPlan) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PlanUnitRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanUnitRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanUnitRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BasePlan != nil {
-		l = m.BasePlan.Size()
-		n += 1 + l + sovFarming(uint64(l))
-	}
-	if len(m.EpochAmount) > 0 {
-		for _, e := range m.EpochAmount {
-			l = e.Size()
-			n += 1 + l + sovFarming(uint64(l))
+	if len(m.CumulativeUnitRewards) > 0 {
+		for iNdEx := len(m.CumulativeUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if m.PlanId != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RatioPlan) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BasePlan != nil {
-		l = m.BasePlan.Size()
-		n += 1 + l + sovFarming(uint64(l))
+func (m *VestingUnitRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.EpochRatio.Size()
-	n += 1 + l + sovFarming(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Staking) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VestingUnitRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingUnitRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovFarming(uint64(l))
-	if m.StartingEpoch != 0 {
-		n += 1 + sovFarming(uint64(m.StartingEpoch))
+	if len(m.CumulativeUnitRewards) > 0 {
+		for iNdEx := len(m.CumulativeUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintFarming(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueuedStaking) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RewardVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovFarming(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TotalStakings) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovFarming(uint64(l))
-	return n
+func (m *RewardVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HistoricalRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RewardVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CumulativeUnitRewards) > 0 {
-		for _, e := range m.CumulativeUnitRewards {
-			l = e.Size()
-			n += 1 + l + sovFarming(uint64(l))
+	if len(m.ClaimedRewards) > 0 {
+		for iNdEx := len(m.ClaimedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TotalRewards) > 0 {
+		for iNdEx := len(m.TotalRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n19, err19 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintFarming(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x12
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintFarming(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OutstandingRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutstandingRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutstandingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardsDust) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsDust) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsDust) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFarming(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFarming(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PrivatePlanCreationFee) > 0 {
+		for _, e := range m.PrivatePlanCreationFee {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	if m.NextEpochDays != 0 {
+		n += 1 + sovFarming(uint64(m.NextEpochDays))
+	}
+	l = len(m.FarmingFeeCollector)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if len(m.LockMultipliers) > 0 {
+		for _, e := range m.LockMultipliers {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnstakingPeriod)
+	n += 1 + l + sovFarming(uint64(l))
+	if len(m.AllowedStakingDenoms) > 0 {
+		for _, s := range m.AllowedStakingDenoms {
+			l = len(s)
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	if len(m.MaxTotalStakings) > 0 {
+		for _, e := range m.MaxTotalStakings {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	if len(m.MinStakingAmounts) > 0 {
+		for _, e := range m.MinStakingAmounts {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	if len(m.PausedOperations) > 0 {
+		for _, s := range m.PausedOperations {
+			l = len(s)
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.NextEpochDuration)
+	n += 1 + l + sovFarming(uint64(l))
+	return n
+}
+
+func (m *LockMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovFarming(uint64(l))
+	l = m.Multiplier.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	return n
+}
+
+func (m *BasePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFarming(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovFarming(uint64(m.Type))
+	}
+	l = len(m.FarmingPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	l = len(m.TerminationAddress)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for _, e := range m.StakingCoinWeights {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovFarming(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovFarming(uint64(l))
+	if m.Terminated {
+		n += 2
+	}
+	if m.LastDistributionTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDistributionTime)
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if len(m.DistributedCoins) > 0 {
+		for _, e := range m.DistributedCoins {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardVestingDuration)
+	n += 1 + l + sovFarming(uint64(l))
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *FixedAmountPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BasePlan != nil {
+		l = m.BasePlan.Size()
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if len(m.EpochAmount) > 0 {
+		for _, e := range m.EpochAmount {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RatioPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BasePlan != nil {
+		l = m.BasePlan.Size()
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	l = m.EpochRatio.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	return n
+}
+
+func (m *DecayingPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BasePlan != nil {
+		l = m.BasePlan.Size()
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if len(m.EpochAmount) > 0 {
+		for _, e := range m.EpochAmount {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	l = m.DecayRate.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	if m.DecayEpochs != 0 {
+		n += 1 + sovFarming(uint64(m.DecayEpochs))
+	}
+	if m.AllocatedEpochs != 0 {
+		n += 1 + sovFarming(uint64(m.AllocatedEpochs))
+	}
+	return n
+}
+
+func (m *SchedulePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BasePlan != nil {
+		l = m.BasePlan.Size()
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if len(m.Phases) > 0 {
+		for _, e := range m.Phases {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	if m.CurrentPhase != nil {
+		l = m.CurrentPhase.Size()
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulePhase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovFarming(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovFarming(uint64(l))
+	if len(m.EpochAmount) > 0 {
+		for _, e := range m.EpochAmount {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Staking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	if m.StartingEpoch != 0 {
+		n += 1 + sovFarming(uint64(m.StartingEpoch))
+	}
+	l = m.BoostAmount.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	return n
+}
+
+func (m *QueuedStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	if m.QueuedEpoch != 0 {
+		n += 1 + sovFarming(uint64(m.QueuedEpoch))
+	}
+	return n
+}
+
+func (m *QueuedStakingsCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EpochTime)
+	n += 1 + l + sovFarming(uint64(l))
+	return n
+}
+
+func (m *LockedStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	l = m.BoostAmount.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovFarming(uint64(l))
+	if m.Boosted {
+		n += 2
+	}
+	return n
+}
+
+func (m *UnbondingStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnbondingStakingEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		n += 1 + sovFarming(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovFarming(uint64(l))
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TotalStakings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	return n
+}
+
+func (m *HistoricalRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CumulativeUnitRewards) > 0 {
+		for _, e := range m.CumulativeUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	if len(m.CumulativeVestingUnitRewards) > 0 {
+		for _, e := range m.CumulativeVestingUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	if len(m.CumulativePlanUnitRewards) > 0 {
+		for _, e := range m.CumulativePlanUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	if m.ReferenceCount != 0 {
+		n += 1 + sovFarming(uint64(m.ReferenceCount))
+	}
+	if len(m.CumulativeUnitRewardsByPlan) > 0 {
+		for _, e := range m.CumulativeUnitRewardsByPlan {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PlanUnitRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovFarming(uint64(m.PlanId))
+	}
+	if len(m.CumulativeUnitRewards) > 0 {
+		for _, e := range m.CumulativeUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VestingUnitRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration)
+	n += 1 + l + sovFarming(uint64(l))
+	if len(m.CumulativeUnitRewards) > 0 {
+		for _, e := range m.CumulativeUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovFarming(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration)
+	n += 1 + l + sovFarming(uint64(l))
+	if len(m.TotalRewards) > 0 {
+		for _, e := range m.TotalRewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	if len(m.ClaimedRewards) > 0 {
+		for _, e := range m.ClaimedRewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OutstandingRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardsDust) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFarming(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFarming(x uint64) (n int) {
+	return sovFarming(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivatePlanCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivatePlanCreationFee = append(m.PrivatePlanCreationFee, types.Coin{})
+			if err := m.PrivatePlanCreationFee[len(m.PrivatePlanCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochDays", wireType)
+			}
+			m.NextEpochDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEpochDays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingFeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingFeeCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockMultipliers = append(m.LockMultipliers, LockMultiplier{})
+			if err := m.LockMultipliers[len(m.LockMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnstakingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedStakingDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedStakingDenoms = append(m.AllowedStakingDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalStakings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxTotalStakings = append(m.MaxTotalStakings, types.Coin{})
+			if err := m.MaxTotalStakings[len(m.MaxTotalStakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStakingAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinStakingAmounts = append(m.MinStakingAmounts, types.Coin{})
+			if err := m.MinStakingAmounts[len(m.MinStakingAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedOperations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedOperations = append(m.PausedOperations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.NextEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PlanType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TerminationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
+			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Terminated = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastDistributionTime == nil {
+				m.LastDistributionTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDistributionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedCoins = append(m.DistributedCoins, types.Coin{})
+			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardVestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FixedAmountPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FixedAmountPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FixedAmountPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasePlan == nil {
+				m.BasePlan = &BasePlan{}
+			}
+			if err := m.BasePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochAmount = append(m.EpochAmount, types.Coin{})
+			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RatioPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RatioPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RatioPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasePlan == nil {
+				m.BasePlan = &BasePlan{}
+			}
+			if err := m.BasePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecayingPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecayingPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecayingPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasePlan == nil {
+				m.BasePlan = &BasePlan{}
+			}
+			if err := m.BasePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochAmount = append(m.EpochAmount, types.Coin{})
+			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayEpochs", wireType)
+			}
+			m.DecayEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedEpochs", wireType)
+			}
+			m.AllocatedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllocatedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasePlan == nil {
+				m.BasePlan = &BasePlan{}
+			}
+			if err := m.BasePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phases = append(m.Phases, SchedulePhase{})
+			if err := m.Phases[len(m.Phases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPhase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentPhase == nil {
+				m.CurrentPhase = &SchedulePhase{}
+			}
+			if err := m.CurrentPhase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulePhase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulePhase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulePhase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochAmount = append(m.EpochAmount, types.Coin{})
+			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Staking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Staking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Staking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingEpoch", wireType)
+			}
+			m.StartingEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoostAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BoostAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedStaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedStaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedEpoch", wireType)
+			}
+			m.QueuedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *OutstandingRewards) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovFarming(uint64(l))
+	return nil
+}
+func (m *QueuedStakingsCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedStakingsCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedStakingsCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EpochTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovFarming(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFarming(x uint64) (n int) {
-	return sovFarming(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *LockedStaking) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1191,15 +4909,83 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: LockedStaking: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LockedStaking: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivatePlanCreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoostAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BoostAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1226,16 +5012,85 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrivatePlanCreationFee = append(m.PrivatePlanCreationFee, types.Coin{})
-			if err := m.PrivatePlanCreationFee[len(m.PrivatePlanCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochDays", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Boosted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Boosted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingStaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.NextEpochDays = 0
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingStaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1245,16 +5100,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextEpochDays |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FarmingFeeCollector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1264,23 +5132,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FarmingFeeCollector = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, UnbondingStakingEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1303,7 +5173,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BasePlan) Unmarshal(dAtA []byte) error {
+func (m *UnbondingStakingEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1326,17 +5196,17 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BasePlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingStakingEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BasePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingStakingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
 			}
-			m.Id = 0
+			m.CreationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1346,16 +5216,16 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.CreationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1365,48 +5235,30 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFarming
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= PlanType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1416,27 +5268,79 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalStakings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalStakings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalStakings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TerminationAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1464,45 +5368,63 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TerminationAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFarming
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFarming
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
-			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeUnitRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1529,13 +5451,14 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.CumulativeUnitRewards = append(m.CumulativeUnitRewards, types.DecCoin{})
+			if err := m.CumulativeUnitRewards[len(m.CumulativeUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeVestingUnitRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1559,36 +5482,17 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFarming
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Terminated = bool(v != 0)
-		case 10:
+			m.CumulativeVestingUnitRewards = append(m.CumulativeVestingUnitRewards, VestingUnitRewards{})
+			if err := m.CumulativeVestingUnitRewards[len(m.CumulativeVestingUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePlanUnitRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1615,16 +5519,33 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastDistributionTime == nil {
-				m.LastDistributionTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDistributionTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.CumulativePlanUnitRewards = append(m.CumulativePlanUnitRewards, PlanUnitRewards{})
+			if err := m.CumulativePlanUnitRewards[len(m.CumulativePlanUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+			}
+			m.ReferenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeUnitRewardsByPlan", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1651,8 +5572,8 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributedCoins = append(m.DistributedCoins, types.Coin{})
-			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CumulativeUnitRewardsByPlan = append(m.CumulativeUnitRewardsByPlan, PlanUnitRewards{})
+			if err := m.CumulativeUnitRewardsByPlan[len(m.CumulativeUnitRewardsByPlan)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1677,7 +5598,7 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FixedAmountPlan) Unmarshal(dAtA []byte) error {
+func (m *PlanUnitRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1700,17 +5621,17 @@ func (m *FixedAmountPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FixedAmountPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlanUnitRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FixedAmountPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlanUnitRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasePlan", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var msglen int
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1720,31 +5641,14 @@ func (m *FixedAmountPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFarming
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFarming
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BasePlan == nil {
-				m.BasePlan = &BasePlan{}
-			}
-			if err := m.BasePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeUnitRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1771,8 +5675,8 @@ func (m *FixedAmountPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochAmount = append(m.EpochAmount, types.Coin{})
-			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CumulativeUnitRewards = append(m.CumulativeUnitRewards, types.DecCoin{})
+			if err := m.CumulativeUnitRewards[len(m.CumulativeUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1797,7 +5701,7 @@ func (m *FixedAmountPlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RatioPlan) Unmarshal(dAtA []byte) error {
+func (m *VestingUnitRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1820,15 +5724,15 @@ func (m *RatioPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RatioPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingUnitRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RatioPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingUnitRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasePlan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1855,18 +5759,15 @@ func (m *RatioPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BasePlan == nil {
-				m.BasePlan = &BasePlan{}
-			}
-			if err := m.BasePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeUnitRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1876,23 +5777,23 @@ func (m *RatioPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CumulativeUnitRewards = append(m.CumulativeUnitRewards, types.DecCoin{})
+			if err := m.CumulativeUnitRewards[len(m.CumulativeUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1917,7 +5818,7 @@ func (m *RatioPlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Staking) Unmarshal(dAtA []byte) error {
+func (m *RewardVesting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1940,17 +5841,17 @@ func (m *Staking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Staking: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardVesting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Staking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardVesting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1960,31 +5861,30 @@ func (m *Staking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
 			}
-			m.StartingEpoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1994,66 +5894,30 @@ func (m *Staking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartingEpoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFarming(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueuedStaking) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFarming
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueuedStaking: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueuedStaking: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -2063,81 +5927,31 @@ func (m *QueuedStaking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalRewards = append(m.TotalRewards, types.Coin{})
+			if err := m.TotalRewards[len(m.TotalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFarming(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthFarming
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TotalStakings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFarming
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TotalStakings: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalStakings: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -2147,23 +5961,23 @@ func (m *TotalStakings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimedRewards = append(m.ClaimedRewards, types.Coin{})
+			if err := m.ClaimedRewards[len(m.ClaimedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2188,7 +6002,7 @@ func (m *TotalStakings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistoricalRewards) Unmarshal(dAtA []byte) error {
+func (m *OutstandingRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2211,15 +6025,15 @@ func (m *HistoricalRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricalRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutstandingRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeUnitRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2246,8 +6060,8 @@ func (m *HistoricalRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CumulativeUnitRewards = append(m.CumulativeUnitRewards, types.DecCoin{})
-			if err := m.CumulativeUnitRewards[len(m.CumulativeUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2272,7 +6086,7 @@ func (m *HistoricalRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OutstandingRewards) Unmarshal(dAtA []byte) error {
+func (m *RewardsDust) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2295,15 +6109,15 @@ func (m *OutstandingRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OutstandingRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardsDust: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardsDust: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2330,8 +6144,8 @@ func (m *OutstandingRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.DecCoin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
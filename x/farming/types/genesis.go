@@ -12,20 +12,37 @@ func NewGenesisState(
 	params Params, plans []PlanRecord, stakings []StakingRecord, queuedStakings []QueuedStakingRecord,
 	historicalRewards []HistoricalRewardsRecord, outstandingRewards []OutstandingRewardsRecord,
 	currentEpochs []CurrentEpochRecord, stakingReserveCoins, rewardPoolCoins sdk.Coins,
-	lastEpochTime *time.Time, currentEpochDays uint32,
+	lastEpochTime *time.Time, currentEpochDuration time.Duration,
+	rewardVestings []RewardVestingRecord, vestingRewardsCoins sdk.Coins,
+	lockedStakings []LockedStakingRecord, planFarmers []PlanFarmerRecord,
+	unbondingStakings []UnbondingStaking, rewardWithdrawAddrs []RewardWithdrawAddressRecord,
+	receiptStakings []ReceiptStakingRecord, unclaimedReceiptStakings []UnclaimedReceiptStakingRecord,
+	autoCompoundFarmers []string, rewardsDust sdk.DecCoins, epochNumber uint64,
 ) *GenesisState {
 	return &GenesisState{
-		Params:                    params,
-		PlanRecords:               plans,
-		StakingRecords:            stakings,
-		QueuedStakingRecords:      queuedStakings,
-		HistoricalRewardsRecords:  historicalRewards,
-		OutstandingRewardsRecords: outstandingRewards,
-		CurrentEpochRecords:       currentEpochs,
-		StakingReserveCoins:       stakingReserveCoins,
-		RewardPoolCoins:           rewardPoolCoins,
-		LastEpochTime:             lastEpochTime,
-		CurrentEpochDays:          currentEpochDays,
+		Params:                         params,
+		PlanRecords:                    plans,
+		StakingRecords:                 stakings,
+		QueuedStakingRecords:           queuedStakings,
+		HistoricalRewardsRecords:       historicalRewards,
+		OutstandingRewardsRecords:      outstandingRewards,
+		CurrentEpochRecords:            currentEpochs,
+		StakingReserveCoins:            stakingReserveCoins,
+		RewardPoolCoins:                rewardPoolCoins,
+		LastEpochTime:                  lastEpochTime,
+		CurrentEpochDays:               uint32(currentEpochDuration / (24 * time.Hour)),
+		CurrentEpochDuration:           currentEpochDuration,
+		RewardVestingRecords:           rewardVestings,
+		VestingRewardsCoins:            vestingRewardsCoins,
+		LockedStakingRecords:           lockedStakings,
+		PlanFarmerRecords:              planFarmers,
+		UnbondingStakings:              unbondingStakings,
+		RewardWithdrawAddressRecords:   rewardWithdrawAddrs,
+		ReceiptStakingRecords:          receiptStakings,
+		UnclaimedReceiptStakingRecords: unclaimedReceiptStakings,
+		AutoCompoundFarmers:            autoCompoundFarmers,
+		RewardsDust:                    rewardsDust,
+		EpochNumber:                    epochNumber,
 	}
 }
 
@@ -42,7 +59,18 @@ func DefaultGenesisState() *GenesisState {
 		sdk.Coins{},
 		sdk.Coins{},
 		nil,
-		DefaultCurrentEpochDays,
+		DefaultCurrentEpochDuration,
+		[]RewardVestingRecord{},
+		sdk.Coins{},
+		[]LockedStakingRecord{},
+		[]PlanFarmerRecord{},
+		[]UnbondingStaking{},
+		[]RewardWithdrawAddressRecord{},
+		[]ReceiptStakingRecord{},
+		[]UnclaimedReceiptStakingRecord{},
+		[]string{},
+		sdk.DecCoins{},
+		0,
 	)
 }
 
@@ -71,6 +99,21 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	restrictedPlans := map[uint64]bool{}
+	for _, plan := range plans {
+		if plan.GetRestricted() {
+			restrictedPlans[plan.GetId()] = true
+		}
+	}
+	for _, record := range data.PlanFarmerRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if !restrictedPlans[record.PlanId] {
+			return fmt.Errorf("plan %d of the plan farmer record must be a restricted plan", record.PlanId)
+		}
+	}
+
 	for _, record := range data.StakingRecords {
 		if err := record.Validate(); err != nil {
 			return err
@@ -81,6 +124,27 @@ func ValidateGenesis(data GenesisState) error {
 		if err := record.Validate(); err != nil {
 			return err
 		}
+		if record.QueuedStaking.QueuedEpoch > data.EpochNumber {
+			return fmt.Errorf("queued epoch %d must not be greater than the epoch number %d",
+				record.QueuedStaking.QueuedEpoch, data.EpochNumber)
+		}
+	}
+
+	for _, record := range data.LockedStakingRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+
+	farmers := map[string]bool{}
+	for _, ubd := range data.UnbondingStakings {
+		if err := ubd.Validate(); err != nil {
+			return err
+		}
+		if farmers[ubd.Farmer] {
+			return fmt.Errorf("duplicate unbonding staking of %s", ubd.Farmer)
+		}
+		farmers[ubd.Farmer] = true
 	}
 
 	for _, record := range data.HistoricalRewardsRecords {
@@ -108,8 +172,55 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	if data.CurrentEpochDays == 0 {
-		return fmt.Errorf("current epoch days must be positive")
+	if data.EpochDuration() <= 0 {
+		return fmt.Errorf("current epoch duration must be positive")
+	}
+
+	for _, record := range data.RewardVestingRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := data.VestingRewardsCoins.Validate(); err != nil {
+		return err
+	}
+
+	farmers = map[string]bool{}
+	for _, record := range data.RewardWithdrawAddressRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if farmers[record.Farmer] {
+			return fmt.Errorf("duplicate reward withdraw address record of %s", record.Farmer)
+		}
+		farmers[record.Farmer] = true
+	}
+
+	for _, record := range data.ReceiptStakingRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range data.UnclaimedReceiptStakingRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+
+	farmers = map[string]bool{}
+	for _, farmer := range data.AutoCompoundFarmers {
+		if _, err := sdk.AccAddressFromBech32(farmer); err != nil {
+			return err
+		}
+		if farmers[farmer] {
+			return fmt.Errorf("duplicate auto-compound farmer %s", farmer)
+		}
+		farmers[farmer] = true
+	}
+
+	if err := data.RewardsDust.Validate(); err != nil {
+		return err
 	}
 
 	return nil
@@ -139,6 +250,9 @@ func (record StakingRecord) Validate() error {
 	if !record.Staking.Amount.IsPositive() {
 		return fmt.Errorf("staking amount must be positive: %s", record.Staking.Amount)
 	}
+	if record.Staking.GetBoostAmount().IsNegative() {
+		return fmt.Errorf("staking boost amount must not be negative: %s", record.Staking.BoostAmount)
+	}
 	return nil
 }
 
@@ -155,6 +269,22 @@ func (record QueuedStakingRecord) Validate() error {
 	return nil
 }
 
+func (record LockedStakingRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(record.Farmer); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(record.StakingCoinDenom); err != nil {
+		return err
+	}
+	if !record.LockedStaking.Amount.IsPositive() {
+		return fmt.Errorf("locked staking amount must be positive: %s", record.LockedStaking.Amount)
+	}
+	if record.LockedStaking.BoostAmount.IsNil() || record.LockedStaking.BoostAmount.IsNegative() {
+		return fmt.Errorf("locked staking boost amount must not be negative: %s", record.LockedStaking.BoostAmount)
+	}
+	return nil
+}
+
 func (record HistoricalRewardsRecord) Validate() error {
 	if err := sdk.ValidateDenom(record.StakingCoinDenom); err != nil {
 		return err
@@ -162,6 +292,40 @@ func (record HistoricalRewardsRecord) Validate() error {
 	if err := record.HistoricalRewards.CumulativeUnitRewards.Validate(); err != nil {
 		return err
 	}
+	for _, r := range record.HistoricalRewards.CumulativeVestingUnitRewards {
+		if r.VestingDuration <= 0 {
+			return fmt.Errorf("vesting duration must be positive: %s", r.VestingDuration)
+		}
+		if err := r.CumulativeUnitRewards.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, r := range record.HistoricalRewards.CumulativePlanUnitRewards {
+		if r.PlanId == 0 {
+			return fmt.Errorf("plan id must be positive")
+		}
+		if err := r.CumulativeUnitRewards.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, r := range record.HistoricalRewards.CumulativeUnitRewardsByPlan {
+		if r.PlanId == 0 {
+			return fmt.Errorf("plan id must be positive")
+		}
+		if err := r.CumulativeUnitRewards.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (record PlanFarmerRecord) Validate() error {
+	if record.PlanId == 0 {
+		return fmt.Errorf("plan id must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(record.Farmer); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -181,3 +345,55 @@ func (record CurrentEpochRecord) Validate() error {
 	}
 	return nil
 }
+
+func (record RewardVestingRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(record.Farmer); err != nil {
+		return err
+	}
+	if err := record.RewardVesting.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (record RewardWithdrawAddressRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(record.Farmer); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(record.WithdrawAddress); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (record ReceiptStakingRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(record.Farmer); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(record.StakingCoinDenom); err != nil {
+		return err
+	}
+	if !record.Amount.IsPositive() {
+		return fmt.Errorf("receipt staking amount must be positive: %s", record.Amount)
+	}
+	return nil
+}
+
+func (record UnclaimedReceiptStakingRecord) Validate() error {
+	if err := sdk.ValidateDenom(record.StakingCoinDenom); err != nil {
+		return err
+	}
+	if !record.Amount.IsPositive() {
+		return fmt.Errorf("unclaimed receipt staking amount must be positive: %s", record.Amount)
+	}
+	return nil
+}
+
+// EpochDuration returns the current epoch duration of the genesis state.
+// The legacy current epoch days are used if the current epoch duration is zero.
+func (data GenesisState) EpochDuration() time.Duration {
+	if data.CurrentEpochDuration > 0 {
+		return data.CurrentEpochDuration
+	}
+	return time.Duration(data.CurrentEpochDays) * 24 * time.Hour
+}
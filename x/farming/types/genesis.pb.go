@@ -12,6 +12,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -51,7 +52,34 @@ type GenesisState struct {
 	// last_epoch_time specifies the last executed epoch time of the plans
 	LastEpochTime *time.Time `protobuf:"bytes,10,opt,name=last_epoch_time,json=lastEpochTime,proto3,stdtime" json:"last_epoch_time,omitempty" yaml:"last_epoch_time"`
 	// current_epoch_days specifies the epoch used when allocating farming rewards in end blocker
-	CurrentEpochDays uint32 `protobuf:"varint,11,opt,name=current_epoch_days,json=currentEpochDays,proto3" json:"current_epoch_days,omitempty"`
+	// Deprecated: it is the current_epoch_duration in whole days, which is used only when
+	// current_epoch_duration is zero
+	CurrentEpochDays     uint32                `protobuf:"varint,11,opt,name=current_epoch_days,json=currentEpochDays,proto3" json:"current_epoch_days,omitempty"`
+	RewardVestingRecords []RewardVestingRecord `protobuf:"bytes,12,rep,name=reward_vesting_records,json=rewardVestingRecords,proto3" json:"reward_vesting_records" yaml:"reward_vesting_records"`
+	// vesting_rewards_coins specifies balance of the vesting rewards pool locked for farmers
+	// this param is needed for import/export validation
+	VestingRewardsCoins  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=vesting_rewards_coins,json=vestingRewardsCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vesting_rewards_coins" yaml:"vesting_rewards_coins"`
+	LockedStakingRecords []LockedStakingRecord                    `protobuf:"bytes,14,rep,name=locked_staking_records,json=lockedStakingRecords,proto3" json:"locked_staking_records" yaml:"locked_staking_records"`
+	// plan_farmer_records defines the farmers in the allowlists of restricted plans
+	PlanFarmerRecords []PlanFarmerRecord `protobuf:"bytes,15,rep,name=plan_farmer_records,json=planFarmerRecords,proto3" json:"plan_farmer_records" yaml:"plan_farmer_records"`
+	// unbonding_stakings defines the unstaked coins of farmers which are unbonding
+	UnbondingStakings []UnbondingStaking `protobuf:"bytes,16,rep,name=unbonding_stakings,json=unbondingStakings,proto3" json:"unbonding_stakings" yaml:"unbonding_stakings"`
+	// reward_withdraw_address_records defines the reward withdraw addresses set by farmers
+	RewardWithdrawAddressRecords []RewardWithdrawAddressRecord `protobuf:"bytes,17,rep,name=reward_withdraw_address_records,json=rewardWithdrawAddressRecords,proto3" json:"reward_withdraw_address_records" yaml:"reward_withdraw_address_records"`
+	// receipt_staking_records defines the receipt-backed stakings of farmers
+	ReceiptStakingRecords []ReceiptStakingRecord `protobuf:"bytes,18,rep,name=receipt_staking_records,json=receiptStakingRecords,proto3" json:"receipt_staking_records" yaml:"receipt_staking_records"`
+	// unclaimed_receipt_staking_records defines the receipt-backed stakings
+	// whose receipts have been moved but not claimed yet
+	UnclaimedReceiptStakingRecords []UnclaimedReceiptStakingRecord `protobuf:"bytes,19,rep,name=unclaimed_receipt_staking_records,json=unclaimedReceiptStakingRecords,proto3" json:"unclaimed_receipt_staking_records" yaml:"unclaimed_receipt_staking_records"`
+	// auto_compound_farmers defines the farmers who have turned on auto-compounding of their rewards
+	AutoCompoundFarmers []string `protobuf:"bytes,20,rep,name=auto_compound_farmers,json=autoCompoundFarmers,proto3" json:"auto_compound_farmers,omitempty" yaml:"auto_compound_farmers"`
+	// rewards_dust specifies the fractional rewards left over from truncation which are not swept yet
+	RewardsDust github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,21,rep,name=rewards_dust,json=rewardsDust,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards_dust" yaml:"rewards_dust"`
+	// current_epoch_duration specifies the epoch length used when allocating farming rewards in end blocker
+	CurrentEpochDuration time.Duration `protobuf:"bytes,22,opt,name=current_epoch_duration,json=currentEpochDuration,proto3,stdduration" json:"current_epoch_duration" yaml:"current_epoch_duration"`
+	// epoch_number specifies the number of epochs advanced so far, which is compared with
+	// the queued epoch of the queued stakings
+	EpochNumber uint64 `protobuf:"varint,23,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty" yaml:"epoch_number"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -89,7 +117,7 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
 // PlanRecord is used for import/export via genesis json.
 type PlanRecord struct {
-	// plan specifies the plan interface; it can be FixedAmountPlan or RatioPlan
+	// plan specifies the plan interface; it can be FixedAmountPlan, RatioPlan, DecayingPlan or SchedulePlan
 	Plan types1.Any `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan"`
 	// farming_pool_coins specifies balance of the farming pool for the plan
 	// this param is needed for import/export validation
@@ -207,6 +235,202 @@ func (m *QueuedStakingRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueuedStakingRecord proto.InternalMessageInfo
 
+type LockedStakingRecord struct {
+	StakingCoinDenom string        `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty" yaml:"staking_coin_denom"`
+	Farmer           string        `protobuf:"bytes,2,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	LockedStaking    LockedStaking `protobuf:"bytes,3,opt,name=locked_staking,json=lockedStaking,proto3" json:"locked_staking" yaml:"locked_staking"`
+}
+
+func (m *LockedStakingRecord) Reset()         { *m = LockedStakingRecord{} }
+func (m *LockedStakingRecord) String() string { return proto.CompactTextString(m) }
+func (*LockedStakingRecord) ProtoMessage()    {}
+func (*LockedStakingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c67612b66bcd2967, []int{4}
+}
+func (m *LockedStakingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockedStakingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockedStakingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockedStakingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedStakingRecord.Merge(m, src)
+}
+func (m *LockedStakingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockedStakingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedStakingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedStakingRecord proto.InternalMessageInfo
+
+// PlanFarmerRecord is used for import/export via genesis json.
+type PlanFarmerRecord struct {
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty" yaml:"plan_id"`
+	Farmer string `protobuf:"bytes,2,opt,name=farmer,proto3" json:"farmer,omitempty"`
+}
+
+func (m *PlanFarmerRecord) Reset()         { *m = PlanFarmerRecord{} }
+func (m *PlanFarmerRecord) String() string { return proto.CompactTextString(m) }
+func (*PlanFarmerRecord) ProtoMessage()    {}
+func (*PlanFarmerRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c67612b66bcd2967, []int{5}
+}
+func (m *PlanFarmerRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlanFarmerRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlanFarmerRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlanFarmerRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanFarmerRecord.Merge(m, src)
+}
+func (m *PlanFarmerRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlanFarmerRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanFarmerRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanFarmerRecord proto.InternalMessageInfo
+
+// RewardWithdrawAddressRecord is used for import/export via genesis json.
+type RewardWithdrawAddressRecord struct {
+	Farmer          string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty" yaml:"withdraw_address"`
+}
+
+func (m *RewardWithdrawAddressRecord) Reset()         { *m = RewardWithdrawAddressRecord{} }
+func (m *RewardWithdrawAddressRecord) String() string { return proto.CompactTextString(m) }
+func (*RewardWithdrawAddressRecord) ProtoMessage()    {}
+func (*RewardWithdrawAddressRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c67612b66bcd2967, []int{6}
+}
+func (m *RewardWithdrawAddressRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardWithdrawAddressRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardWithdrawAddressRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardWithdrawAddressRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardWithdrawAddressRecord.Merge(m, src)
+}
+func (m *RewardWithdrawAddressRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardWithdrawAddressRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardWithdrawAddressRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardWithdrawAddressRecord proto.InternalMessageInfo
+
+// ReceiptStakingRecord is used for import/export via genesis json.
+type ReceiptStakingRecord struct {
+	StakingCoinDenom string                                 `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty" yaml:"staking_coin_denom"`
+	Farmer           string                                 `protobuf:"bytes,2,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *ReceiptStakingRecord) Reset()         { *m = ReceiptStakingRecord{} }
+func (m *ReceiptStakingRecord) String() string { return proto.CompactTextString(m) }
+func (*ReceiptStakingRecord) ProtoMessage()    {}
+func (*ReceiptStakingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c67612b66bcd2967, []int{7}
+}
+func (m *ReceiptStakingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiptStakingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiptStakingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiptStakingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptStakingRecord.Merge(m, src)
+}
+func (m *ReceiptStakingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiptStakingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptStakingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptStakingRecord proto.InternalMessageInfo
+
+// UnclaimedReceiptStakingRecord is used for import/export via genesis json.
+type UnclaimedReceiptStakingRecord struct {
+	StakingCoinDenom string                                 `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty" yaml:"staking_coin_denom"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *UnclaimedReceiptStakingRecord) Reset()         { *m = UnclaimedReceiptStakingRecord{} }
+func (m *UnclaimedReceiptStakingRecord) String() string { return proto.CompactTextString(m) }
+func (*UnclaimedReceiptStakingRecord) ProtoMessage()    {}
+func (*UnclaimedReceiptStakingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c67612b66bcd2967, []int{8}
+}
+func (m *UnclaimedReceiptStakingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnclaimedReceiptStakingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnclaimedReceiptStakingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnclaimedReceiptStakingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnclaimedReceiptStakingRecord.Merge(m, src)
+}
+func (m *UnclaimedReceiptStakingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnclaimedReceiptStakingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnclaimedReceiptStakingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnclaimedReceiptStakingRecord proto.InternalMessageInfo
+
 type HistoricalRewardsRecord struct {
 	StakingCoinDenom  string            `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty" yaml:"staking_coin_denom"`
 	Epoch             uint64            `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
@@ -217,7 +441,7 @@ func (m *HistoricalRewardsRecord) Reset()         { *m = HistoricalRewardsRecord
 func (m *HistoricalRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*HistoricalRewardsRecord) ProtoMessage()    {}
 func (*HistoricalRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c67612b66bcd2967, []int{4}
+	return fileDescriptor_c67612b66bcd2967, []int{9}
 }
 func (m *HistoricalRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -255,7 +479,7 @@ func (m *OutstandingRewardsRecord) Reset()         { *m = OutstandingRewardsReco
 func (m *OutstandingRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*OutstandingRewardsRecord) ProtoMessage()    {}
 func (*OutstandingRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c67612b66bcd2967, []int{5}
+	return fileDescriptor_c67612b66bcd2967, []int{10}
 }
 func (m *OutstandingRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -293,7 +517,7 @@ func (m *CurrentEpochRecord) Reset()         { *m = CurrentEpochRecord{} }
 func (m *CurrentEpochRecord) String() string { return proto.CompactTextString(m) }
 func (*CurrentEpochRecord) ProtoMessage()    {}
 func (*CurrentEpochRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c67612b66bcd2967, []int{6}
+	return fileDescriptor_c67612b66bcd2967, []int{11}
 }
 func (m *CurrentEpochRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,14 +546,58 @@ func (m *CurrentEpochRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CurrentEpochRecord proto.InternalMessageInfo
 
+type RewardVestingRecord struct {
+	Farmer        string        `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	RewardVesting RewardVesting `protobuf:"bytes,2,opt,name=reward_vesting,json=rewardVesting,proto3" json:"reward_vesting" yaml:"reward_vesting"`
+}
+
+func (m *RewardVestingRecord) Reset()         { *m = RewardVestingRecord{} }
+func (m *RewardVestingRecord) String() string { return proto.CompactTextString(m) }
+func (*RewardVestingRecord) ProtoMessage()    {}
+func (*RewardVestingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c67612b66bcd2967, []int{12}
+}
+func (m *RewardVestingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardVestingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardVestingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardVestingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardVestingRecord.Merge(m, src)
+}
+func (m *RewardVestingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardVestingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardVestingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardVestingRecord proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.farming.v1beta1.GenesisState")
 	proto.RegisterType((*PlanRecord)(nil), "cosmos.farming.v1beta1.PlanRecord")
 	proto.RegisterType((*StakingRecord)(nil), "cosmos.farming.v1beta1.StakingRecord")
 	proto.RegisterType((*QueuedStakingRecord)(nil), "cosmos.farming.v1beta1.QueuedStakingRecord")
+	proto.RegisterType((*LockedStakingRecord)(nil), "cosmos.farming.v1beta1.LockedStakingRecord")
+	proto.RegisterType((*PlanFarmerRecord)(nil), "cosmos.farming.v1beta1.PlanFarmerRecord")
+	proto.RegisterType((*RewardWithdrawAddressRecord)(nil), "cosmos.farming.v1beta1.RewardWithdrawAddressRecord")
+	proto.RegisterType((*ReceiptStakingRecord)(nil), "cosmos.farming.v1beta1.ReceiptStakingRecord")
+	proto.RegisterType((*UnclaimedReceiptStakingRecord)(nil), "cosmos.farming.v1beta1.UnclaimedReceiptStakingRecord")
 	proto.RegisterType((*HistoricalRewardsRecord)(nil), "cosmos.farming.v1beta1.HistoricalRewardsRecord")
 	proto.RegisterType((*OutstandingRewardsRecord)(nil), "cosmos.farming.v1beta1.OutstandingRewardsRecord")
 	proto.RegisterType((*CurrentEpochRecord)(nil), "cosmos.farming.v1beta1.CurrentEpochRecord")
+	proto.RegisterType((*RewardVestingRecord)(nil), "cosmos.farming.v1beta1.RewardVestingRecord")
 }
 
 func init() {
@@ -337,70 +605,108 @@ func init() {
 }
 
 var fileDescriptor_c67612b66bcd2967 = []byte{
-	// 997 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe4, 0x57, 0xdb, 0x49, 0xdc, 0xa4, 0x13, 0x27, 0xac, 0x5d, 0xb2, 0x9b, 0x8e, 0x88,
-	0xe4, 0x16, 0xb2, 0x4b, 0xcb, 0x01, 0xa9, 0x02, 0x21, 0x96, 0x22, 0x40, 0x05, 0x11, 0xa6, 0x9c,
-	0xb8, 0x58, 0x63, 0x7b, 0xea, 0xac, 0x62, 0xef, 0xb8, 0x3b, 0xeb, 0x82, 0xc5, 0x81, 0x03, 0x1c,
-	0x7a, 0xac, 0x84, 0x84, 0x38, 0x20, 0xd1, 0x23, 0xea, 0x99, 0x3b, 0x1c, 0x2b, 0x4e, 0x3d, 0x72,
-	0x4a, 0x51, 0x72, 0xe9, 0x95, 0xfc, 0x05, 0x68, 0x7e, 0x78, 0xbd, 0x9b, 0xdd, 0x4d, 0x5a, 0x29,
-	0xe2, 0xe4, 0xdd, 0xd9, 0xf7, 0xbe, 0xef, 0x7b, 0x6f, 0x66, 0xbe, 0x67, 0xd8, 0x8c, 0x59, 0xd8,
-	0x65, 0xd1, 0x20, 0x08, 0x63, 0xef, 0x2e, 0x95, 0xbf, 0x3d, 0xef, 0xfe, 0xf5, 0x36, 0x8b, 0xe9,
-	0x75, 0xaf, 0xc7, 0x42, 0x26, 0x02, 0xe1, 0x0e, 0x23, 0x1e, 0x73, 0xb4, 0xde, 0xe1, 0x62, 0xc0,
-	0x85, 0x6b, 0xa2, 0x5c, 0x13, 0xd5, 0xa8, 0xf7, 0x38, 0xef, 0xf5, 0x99, 0xa7, 0xa2, 0xda, 0xa3,
-	0xbb, 0x1e, 0x0d, 0xc7, 0x3a, 0xa5, 0x51, 0xeb, 0xf1, 0x1e, 0x57, 0x8f, 0x9e, 0x7c, 0x32, 0xab,
-	0x75, 0x0d, 0xd4, 0xd2, 0x1f, 0x0c, 0xaa, 0xfe, 0x64, 0xeb, 0x37, 0xaf, 0x4d, 0x05, 0x4b, 0x64,
-	0x74, 0x78, 0x10, 0x9a, 0xef, 0x27, 0xa9, 0x9d, 0xe8, 0xd2, 0x91, 0xce, 0x71, 0x55, 0x71, 0x30,
-	0x60, 0x22, 0xa6, 0x83, 0xa1, 0x0e, 0xc0, 0x7f, 0x42, 0xb8, 0xf4, 0x91, 0x2e, 0xf0, 0x4e, 0x4c,
-	0x63, 0x86, 0xde, 0x81, 0x0b, 0x43, 0x1a, 0xd1, 0x81, 0xb0, 0xc0, 0x26, 0x68, 0x2e, 0xde, 0xb0,
-	0xdd, 0xe2, 0x82, 0xdd, 0x1d, 0x15, 0xe5, 0xcf, 0x3d, 0xd9, 0x77, 0x2a, 0xc4, 0xe4, 0xa0, 0x36,
-	0x5c, 0x1a, 0xf6, 0x69, 0xd8, 0x8a, 0x58, 0x87, 0x47, 0x5d, 0x61, 0xcd, 0x6c, 0xce, 0x36, 0x17,
-	0x6f, 0xe0, 0x52, 0x8c, 0x3e, 0x0d, 0x89, 0x0a, 0xf5, 0x2f, 0x4b, 0x9c, 0xa3, 0x7d, 0x67, 0x75,
-	0x4c, 0x07, 0xfd, 0x9b, 0x38, 0x8d, 0x82, 0xc9, 0xe2, 0x30, 0x09, 0x14, 0x28, 0x84, 0xcb, 0x22,
-	0xa6, 0x7b, 0x41, 0xd8, 0x4b, 0x68, 0x66, 0x15, 0xcd, 0x56, 0x19, 0xcd, 0x1d, 0x1d, 0x6e, 0x98,
-	0x6c, 0xc3, 0xb4, 0xae, 0x99, 0x8e, 0x61, 0x61, 0x72, 0x51, 0xa4, 0xc3, 0x05, 0x7a, 0x00, 0xe0,
-	0xfa, 0xbd, 0x11, 0x1b, 0xb1, 0x6e, 0xeb, 0x38, 0xef, 0x9c, 0xe2, 0x7d, 0xbd, 0x8c, 0xf7, 0x0b,
-	0x95, 0x95, 0x65, 0xdf, 0x32, 0xec, 0x1b, 0x9a, 0xbd, 0x18, 0x18, 0x93, 0xda, 0xbd, 0x7c, 0xae,
-	0x40, 0x3f, 0x03, 0xd8, 0xd8, 0x0d, 0x44, 0xcc, 0xa3, 0xa0, 0x43, 0xfb, 0xad, 0x88, 0x7d, 0x4d,
-	0xa3, 0xae, 0x48, 0xe4, 0xcc, 0x2b, 0x39, 0x5e, 0x99, 0x9c, 0x8f, 0x93, 0x4c, 0xa2, 0x13, 0x8d,
-	0xa4, 0xab, 0x46, 0xd2, 0x15, 0x2d, 0xa9, 0x9c, 0x00, 0x13, 0x6b, 0xb7, 0x18, 0x43, 0xa0, 0x5f,
-	0x00, 0xbc, 0xcc, 0x47, 0xb1, 0x88, 0x69, 0xd8, 0xd5, 0x95, 0x64, 0xb5, 0x2d, 0x28, 0x6d, 0x6f,
-	0x96, 0x69, 0xfb, 0x7c, 0x9a, 0x9a, 0x15, 0x77, 0xcd, 0x88, 0xc3, 0x5a, 0xdc, 0x09, 0x14, 0x98,
-	0xd4, 0x79, 0x09, 0x8a, 0x40, 0x3f, 0x00, 0xb8, 0xd6, 0x19, 0x45, 0x11, 0x0b, 0xe3, 0x16, 0x1b,
-	0xf2, 0xce, 0x6e, 0x22, 0xec, 0x9c, 0x12, 0x76, 0xad, 0x4c, 0xd8, 0x07, 0x3a, 0xe9, 0x43, 0x99,
-	0x63, 0x24, 0xbd, 0x66, 0x24, 0xbd, 0xaa, 0x25, 0x15, 0xc2, 0x62, 0xb2, 0xda, 0xc9, 0x65, 0x0a,
-	0xf4, 0x2b, 0x80, 0x6b, 0xd3, 0xbd, 0x16, 0x2c, 0xba, 0xcf, 0x5a, 0xf2, 0x62, 0x0b, 0xeb, 0xbc,
-	0x92, 0x51, 0x9f, 0xc8, 0x90, 0x57, 0x7f, 0xaa, 0x81, 0x07, 0xa1, 0xbf, 0x93, 0x65, 0x2d, 0x44,
-	0xc1, 0x8f, 0x9f, 0x39, 0xcd, 0x5e, 0x10, 0xef, 0x8e, 0xda, 0x6e, 0x87, 0x0f, 0x8c, 0xab, 0x98,
-	0x9f, 0x6d, 0xd1, 0xdd, 0xf3, 0xe2, 0xf1, 0x90, 0x09, 0x05, 0x28, 0xc8, 0x6a, 0x72, 0xd0, 0x15,
-	0x84, 0x5a, 0x44, 0x3f, 0x02, 0x78, 0x49, 0x37, 0xb6, 0x35, 0xe4, 0xbc, 0x6f, 0xd4, 0x5d, 0x38,
-	0x4d, 0xdd, 0xa7, 0x46, 0x9d, 0xa5, 0xd5, 0xe5, 0x10, 0x5e, 0x4e, 0xd9, 0xb2, 0xce, 0xdf, 0xe1,
-	0xbc, 0xaf, 0x55, 0xb5, 0xe1, 0x72, 0x9f, 0x8a, 0x49, 0x8f, 0xa5, 0x89, 0x59, 0x50, 0xd9, 0x53,
-	0xc3, 0xd5, 0x0e, 0xe7, 0x4e, 0x1c, 0xce, 0xfd, 0x72, 0xe2, 0x70, 0xbe, 0x3d, 0xbd, 0xe4, 0xc7,
-	0x92, 0xf1, 0xc3, 0x67, 0x0e, 0x20, 0x55, 0xb9, 0xaa, 0xb6, 0x47, 0xe6, 0xa0, 0x37, 0x20, 0xca,
-	0x6e, 0x65, 0x97, 0x8e, 0x85, 0xb5, 0xb8, 0x09, 0x9a, 0x55, 0xb2, 0x92, 0xde, 0xcc, 0x5b, 0x74,
-	0x2c, 0x6e, 0x9e, 0x7f, 0xf0, 0xc8, 0xa9, 0x3c, 0x7f, 0xe4, 0x54, 0xf0, 0x73, 0x00, 0xe1, 0xd4,
-	0xc8, 0xd0, 0xdb, 0x70, 0x4e, 0xba, 0x95, 0xb1, 0xcf, 0x5a, 0x4e, 0xdf, 0xfb, 0xe1, 0xd8, 0xaf,
-	0xca, 0x6e, 0xfd, 0xf5, 0xfb, 0xf6, 0xbc, 0xcc, 0xfb, 0x84, 0xa8, 0x04, 0xf4, 0x13, 0x80, 0xc8,
-	0x9c, 0xbe, 0x74, 0xeb, 0x67, 0x4e, 0x6b, 0xfd, 0x67, 0xa6, 0xf5, 0x75, 0x5d, 0x6a, 0x1e, 0xe2,
-	0xe5, 0x7a, 0xbf, 0x62, 0x00, 0x92, 0xe6, 0xa7, 0x4a, 0xfd, 0x03, 0xc0, 0x6a, 0xc6, 0x92, 0xd0,
-	0x6d, 0x88, 0x26, 0x27, 0x51, 0x72, 0xb5, 0xba, 0x2c, 0xe4, 0x03, 0x55, 0xfb, 0x05, 0x7f, 0x63,
-	0x2a, 0x2a, 0x1f, 0x83, 0xc9, 0x8a, 0x59, 0x94, 0x24, 0xb7, 0xe4, 0x12, 0x5a, 0x87, 0x0b, 0x92,
-	0x9c, 0x45, 0xd6, 0x8c, 0x04, 0x20, 0xe6, 0x0d, 0xbd, 0x07, 0xcf, 0x99, 0x58, 0x6b, 0x56, 0x75,
-	0xd5, 0x39, 0xc5, 0xe9, 0xcd, 0x54, 0x9a, 0x64, 0xa5, 0x2a, 0xf8, 0x17, 0xc0, 0xd5, 0x02, 0x5b,
-	0xfe, 0x7f, 0xea, 0xd8, 0x83, 0x17, 0xb3, 0x7e, 0x6f, 0xca, 0xd9, 0x7a, 0xa1, 0x01, 0xe2, 0x6f,
-	0x98, 0x8d, 0x5e, 0x2b, 0x1a, 0x1d, 0x98, 0x54, 0x33, 0x23, 0x23, 0x55, 0xf3, 0xf7, 0x33, 0xf0,
-	0x95, 0x12, 0xef, 0x3f, 0xdb, 0xba, 0x6b, 0x70, 0x5e, 0xdd, 0x1c, 0x55, 0xf6, 0x1c, 0xd1, 0x2f,
-	0xe8, 0x5b, 0x88, 0xf2, 0x23, 0xc5, 0x54, 0x7e, 0xf5, 0x85, 0x67, 0x95, 0x7f, 0x25, 0x7b, 0xcc,
-	0xf3, 0x90, 0x98, 0x5c, 0xca, 0x4d, 0xa7, 0x54, 0x17, 0x8e, 0x00, 0xb4, 0xca, 0xa6, 0xcc, 0xd9,
-	0xb6, 0xe1, 0x3b, 0xb8, 0x5a, 0x30, 0xa6, 0x54, 0x53, 0x4e, 0x18, 0x34, 0x79, 0x6d, 0x3e, 0x36,
-	0x25, 0x37, 0x4a, 0x67, 0x1f, 0x26, 0x28, 0x3f, 0xf3, 0x52, 0x45, 0x3f, 0x06, 0x10, 0xe5, 0x27,
-	0xd8, 0xd9, 0x96, 0xfb, 0x2e, 0xac, 0x66, 0x7c, 0x53, 0xef, 0xbe, 0x6f, 0x1d, 0xed, 0x3b, 0xb5,
-	0x82, 0x09, 0x89, 0xc9, 0x52, 0xda, 0x4c, 0xa7, 0x62, 0xfd, 0xdb, 0xbf, 0x1d, 0xd8, 0xe0, 0xc9,
-	0x81, 0x0d, 0x9e, 0x1e, 0xd8, 0xe0, 0x9f, 0x03, 0x1b, 0x3c, 0x3c, 0xb4, 0x2b, 0x4f, 0x0f, 0xed,
-	0xca, 0xdf, 0x87, 0x76, 0xe5, 0xab, 0xed, 0x94, 0x83, 0x15, 0xfc, 0xff, 0xfd, 0x26, 0x79, 0x52,
-	0x66, 0xd6, 0x5e, 0x50, 0x86, 0xfb, 0xd6, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x5e, 0x74,
-	0x2a, 0xda, 0x0b, 0x00, 0x00,
+	// 1603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0x24, 0x69, 0xda, 0x4c, 0xe2, 0x7c, 0x8c, 0x9d, 0x64, 0x93, 0x34, 0x76, 0x3a, 0xfa,
+	0xb5, 0x72, 0xbf, 0xec, 0x7e, 0xe8, 0x27, 0xa4, 0x0a, 0x84, 0xba, 0x0d, 0x85, 0xd2, 0x02, 0x65,
+	0x5a, 0x40, 0xe2, 0x62, 0xad, 0xbd, 0x53, 0x67, 0x15, 0x7b, 0xc7, 0xdd, 0xd9, 0x4d, 0x48, 0x41,
+	0xe2, 0x00, 0x12, 0x95, 0xe0, 0x50, 0x09, 0x09, 0xf5, 0x80, 0x44, 0x0f, 0x1c, 0x50, 0x8f, 0x88,
+	0x33, 0x5c, 0x2b, 0x24, 0xa4, 0x1e, 0x11, 0x87, 0x14, 0xa5, 0x97, 0x5e, 0xc9, 0x3f, 0x00, 0xda,
+	0x99, 0xb1, 0xbd, 0xdf, 0x49, 0xa4, 0xa8, 0x27, 0x7b, 0x77, 0xdf, 0xe7, 0x79, 0x9f, 0x77, 0xe6,
+	0x9d, 0xf7, 0x9d, 0x19, 0x58, 0x76, 0xa9, 0x6d, 0x52, 0xa7, 0x6d, 0xd9, 0x6e, 0xf5, 0x8e, 0xe1,
+	0xff, 0x36, 0xab, 0xeb, 0xe7, 0xeb, 0xd4, 0x35, 0xce, 0x57, 0x9b, 0xd4, 0xa6, 0xdc, 0xe2, 0x95,
+	0x8e, 0xc3, 0x5c, 0x86, 0x66, 0x1b, 0x8c, 0xb7, 0x19, 0xaf, 0x28, 0xab, 0x8a, 0xb2, 0x5a, 0x98,
+	0x6f, 0x32, 0xd6, 0x6c, 0xd1, 0xaa, 0xb0, 0xaa, 0x7b, 0x77, 0xaa, 0x86, 0xbd, 0x29, 0x21, 0x0b,
+	0x85, 0x26, 0x6b, 0x32, 0xf1, 0xb7, 0xea, 0xff, 0x53, 0x6f, 0xe7, 0x25, 0x51, 0x4d, 0x7e, 0x50,
+	0xac, 0xf2, 0x53, 0x51, 0x3e, 0x55, 0xeb, 0x06, 0xa7, 0x3d, 0x19, 0x0d, 0x66, 0xd9, 0xea, 0x7b,
+	0x96, 0xda, 0xae, 0x2e, 0x69, 0x59, 0x8a, 0xaa, 0x72, 0xad, 0x36, 0xe5, 0xae, 0xd1, 0xee, 0x74,
+	0x5d, 0x45, 0x0d, 0x4c, 0xcf, 0x31, 0x5c, 0x8b, 0x29, 0x57, 0xf8, 0xdf, 0x59, 0x38, 0xfe, 0xa6,
+	0x1c, 0x80, 0x5b, 0xae, 0xe1, 0x52, 0xf4, 0x2a, 0x1c, 0xe9, 0x18, 0x8e, 0xd1, 0xe6, 0x1a, 0x58,
+	0x06, 0xe5, 0xb1, 0x0b, 0xc5, 0x4a, 0xf2, 0x80, 0x54, 0x6e, 0x0a, 0x2b, 0x7d, 0xf8, 0xc9, 0x56,
+	0x69, 0x80, 0x28, 0x0c, 0xaa, 0xc3, 0xf1, 0x4e, 0xcb, 0xb0, 0x6b, 0x0e, 0x6d, 0x30, 0xc7, 0xe4,
+	0xda, 0xe0, 0xf2, 0x50, 0x79, 0xec, 0x02, 0x4e, 0xe5, 0x68, 0x19, 0x36, 0x11, 0xa6, 0xfa, 0xa2,
+	0xcf, 0xb3, 0xb3, 0x55, 0xca, 0x6f, 0x1a, 0xed, 0xd6, 0x25, 0x1c, 0x64, 0xc1, 0x64, 0xac, 0xd3,
+	0x33, 0xe4, 0xc8, 0x86, 0x93, 0xdc, 0x35, 0xd6, 0x2c, 0xbb, 0xd9, 0x73, 0x33, 0x24, 0xdc, 0x1c,
+	0x4f, 0x73, 0x73, 0x4b, 0x9a, 0x2b, 0x4f, 0x45, 0xe5, 0x69, 0x56, 0x7a, 0x8a, 0x70, 0x61, 0x32,
+	0xc1, 0x83, 0xe6, 0x1c, 0xdd, 0x07, 0x70, 0xf6, 0xae, 0x47, 0x3d, 0x6a, 0xd6, 0xa2, 0x7e, 0x87,
+	0x85, 0xdf, 0xd3, 0x69, 0x7e, 0xdf, 0x17, 0xa8, 0xb0, 0xf7, 0xe3, 0xca, 0xfb, 0x92, 0xf4, 0x9e,
+	0x4c, 0x8c, 0x49, 0xe1, 0x6e, 0x1c, 0xcb, 0xd1, 0x43, 0x00, 0x17, 0x56, 0x2d, 0xee, 0x32, 0xc7,
+	0x6a, 0x18, 0xad, 0x9a, 0x43, 0x37, 0x0c, 0xc7, 0xe4, 0x3d, 0x39, 0x87, 0x84, 0x9c, 0x6a, 0x9a,
+	0x9c, 0xb7, 0x7a, 0x48, 0x22, 0x81, 0x4a, 0xd2, 0x49, 0x25, 0xe9, 0x98, 0x94, 0x94, 0xee, 0x00,
+	0x13, 0x6d, 0x35, 0x99, 0x83, 0xa3, 0xef, 0x01, 0x5c, 0x64, 0x9e, 0xcb, 0x5d, 0xc3, 0x36, 0x65,
+	0x24, 0x61, 0x6d, 0x23, 0x42, 0xdb, 0xb9, 0x34, 0x6d, 0xef, 0xf5, 0xa1, 0x61, 0x71, 0xa7, 0x94,
+	0x38, 0x2c, 0xc5, 0x65, 0xb8, 0xc0, 0x64, 0x9e, 0xa5, 0xb0, 0x70, 0xf4, 0x25, 0x80, 0x33, 0x0d,
+	0xcf, 0x71, 0xa8, 0xed, 0xd6, 0x68, 0x87, 0x35, 0x56, 0x7b, 0xc2, 0x0e, 0x0b, 0x61, 0xa7, 0xd2,
+	0x84, 0x5d, 0x91, 0xa0, 0x37, 0x7c, 0x8c, 0x92, 0xf4, 0x3f, 0x25, 0xe9, 0xa8, 0x94, 0x94, 0x48,
+	0x8b, 0x49, 0xbe, 0x11, 0x43, 0x72, 0xf4, 0x03, 0x80, 0x33, 0xfd, 0xb9, 0xe6, 0xd4, 0x59, 0xa7,
+	0x35, 0x7f, 0xe1, 0x73, 0xed, 0x88, 0x90, 0x31, 0xdf, 0x95, 0xe1, 0x97, 0x86, 0xbe, 0x06, 0x66,
+	0xd9, 0xfa, 0xcd, 0xb0, 0xd7, 0x44, 0x16, 0xfc, 0xf8, 0x59, 0xa9, 0xdc, 0xb4, 0xdc, 0x55, 0xaf,
+	0x5e, 0x69, 0xb0, 0xb6, 0xaa, 0x3a, 0xea, 0xe7, 0x2c, 0x37, 0xd7, 0xaa, 0xee, 0x66, 0x87, 0x72,
+	0x41, 0xc8, 0x49, 0xbe, 0x97, 0xe8, 0x82, 0x42, 0xbc, 0x44, 0xdf, 0x02, 0x38, 0x2d, 0x07, 0xb6,
+	0xd6, 0x61, 0xac, 0xa5, 0xd4, 0x8d, 0xee, 0xa6, 0xee, 0x86, 0x52, 0xa7, 0x49, 0x75, 0x31, 0x86,
+	0xfd, 0x29, 0x9b, 0x94, 0xf8, 0x9b, 0x8c, 0xb5, 0xa4, 0xaa, 0x3a, 0x9c, 0x6c, 0x19, 0xbc, 0x3b,
+	0xc6, 0x7e, 0x91, 0xd3, 0xa0, 0x28, 0x4f, 0x0b, 0x15, 0x59, 0xe0, 0x2a, 0xdd, 0x02, 0x57, 0xb9,
+	0xdd, 0xad, 0x80, 0x7a, 0xb1, 0xbf, 0xc8, 0x23, 0x60, 0xfc, 0xe0, 0x59, 0x09, 0x90, 0x9c, 0xff,
+	0x56, 0x4c, 0x8f, 0x8f, 0x41, 0x67, 0x20, 0x0a, 0x4f, 0xa5, 0x69, 0x6c, 0x72, 0x6d, 0x6c, 0x19,
+	0x94, 0x73, 0x64, 0x2a, 0x38, 0x99, 0x2b, 0xc6, 0xa6, 0xac, 0x0a, 0x2a, 0xca, 0x75, 0xca, 0xdd,
+	0x60, 0x55, 0x18, 0xcf, 0xae, 0x0a, 0x32, 0x33, 0x3f, 0x94, 0xa0, 0xe4, 0xaa, 0x90, 0x4c, 0x8c,
+	0x49, 0xc1, 0x89, 0x63, 0x65, 0x52, 0xf5, 0x4d, 0xe5, 0x9a, 0x90, 0xd3, 0x96, 0xdb, 0x67, 0x52,
+	0x25, 0xb2, 0xec, 0x33, 0xa9, 0xd6, 0xbb, 0xe2, 0x04, 0x85, 0x9c, 0x3e, 0x7f, 0xb0, 0x5a, 0xac,
+	0xb1, 0x96, 0x50, 0x42, 0x27, 0xb2, 0x07, 0xeb, 0x86, 0x40, 0x65, 0x96, 0xd0, 0x64, 0x62, 0x4c,
+	0x0a, 0xad, 0x38, 0x96, 0xa3, 0xcf, 0x60, 0x5e, 0xf4, 0x16, 0xdf, 0x11, 0x75, 0x7a, 0x32, 0x26,
+	0x85, 0x8c, 0x72, 0x56, 0xa3, 0xba, 0x2a, 0x10, 0x4a, 0x03, 0x56, 0x1a, 0x16, 0x02, 0xed, 0x2a,
+	0x4c, 0x89, 0xc9, 0x74, 0x27, 0x82, 0xe2, 0xe8, 0x1e, 0x44, 0x9e, 0x5d, 0x67, 0xb2, 0x7e, 0x29,
+	0xc5, 0x5c, 0x9b, 0xca, 0x76, 0xfe, 0x41, 0x17, 0xa1, 0x42, 0xd1, 0x8f, 0x29, 0xe7, 0xf3, 0xd2,
+	0x79, 0x9c, 0x11, 0x93, 0x69, 0x2f, 0x02, 0xe2, 0xe8, 0x31, 0x80, 0x25, 0x95, 0x58, 0x1b, 0x96,
+	0xbb, 0x6a, 0x3a, 0xc6, 0x46, 0xcd, 0x30, 0x4d, 0x87, 0xf2, 0x7e, 0x95, 0x9e, 0x16, 0x4a, 0x2e,
+	0x66, 0xa7, 0xee, 0x47, 0x0a, 0x7d, 0x59, 0x82, 0xd5, 0x88, 0x54, 0x94, 0xa8, 0x13, 0xa1, 0x14,
+	0x4e, 0xf3, 0x84, 0xc9, 0x51, 0x27, 0x9d, 0x8c, 0xa3, 0x6f, 0x00, 0x9c, 0x73, 0x68, 0x83, 0x5a,
+	0x1d, 0x37, 0x96, 0x32, 0x48, 0x88, 0x3c, 0x93, 0x2e, 0x52, 0xc0, 0xc2, 0x39, 0x73, 0x42, 0xa9,
+	0x2b, 0x76, 0xd5, 0x25, 0x52, 0x63, 0x32, 0xe3, 0x24, 0xa0, 0x39, 0xfa, 0x19, 0xc0, 0x63, 0x9e,
+	0xdd, 0x68, 0x19, 0x56, 0x9b, 0x9a, 0xb5, 0x34, 0x61, 0x79, 0x21, 0xec, 0xff, 0xe9, 0xf3, 0xa8,
+	0x08, 0x12, 0x15, 0x9e, 0x53, 0x0a, 0xcb, 0xdd, 0x49, 0xdd, 0xc5, 0x1b, 0x26, 0x45, 0x2f, 0x8b,
+	0x90, 0xa3, 0xdb, 0x70, 0xc6, 0xf0, 0x5c, 0x56, 0x6b, 0xb0, 0x76, 0x87, 0x79, 0xb6, 0xa9, 0x12,
+	0x94, 0x6b, 0x85, 0xe5, 0xa1, 0xf2, 0xa8, 0xbe, 0xdc, 0x5f, 0xf7, 0x89, 0x66, 0x98, 0xe4, 0xfd,
+	0xf7, 0x57, 0xd4, 0x6b, 0x99, 0xc8, 0x1c, 0x7d, 0x0d, 0xe0, 0x78, 0xb7, 0x3e, 0x98, 0x1e, 0x77,
+	0xb5, 0x19, 0x11, 0xf5, 0xd1, 0xc4, 0x22, 0xb3, 0x42, 0x1b, 0xa2, 0xce, 0xbc, 0x1d, 0xde, 0xdd,
+	0x05, 0xf1, 0x7e, 0x79, 0x39, 0xbd, 0x87, 0xf2, 0xa2, 0xa8, 0x38, 0x19, 0x53, 0xe8, 0x15, 0x8f,
+	0xbb, 0xe8, 0x1e, 0x9c, 0x8d, 0x14, 0x6d, 0xb5, 0xbf, 0xd5, 0x66, 0x45, 0x7f, 0x98, 0x8f, 0xf5,
+	0x87, 0x15, 0x65, 0xa0, 0x9f, 0x0c, 0x97, 0x91, 0x64, 0x1a, 0xfc, 0xd0, 0xef, 0x14, 0x85, 0x50,
+	0xf9, 0x57, 0x9f, 0xd0, 0x25, 0x38, 0x2e, 0x8d, 0x6d, 0xaf, 0x5d, 0xa7, 0x8e, 0x36, 0xb7, 0x0c,
+	0xca, 0xc3, 0xfa, 0x5c, 0x3f, 0xcc, 0xe0, 0x57, 0x4c, 0xc6, 0xc4, 0xe3, 0xbb, 0xe2, 0xe9, 0xd2,
+	0x91, 0xfb, 0x8f, 0x4a, 0x03, 0x2f, 0x1e, 0x95, 0x06, 0xf0, 0x0b, 0x00, 0x61, 0x7f, 0x1f, 0x8c,
+	0x5e, 0x81, 0xc3, 0x7e, 0xd9, 0x50, 0xbb, 0xef, 0x42, 0x4c, 0xfe, 0x65, 0x7b, 0x53, 0xcf, 0xf9,
+	0xca, 0x7f, 0xff, 0xe5, 0xec, 0x21, 0x1f, 0x77, 0x8d, 0x08, 0x00, 0xfa, 0x0e, 0x40, 0xa4, 0x32,
+	0x2e, 0xd8, 0xb9, 0x07, 0x77, 0x6b, 0x01, 0xef, 0x84, 0x8b, 0x49, 0x9c, 0x62, 0x7f, 0xf5, 0x7f,
+	0x4a, 0x11, 0xf4, 0x7a, 0x77, 0x20, 0xd4, 0xdf, 0x00, 0xcc, 0x85, 0x72, 0x14, 0x5d, 0x87, 0xa8,
+	0x9b, 0xd6, 0xbe, 0xaf, 0x9a, 0x49, 0x6d, 0xd6, 0x16, 0xb1, 0x8f, 0xea, 0x4b, 0x7d, 0x51, 0x71,
+	0x1b, 0x4c, 0xa6, 0xd4, 0x4b, 0xdf, 0xc9, 0x8a, 0xff, 0x0a, 0xcd, 0xc2, 0x11, 0x99, 0xba, 0xda,
+	0xa0, 0x4f, 0x40, 0xd4, 0x13, 0x7a, 0x1d, 0x1e, 0x56, 0xb6, 0xda, 0x90, 0x18, 0xd5, 0xd2, 0x2e,
+	0x07, 0x05, 0x75, 0xa8, 0xe9, 0xa2, 0x02, 0x11, 0xfc, 0x03, 0x60, 0x3e, 0x61, 0x57, 0xff, 0x72,
+	0xe2, 0x58, 0x83, 0x13, 0xe1, 0xe3, 0x82, 0x0a, 0xe7, 0xf8, 0x9e, 0xce, 0x1f, 0xfa, 0x92, 0x9a,
+	0xe8, 0x99, 0xa4, 0x93, 0x07, 0x26, 0xb9, 0xd0, 0x89, 0x23, 0x12, 0x73, 0x42, 0x1b, 0x7e, 0x69,
+	0x31, 0x87, 0xfb, 0xfb, 0x6e, 0x31, 0x87, 0x94, 0x46, 0x63, 0x0e, 0x53, 0x61, 0x92, 0x0b, 0x6d,
+	0x11, 0x02, 0x31, 0x1b, 0x70, 0x2a, 0xda, 0xf2, 0xd1, 0x69, 0x78, 0x58, 0xb4, 0x79, 0xcb, 0x14,
+	0x41, 0x0e, 0xeb, 0x68, 0x67, 0xab, 0x34, 0x11, 0xe8, 0xff, 0x96, 0x89, 0xc9, 0x88, 0xff, 0xef,
+	0x9a, 0x99, 0x16, 0x4f, 0xc0, 0xc5, 0x57, 0x00, 0x2e, 0x66, 0xf4, 0xd3, 0x00, 0x03, 0x08, 0x8d,
+	0xc8, 0x55, 0x38, 0x15, 0x6d, 0xaa, 0xd2, 0x87, 0xbe, 0xb8, 0xb3, 0x55, 0x9a, 0x93, 0x7a, 0xa2,
+	0x16, 0x98, 0x4c, 0x6e, 0x84, 0xbd, 0x04, 0x94, 0xfc, 0x01, 0x60, 0x21, 0xa9, 0x83, 0xbc, 0x9c,
+	0x19, 0xbe, 0x0a, 0x47, 0x8c, 0x36, 0xf3, 0x6c, 0x57, 0xcc, 0xec, 0xa8, 0xdc, 0x47, 0xfc, 0xb5,
+	0x55, 0x3a, 0xb1, 0x87, 0x92, 0x73, 0xcd, 0x76, 0x89, 0x42, 0x07, 0xe2, 0xf9, 0x15, 0xc0, 0xa5,
+	0xcc, 0x5e, 0x7b, 0xb0, 0x81, 0xf5, 0x03, 0x18, 0x3c, 0xa0, 0x00, 0xbe, 0x18, 0x84, 0x73, 0x29,
+	0x87, 0xf5, 0x83, 0x95, 0x5e, 0x80, 0x87, 0x44, 0x53, 0x12, 0xca, 0x87, 0x89, 0x7c, 0x40, 0x9f,
+	0x42, 0x14, 0xbf, 0x03, 0x50, 0xeb, 0xee, 0xe4, 0x9e, 0x2f, 0x17, 0xa2, 0xbb, 0xd4, 0x38, 0x25,
+	0x26, 0xd3, 0xb1, 0xeb, 0x84, 0xc0, 0x28, 0xec, 0x00, 0xa8, 0xa5, 0x5d, 0x0b, 0x1c, 0xec, 0x30,
+	0x7c, 0x0e, 0xf3, 0x09, 0xf7, 0x0a, 0x62, 0x50, 0x32, 0x6e, 0x06, 0xe2, 0xda, 0xa2, 0xa7, 0x82,
+	0x04, 0x52, 0x4c, 0x50, 0xfc, 0x92, 0x22, 0x10, 0xf4, 0x63, 0x00, 0x51, 0xfc, 0xca, 0xe1, 0x60,
+	0xc3, 0x7d, 0x0d, 0xe6, 0x42, 0x9b, 0x1d, 0x39, 0xfb, 0xba, 0xb6, 0xb3, 0x55, 0x2a, 0x24, 0xec,
+	0x85, 0x30, 0x19, 0x0f, 0x6e, 0x7f, 0x02, 0x62, 0x7f, 0x04, 0x30, 0x9f, 0x70, 0x9a, 0x4d, 0x2d,
+	0x5d, 0x6b, 0x70, 0x22, 0x7c, 0xb2, 0xd5, 0x06, 0xb3, 0x8b, 0x79, 0x88, 0x3c, 0x5a, 0xcc, 0xc3,
+	0x54, 0x98, 0xe4, 0x42, 0x87, 0xe3, 0xbe, 0x4c, 0xfd, 0xfa, 0x4f, 0xdb, 0x45, 0xf0, 0x64, 0xbb,
+	0x08, 0x9e, 0x6e, 0x17, 0xc1, 0xdf, 0xdb, 0x45, 0xf0, 0xe0, 0x79, 0x71, 0xe0, 0xe9, 0xf3, 0xe2,
+	0xc0, 0x9f, 0xcf, 0x8b, 0x03, 0x1f, 0x9f, 0x0d, 0x2c, 0xd3, 0x84, 0x7b, 0xd7, 0x4f, 0x7a, 0xff,
+	0xc4, 0x8a, 0xad, 0x8f, 0x88, 0x9d, 0xd8, 0xc5, 0xff, 0x06, 0x00, 0x5d, 0xdd, 0x9c, 0xfb, 0x52,
+	0x16, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -423,18 +729,182 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CurrentEpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if len(m.RewardsDust) > 0 {
+		for iNdEx := len(m.RewardsDust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsDust[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.AutoCompoundFarmers) > 0 {
+		for iNdEx := len(m.AutoCompoundFarmers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoCompoundFarmers[iNdEx])
+			copy(dAtA[i:], m.AutoCompoundFarmers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoCompoundFarmers[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.UnclaimedReceiptStakingRecords) > 0 {
+		for iNdEx := len(m.UnclaimedReceiptStakingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnclaimedReceiptStakingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ReceiptStakingRecords) > 0 {
+		for iNdEx := len(m.ReceiptStakingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiptStakingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.RewardWithdrawAddressRecords) > 0 {
+		for iNdEx := len(m.RewardWithdrawAddressRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardWithdrawAddressRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.UnbondingStakings) > 0 {
+		for iNdEx := len(m.UnbondingStakings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingStakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.PlanFarmerRecords) > 0 {
+		for iNdEx := len(m.PlanFarmerRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlanFarmerRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.LockedStakingRecords) > 0 {
+		for iNdEx := len(m.LockedStakingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedStakingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.VestingRewardsCoins) > 0 {
+		for iNdEx := len(m.VestingRewardsCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingRewardsCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RewardVestingRecords) > 0 {
+		for iNdEx := len(m.RewardVestingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardVestingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if m.CurrentEpochDays != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochDays))
 		i--
 		dAtA[i] = 0x58
 	}
 	if m.LastEpochTime != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastEpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastEpochTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastEpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastEpochTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintGenesis(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintGenesis(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -704,7 +1174,7 @@ func (m *QueuedStakingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *HistoricalRewardsRecord) Marshal() (dAtA []byte, err error) {
+func (m *LockedStakingRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -714,18 +1184,18 @@ func (m *HistoricalRewardsRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HistoricalRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *LockedStakingRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HistoricalRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LockedStakingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.HistoricalRewards.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.LockedStaking.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -734,10 +1204,12 @@ func (m *HistoricalRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	i--
 	dAtA[i] = 0x1a
-	if m.Epoch != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Farmer)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.StakingCoinDenom) > 0 {
 		i -= len(m.StakingCoinDenom)
@@ -749,7 +1221,7 @@ func (m *HistoricalRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *OutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
+func (m *PlanFarmerRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -759,17 +1231,221 @@ func (m *OutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OutstandingRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *PlanFarmerRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OutstandingRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PlanFarmerRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardWithdrawAddressRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardWithdrawAddressRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardWithdrawAddressRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiptStakingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiptStakingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiptStakingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnclaimedReceiptStakingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnclaimedReceiptStakingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnclaimedReceiptStakingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HistoricalRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutstandingRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutstandingRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
 		size, err := m.OutstandingRewards.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
@@ -824,6 +1500,46 @@ func (m *CurrentEpochRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RewardVestingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardVestingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardVestingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RewardVesting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -898,6 +1614,71 @@ func (m *GenesisState) Size() (n int) {
 	if m.CurrentEpochDays != 0 {
 		n += 1 + sovGenesis(uint64(m.CurrentEpochDays))
 	}
+	if len(m.RewardVestingRecords) > 0 {
+		for _, e := range m.RewardVestingRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VestingRewardsCoins) > 0 {
+		for _, e := range m.VestingRewardsCoins {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockedStakingRecords) > 0 {
+		for _, e := range m.LockedStakingRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PlanFarmerRecords) > 0 {
+		for _, e := range m.PlanFarmerRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingStakings) > 0 {
+		for _, e := range m.UnbondingStakings {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardWithdrawAddressRecords) > 0 {
+		for _, e := range m.RewardWithdrawAddressRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReceiptStakingRecords) > 0 {
+		for _, e := range m.ReceiptStakingRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnclaimedReceiptStakingRecords) > 0 {
+		for _, e := range m.UnclaimedReceiptStakingRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundFarmers) > 0 {
+		for _, s := range m.AutoCompoundFarmers {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardsDust) > 0 {
+		for _, e := range m.RewardsDust {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration)
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.EpochNumber != 0 {
+		n += 2 + sovGenesis(uint64(m.EpochNumber))
+	}
 	return n
 }
 
@@ -956,7 +1737,93 @@ func (m *QueuedStakingRecord) Size() (n int) {
 	return n
 }
 
-func (m *HistoricalRewardsRecord) Size() (n int) {
+func (m *LockedStakingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.LockedStaking.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PlanFarmerRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovGenesis(uint64(m.PlanId))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *RewardWithdrawAddressRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ReceiptStakingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *UnclaimedReceiptStakingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *HistoricalRewardsRecord) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1005,6 +1872,21 @@ func (m *CurrentEpochRecord) Size() (n int) {
 	return n
 }
 
+func (m *RewardVestingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.RewardVesting.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1042,9 +1924,958 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanRecords = append(m.PlanRecords, PlanRecord{})
+			if err := m.PlanRecords[len(m.PlanRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingRecords = append(m.StakingRecords, StakingRecord{})
+			if err := m.StakingRecords[len(m.StakingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedStakingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedStakingRecords = append(m.QueuedStakingRecords, QueuedStakingRecord{})
+			if err := m.QueuedStakingRecords[len(m.QueuedStakingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRewardsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoricalRewardsRecords = append(m.HistoricalRewardsRecords, HistoricalRewardsRecord{})
+			if err := m.HistoricalRewardsRecords[len(m.HistoricalRewardsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewardsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutstandingRewardsRecords = append(m.OutstandingRewardsRecords, OutstandingRewardsRecord{})
+			if err := m.OutstandingRewardsRecords[len(m.OutstandingRewardsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentEpochRecords = append(m.CurrentEpochRecords, CurrentEpochRecord{})
+			if err := m.CurrentEpochRecords[len(m.CurrentEpochRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingReserveCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingReserveCoins = append(m.StakingReserveCoins, types.Coin{})
+			if err := m.StakingReserveCoins[len(m.StakingReserveCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPoolCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPoolCoins = append(m.RewardPoolCoins, types.Coin{})
+			if err := m.RewardPoolCoins[len(m.RewardPoolCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastEpochTime == nil {
+				m.LastEpochTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastEpochTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochDays", wireType)
+			}
+			m.CurrentEpochDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpochDays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVestingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardVestingRecords = append(m.RewardVestingRecords, RewardVestingRecord{})
+			if err := m.RewardVestingRecords[len(m.RewardVestingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingRewardsCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingRewardsCoins = append(m.VestingRewardsCoins, types.Coin{})
+			if err := m.VestingRewardsCoins[len(m.VestingRewardsCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedStakingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedStakingRecords = append(m.LockedStakingRecords, LockedStakingRecord{})
+			if err := m.LockedStakingRecords[len(m.LockedStakingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanFarmerRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanFarmerRecords = append(m.PlanFarmerRecords, PlanFarmerRecord{})
+			if err := m.PlanFarmerRecords[len(m.PlanFarmerRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingStakings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingStakings = append(m.UnbondingStakings, UnbondingStaking{})
+			if err := m.UnbondingStakings[len(m.UnbondingStakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWithdrawAddressRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardWithdrawAddressRecords = append(m.RewardWithdrawAddressRecords, RewardWithdrawAddressRecord{})
+			if err := m.RewardWithdrawAddressRecords[len(m.RewardWithdrawAddressRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptStakingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptStakingRecords = append(m.ReceiptStakingRecords, ReceiptStakingRecord{})
+			if err := m.ReceiptStakingRecords[len(m.ReceiptStakingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedReceiptStakingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnclaimedReceiptStakingRecords = append(m.UnclaimedReceiptStakingRecords, UnclaimedReceiptStakingRecord{})
+			if err := m.UnclaimedReceiptStakingRecords[len(m.UnclaimedReceiptStakingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundFarmers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundFarmers = append(m.AutoCompoundFarmers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsDust", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsDust = append(m.RewardsDust, types.DecCoin{})
+			if err := m.RewardsDust[len(m.RewardsDust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CurrentEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlanRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlanRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlanRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingPoolCoins = append(m.FarmingPoolCoins, types.Coin{})
+			if err := m.FarmingPoolCoins[len(m.FarmingPoolCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1054,28 +2885,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1102,16 +2932,65 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanRecords = append(m.PlanRecords, PlanRecord{})
-			if err := m.PlanRecords[len(m.PlanRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedStakingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedStakingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedStakingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1121,31 +3000,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingRecords = append(m.StakingRecords, StakingRecord{})
-			if err := m.StakingRecords[len(m.StakingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedStakingRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1155,29 +3032,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueuedStakingRecords = append(m.QueuedStakingRecords, QueuedStakingRecord{})
-			if err := m.QueuedStakingRecords[len(m.QueuedStakingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRewardsRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedStaking", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1204,16 +3079,65 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HistoricalRewardsRecords = append(m.HistoricalRewardsRecords, HistoricalRewardsRecord{})
-			if err := m.HistoricalRewardsRecords[len(m.HistoricalRewardsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueuedStaking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedStakingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedStakingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedStakingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewardsRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1223,31 +3147,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutstandingRewardsRecords = append(m.OutstandingRewardsRecords, OutstandingRewardsRecord{})
-			if err := m.OutstandingRewardsRecords[len(m.OutstandingRewardsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1257,29 +3179,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentEpochRecords = append(m.CurrentEpochRecords, CurrentEpochRecord{})
-			if err := m.CurrentEpochRecords[len(m.CurrentEpochRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingReserveCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedStaking", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1306,16 +3226,65 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingReserveCoins = append(m.StakingReserveCoins, types.Coin{})
-			if err := m.StakingReserveCoins[len(m.StakingReserveCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LockedStaking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPoolCoins", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlanFarmerRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlanFarmerRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlanFarmerRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var msglen int
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1325,31 +3294,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RewardPoolCoins = append(m.RewardPoolCoins, types.Coin{})
-			if err := m.RewardPoolCoins[len(m.RewardPoolCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1359,47 +3313,24 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastEpochTime == nil {
-				m.LastEpochTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastEpochTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochDays", wireType)
-			}
-			m.CurrentEpochDays = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentEpochDays |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1421,7 +3352,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PlanRecord) Unmarshal(dAtA []byte) error {
+func (m *RewardWithdrawAddressRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1444,17 +3375,17 @@ func (m *PlanRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PlanRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardWithdrawAddressRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PlanRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardWithdrawAddressRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1464,30 +3395,29 @@ func (m *PlanRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1497,25 +3427,23 @@ func (m *PlanRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FarmingPoolCoins = append(m.FarmingPoolCoins, types.Coin{})
-			if err := m.FarmingPoolCoins[len(m.FarmingPoolCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1538,7 +3466,7 @@ func (m *PlanRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakingRecord) Unmarshal(dAtA []byte) error {
+func (m *ReceiptStakingRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1561,10 +3489,10 @@ func (m *StakingRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakingRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReceiptStakingRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReceiptStakingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1633,9 +3561,9 @@ func (m *StakingRecord) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1645,22 +3573,23 @@ func (m *StakingRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1685,7 +3614,7 @@ func (m *StakingRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuedStakingRecord) Unmarshal(dAtA []byte) error {
+func (m *UnclaimedReceiptStakingRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1708,10 +3637,10 @@ func (m *QueuedStakingRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueuedStakingRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnclaimedReceiptStakingRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueuedStakingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnclaimedReceiptStakingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1748,7 +3677,7 @@ func (m *QueuedStakingRecord) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1776,38 +3705,7 @@ func (m *QueuedStakingRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Farmer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedStaking", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.QueuedStaking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2182,6 +4080,121 @@ func (m *CurrentEpochRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RewardVestingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardVestingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardVestingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardVesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -297,6 +297,23 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			"queued staking amount must be positive: 0",
 		},
+		{
+			"invalid queued staking records - queued epoch greater than epoch number",
+			func(genState *types.GenesisState) {
+				genState.EpochNumber = 1
+				genState.QueuedStakingRecords = []types.QueuedStakingRecord{
+					{
+						StakingCoinDenom: validStakingCoinDenom,
+						Farmer:           validAcc.String(),
+						QueuedStaking: types.QueuedStaking{
+							Amount:      sdk.NewInt(1000000),
+							QueuedEpoch: 2,
+						},
+					},
+				}
+			},
+			"queued epoch 2 must not be greater than the epoch number 1",
+		},
 		{
 			"invalid historical rewards records - invalid staking coin denom",
 			func(genState *types.GenesisState) {
@@ -377,13 +394,29 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			"coin 0denom1 amount is not positive",
 		},
-		//{
-		//	"invalid current epoch days",
-		//	func(genState *types.GenesisState) {
-		//		genState.CurrentEpochDays = 0
-		//	},
-		//	"not implemented",
-		//},
+		{
+			"invalid rewards dust",
+			func(genState *types.GenesisState) {
+				genState.RewardsDust = sdk.DecCoins{sdk.NewInt64DecCoin("denom3", 0)}
+			},
+			"coin 0.000000000000000000denom3 amount is not positive",
+		},
+		{
+			"invalid current epoch duration",
+			func(genState *types.GenesisState) {
+				genState.CurrentEpochDays = 0
+				genState.CurrentEpochDuration = 0
+			},
+			"current epoch duration must be positive",
+		},
+		{
+			"legacy current epoch days",
+			func(genState *types.GenesisState) {
+				genState.CurrentEpochDays = 3
+				genState.CurrentEpochDuration = 0
+			},
+			"",
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ FarmingHooks = MultiFarmingHooks{}
+
+// MultiFarmingHooks combines multiple farming hooks, all hook functions are run in array sequence.
+type MultiFarmingHooks []FarmingHooks
+
+// NewMultiFarmingHooks returns a new MultiFarmingHooks.
+func NewMultiFarmingHooks(hooks ...FarmingHooks) MultiFarmingHooks {
+	return hooks
+}
+
+func (h MultiFarmingHooks) BeforeAdvanceEpoch(ctx sdk.Context) {
+	for i := range h {
+		h[i].BeforeAdvanceEpoch(ctx)
+	}
+}
+
+func (h MultiFarmingHooks) AfterAdvanceEpoch(ctx sdk.Context) {
+	for i := range h {
+		h[i].AfterAdvanceEpoch(ctx)
+	}
+}
+
+func (h MultiFarmingHooks) AfterAllocateRewards(ctx sdk.Context, plan PlanI, allocatedCoins sdk.Coins) {
+	for i := range h {
+		h[i].AfterAllocateRewards(ctx, plan, allocatedCoins)
+	}
+}
+
+func (h MultiFarmingHooks) AfterStake(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoins sdk.Coins) {
+	for i := range h {
+		h[i].AfterStake(ctx, farmerAcc, stakingCoins)
+	}
+}
+
+func (h MultiFarmingHooks) AfterUnstake(ctx sdk.Context, farmerAcc sdk.AccAddress, unstakingCoins sdk.Coins) {
+	for i := range h {
+		h[i].AfterUnstake(ctx, farmerAcc, unstakingCoins)
+	}
+}
+
+func (h MultiFarmingHooks) AfterHarvest(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenoms []string, rewards sdk.Coins) {
+	for i := range h {
+		h[i].AfterHarvest(ctx, farmerAcc, stakingCoinDenoms, rewards)
+	}
+}
+
+func (h MultiFarmingHooks) AfterPlanTerminated(ctx sdk.Context, plan PlanI) {
+	for i := range h {
+		h[i].AfterPlanTerminated(ctx, plan)
+	}
+}
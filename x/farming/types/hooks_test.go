@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+var _ types.FarmingHooks = &mockFarmingHooks{}
+
+// mockFarmingHooks appends the name of the called hooks to the shared log.
+type mockFarmingHooks struct {
+	name string
+	log  *[]string
+}
+
+func (h *mockFarmingHooks) record(hook string) {
+	*h.log = append(*h.log, fmt.Sprintf("%s.%s", h.name, hook))
+}
+
+func (h *mockFarmingHooks) BeforeAdvanceEpoch(sdk.Context) { h.record("BeforeAdvanceEpoch") }
+func (h *mockFarmingHooks) AfterAdvanceEpoch(sdk.Context)  { h.record("AfterAdvanceEpoch") }
+func (h *mockFarmingHooks) AfterAllocateRewards(sdk.Context, types.PlanI, sdk.Coins) {
+	h.record("AfterAllocateRewards")
+}
+func (h *mockFarmingHooks) AfterStake(sdk.Context, sdk.AccAddress, sdk.Coins) { h.record("AfterStake") }
+func (h *mockFarmingHooks) AfterUnstake(sdk.Context, sdk.AccAddress, sdk.Coins) {
+	h.record("AfterUnstake")
+}
+func (h *mockFarmingHooks) AfterHarvest(sdk.Context, sdk.AccAddress, []string, sdk.Coins) {
+	h.record("AfterHarvest")
+}
+func (h *mockFarmingHooks) AfterPlanTerminated(sdk.Context, types.PlanI) {
+	h.record("AfterPlanTerminated")
+}
+
+func TestMultiFarmingHooks(t *testing.T) {
+	var log []string
+	hooks := types.NewMultiFarmingHooks(
+		&mockFarmingHooks{name: "a", log: &log},
+		&mockFarmingHooks{name: "b", log: &log},
+	)
+
+	ctx := sdk.Context{}
+	hooks.BeforeAdvanceEpoch(ctx)
+	hooks.AfterAdvanceEpoch(ctx)
+	hooks.AfterAllocateRewards(ctx, nil, sdk.Coins{})
+	hooks.AfterStake(ctx, sdk.AccAddress{}, sdk.Coins{})
+	hooks.AfterUnstake(ctx, sdk.AccAddress{}, sdk.Coins{})
+	hooks.AfterHarvest(ctx, sdk.AccAddress{}, nil, sdk.Coins{})
+	hooks.AfterPlanTerminated(ctx, nil)
+
+	require.Equal(t, []string{
+		"a.BeforeAdvanceEpoch", "b.BeforeAdvanceEpoch",
+		"a.AfterAdvanceEpoch", "b.AfterAdvanceEpoch",
+		"a.AfterAllocateRewards", "b.AfterAllocateRewards",
+		"a.AfterStake", "b.AfterStake",
+		"a.AfterUnstake", "b.AfterUnstake",
+		"a.AfterHarvest", "b.AfterHarvest",
+		"a.AfterPlanTerminated", "b.AfterPlanTerminated",
+	}, log)
+}
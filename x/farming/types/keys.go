@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -23,21 +24,44 @@ const (
 
 // keys for farming store prefixes
 var (
-	GlobalPlanIdKey     = []byte("globalPlanId")
-	LastEpochTimeKey    = []byte("lastEpochTime")
+	GlobalPlanIdKey         = []byte("globalPlanId")
+	LastEpochTimeKey        = []byte("lastEpochTime")
+	CurrentEpochDurationKey = []byte("currentEpochDuration")
+	RewardsDustKey          = []byte("rewardsDust")
+	EpochNumberKey          = []byte("epochNumber")
+	QueuedStakingsCursorKey = []byte("queuedStakingsCursor")
+
+	// CurrentEpochDaysKey is the key of the legacy current epoch days,
+	// which is migrated to CurrentEpochDurationKey.
 	CurrentEpochDaysKey = []byte("currentEpochDays")
 
-	PlanKeyPrefix = []byte{0x11}
+	PlanKeyPrefix             = []byte{0x11}
+	PlanFarmerKeyPrefix       = []byte{0x12}
+	PlanFarmerIndexKeyPrefix  = []byte{0x13}
+	PlanTotalStakingKeyPrefix = []byte{0x14}
+	PlanEndTimeQueueKeyPrefix = []byte{0x15}
+	ActivePlanKeyPrefix       = []byte{0x16}
 
-	StakingKeyPrefix            = []byte{0x21}
-	StakingIndexKeyPrefix       = []byte{0x22}
-	QueuedStakingKeyPrefix      = []byte{0x23}
-	QueuedStakingIndexKeyPrefix = []byte{0x24}
-	TotalStakingKeyPrefix       = []byte{0x25}
+	StakingKeyPrefix                 = []byte{0x21}
+	StakingIndexKeyPrefix            = []byte{0x22}
+	QueuedStakingKeyPrefix           = []byte{0x23}
+	QueuedStakingIndexKeyPrefix      = []byte{0x24}
+	TotalStakingKeyPrefix            = []byte{0x25}
+	LockedStakingKeyPrefix           = []byte{0x26}
+	LockedStakingIndexKeyPrefix      = []byte{0x27}
+	UnbondingStakingKeyPrefix        = []byte{0x28}
+	UnbondingQueueKeyPrefix          = []byte{0x29}
+	ReceiptStakingKeyPrefix          = []byte{0x2A}
+	UnclaimedReceiptStakingKeyPrefix = []byte{0x2B}
+	TotalStakingCoinsKeyPrefix       = []byte{0x2C}
 
 	HistoricalRewardsKeyPrefix  = []byte{0x31}
 	CurrentEpochKeyPrefix       = []byte{0x32}
 	OutstandingRewardsKeyPrefix = []byte{0x33}
+	RewardWithdrawAddrKeyPrefix = []byte{0x34}
+	AutoCompoundKeyPrefix       = []byte{0x35}
+
+	RewardVestingKeyPrefix = []byte{0x41}
 )
 
 // GetPlanKey returns kv indexing key of the plan
@@ -45,6 +69,46 @@ func GetPlanKey(planID uint64) []byte {
 	return append(PlanKeyPrefix, sdk.Uint64ToBigEndian(planID)...)
 }
 
+// GetPlanFarmerKey returns a key for the farmer in the allowlist of the plan.
+func GetPlanFarmerKey(planID uint64, farmerAcc sdk.AccAddress) []byte {
+	return append(GetPlanFarmersPrefix(planID), farmerAcc...)
+}
+
+func GetPlanFarmersPrefix(planID uint64) []byte {
+	return append(PlanFarmerKeyPrefix, sdk.Uint64ToBigEndian(planID)...)
+}
+
+func GetPlanFarmerIndexKey(farmerAcc sdk.AccAddress, planID uint64) []byte {
+	return append(GetPlanFarmerIndexByFarmerPrefix(farmerAcc), sdk.Uint64ToBigEndian(planID)...)
+}
+
+func GetPlanFarmerIndexByFarmerPrefix(farmerAcc sdk.AccAddress) []byte {
+	return append(PlanFarmerIndexKeyPrefix, address.MustLengthPrefix(farmerAcc)...)
+}
+
+// GetPlanTotalStakingsKey returns a key for the total stakings of the farmers
+// in the allowlist of the plan.
+func GetPlanTotalStakingsKey(planID uint64, stakingCoinDenom string) []byte {
+	return append(append(PlanTotalStakingKeyPrefix, sdk.Uint64ToBigEndian(planID)...), []byte(stakingCoinDenom)...)
+}
+
+// GetPlanEndTimeQueueKey returns a key for the plan in the queue of the plans
+// which are not terminated yet. The key is prefixed by the end time of the plan
+// so that the plans which have ended can be iterated in order.
+func GetPlanEndTimeQueueKey(endTime time.Time, planID uint64) []byte {
+	return append(GetPlanEndTimeQueueByTimePrefix(endTime), sdk.Uint64ToBigEndian(planID)...)
+}
+
+// GetPlanEndTimeQueueByTimePrefix returns a key prefix for the plans ending at the end time.
+func GetPlanEndTimeQueueByTimePrefix(endTime time.Time) []byte {
+	return append(PlanEndTimeQueueKeyPrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+// GetActivePlanKey returns a key for the plan which is not terminated yet.
+func GetActivePlanKey(planID uint64) []byte {
+	return append(ActivePlanKeyPrefix, sdk.Uint64ToBigEndian(planID)...)
+}
+
 // GetStakingKey returns a key for staking of corresponding the id
 func GetStakingKey(stakingCoinDenom string, farmerAcc sdk.AccAddress) []byte {
 	return append(append(StakingKeyPrefix, LengthPrefixString(stakingCoinDenom)...), farmerAcc...)
@@ -74,6 +138,55 @@ func GetTotalStakingsKey(stakingCoinDenom string) []byte {
 	return append(TotalStakingKeyPrefix, []byte(stakingCoinDenom)...)
 }
 
+// GetLockedStakingKey returns a key for the locked staking of the farmer which ends at the end time.
+// The key is prefixed by the end time so that expired locked stakings can be iterated in order.
+func GetLockedStakingKey(endTime time.Time, farmerAcc sdk.AccAddress, stakingCoinDenom string) []byte {
+	return append(append(GetLockedStakingsByEndTimePrefix(endTime), address.MustLengthPrefix(farmerAcc)...), []byte(stakingCoinDenom)...)
+}
+
+func GetLockedStakingsByEndTimePrefix(endTime time.Time) []byte {
+	return append(LockedStakingKeyPrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+func GetLockedStakingIndexKey(farmerAcc sdk.AccAddress, stakingCoinDenom string, endTime time.Time) []byte {
+	return append(append(GetLockedStakingsByFarmerPrefix(farmerAcc), LengthPrefixString(stakingCoinDenom)...), sdk.FormatTimeBytes(endTime)...)
+}
+
+func GetLockedStakingsByFarmerPrefix(farmerAcc sdk.AccAddress) []byte {
+	return append(LockedStakingIndexKeyPrefix, address.MustLengthPrefix(farmerAcc)...)
+}
+
+// GetUnbondingStakingKey returns a key for the unbonding staking of the farmer.
+func GetUnbondingStakingKey(farmerAcc sdk.AccAddress) []byte {
+	return append(UnbondingStakingKeyPrefix, farmerAcc...)
+}
+
+// GetUnbondingQueueKey returns a key for the farmer in the unbonding queue
+// whose entry completes at the completion time.
+func GetUnbondingQueueKey(completionTime time.Time, farmerAcc sdk.AccAddress) []byte {
+	return append(GetUnbondingQueueByTimePrefix(completionTime), address.MustLengthPrefix(farmerAcc)...)
+}
+
+func GetUnbondingQueueByTimePrefix(completionTime time.Time) []byte {
+	return append(UnbondingQueueKeyPrefix, sdk.FormatTimeBytes(completionTime)...)
+}
+
+// GetReceiptStakingKey returns a key for the receipt-backed staking amount of the farmer.
+func GetReceiptStakingKey(stakingCoinDenom string, farmerAcc sdk.AccAddress) []byte {
+	return append(append(ReceiptStakingKeyPrefix, LengthPrefixString(stakingCoinDenom)...), farmerAcc...)
+}
+
+// GetUnclaimedReceiptStakingKey returns a key for the receipt-backed staking amount
+// whose receipts have been moved but not claimed yet.
+func GetUnclaimedReceiptStakingKey(stakingCoinDenom string) []byte {
+	return append(UnclaimedReceiptStakingKeyPrefix, []byte(stakingCoinDenom)...)
+}
+
+// GetTotalStakingCoinsKey returns a key for the total staked and queued coins of the staking coin denom.
+func GetTotalStakingCoinsKey(stakingCoinDenom string) []byte {
+	return append(TotalStakingCoinsKeyPrefix, []byte(stakingCoinDenom)...)
+}
+
 func GetHistoricalRewardsKey(stakingCoinDenom string, epoch uint64) []byte {
 	return append(append(HistoricalRewardsKeyPrefix, LengthPrefixString(stakingCoinDenom)...), sdk.Uint64ToBigEndian(epoch)...)
 }
@@ -86,6 +199,76 @@ func GetOutstandingRewardsKey(stakingCoinDenom string) []byte {
 	return append(OutstandingRewardsKeyPrefix, []byte(stakingCoinDenom)...)
 }
 
+// GetRewardWithdrawAddrKey returns a key for the reward withdraw address of the farmer.
+func GetRewardWithdrawAddrKey(farmerAcc sdk.AccAddress) []byte {
+	return append(RewardWithdrawAddrKeyPrefix, farmerAcc...)
+}
+
+// GetAutoCompoundKey returns a key for the farmer who has turned on auto-compounding.
+func GetAutoCompoundKey(farmerAcc sdk.AccAddress) []byte {
+	return append(AutoCompoundKeyPrefix, farmerAcc...)
+}
+
+// GetRewardVestingKey returns a key for the reward vesting of the farmer started at the start time.
+func GetRewardVestingKey(farmerAcc sdk.AccAddress, startTime time.Time, vestingDuration time.Duration) []byte {
+	return append(append(GetRewardVestingsByFarmerPrefix(farmerAcc), sdk.FormatTimeBytes(startTime)...), sdk.Uint64ToBigEndian(uint64(vestingDuration))...)
+}
+
+func GetRewardVestingsByFarmerPrefix(farmerAcc sdk.AccAddress) []byte {
+	return append(RewardVestingKeyPrefix, address.MustLengthPrefix(farmerAcc)...)
+}
+
+func ParsePlanFarmerKey(key []byte) (planID uint64, farmerAcc sdk.AccAddress) {
+	if !bytes.HasPrefix(key, PlanFarmerKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	planID = sdk.BigEndianToUint64(key[1:9])
+	farmerAcc = key[9:]
+	return
+}
+
+func ParsePlanFarmerIndexKey(key []byte) (farmerAcc sdk.AccAddress, planID uint64) {
+	if !bytes.HasPrefix(key, PlanFarmerIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	addrLen := key[1]
+	farmerAcc = key[2 : 2+addrLen]
+	planID = sdk.BigEndianToUint64(key[2+addrLen:])
+	return
+}
+
+func ParsePlanTotalStakingsKey(key []byte) (planID uint64, stakingCoinDenom string) {
+	if !bytes.HasPrefix(key, PlanTotalStakingKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	planID = sdk.BigEndianToUint64(key[1:9])
+	stakingCoinDenom = string(key[9:])
+	return
+}
+
+// ParsePlanEndTimeQueueKey parses a key of the plan end time queue.
+func ParsePlanEndTimeQueueKey(key []byte) (endTime time.Time, planID uint64) {
+	if !bytes.HasPrefix(key, PlanEndTimeQueueKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+	endTime, err := sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		panic(err)
+	}
+	planID = sdk.BigEndianToUint64(key[1+timeLen:])
+	return
+}
+
+// ParseActivePlanKey parses a key for the plan which is not terminated yet.
+func ParseActivePlanKey(key []byte) (planID uint64) {
+	if !bytes.HasPrefix(key, ActivePlanKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	planID = sdk.BigEndianToUint64(key[1:])
+	return
+}
+
 func ParseStakingKey(key []byte) (stakingCoinDenom string, farmerAcc sdk.AccAddress) {
 	if !bytes.HasPrefix(key, StakingKeyPrefix) {
 		panic("key does not have proper prefix")
@@ -126,6 +309,85 @@ func ParseQueuedStakingIndexKey(key []byte) (farmerAcc sdk.AccAddress, stakingCo
 	return
 }
 
+func ParseLockedStakingKey(key []byte) (endTime time.Time, farmerAcc sdk.AccAddress, stakingCoinDenom string) {
+	if !bytes.HasPrefix(key, LockedStakingKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+	endTime, err := sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		panic(err)
+	}
+	addrLen := key[1+timeLen]
+	farmerAcc = key[2+timeLen : 2+timeLen+int(addrLen)]
+	stakingCoinDenom = string(key[2+timeLen+int(addrLen):])
+	return
+}
+
+func ParseLockedStakingIndexKey(key []byte) (farmerAcc sdk.AccAddress, stakingCoinDenom string, endTime time.Time) {
+	if !bytes.HasPrefix(key, LockedStakingIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	addrLen := key[1]
+	farmerAcc = key[2 : 2+addrLen]
+	denomLen := key[2+addrLen]
+	stakingCoinDenom = string(key[3+addrLen : 3+addrLen+denomLen])
+	endTime, err := sdk.ParseTimeBytes(key[3+addrLen+denomLen:])
+	if err != nil {
+		panic(err)
+	}
+	return
+}
+
+func ParseUnbondingStakingKey(key []byte) (farmerAcc sdk.AccAddress) {
+	if !bytes.HasPrefix(key, UnbondingStakingKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	farmerAcc = key[1:]
+	return
+}
+
+func ParseUnbondingQueueKey(key []byte) (completionTime time.Time, farmerAcc sdk.AccAddress) {
+	if !bytes.HasPrefix(key, UnbondingQueueKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+	completionTime, err := sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		panic(err)
+	}
+	addrLen := key[1+timeLen]
+	farmerAcc = key[2+timeLen : 2+timeLen+int(addrLen)]
+	return
+}
+
+func ParseReceiptStakingKey(key []byte) (stakingCoinDenom string, farmerAcc sdk.AccAddress) {
+	if !bytes.HasPrefix(key, ReceiptStakingKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	denomLen := key[1]
+	stakingCoinDenom = string(key[2 : 2+denomLen])
+	farmerAcc = key[2+denomLen:]
+	return
+}
+
+func ParseUnclaimedReceiptStakingKey(key []byte) (stakingCoinDenom string) {
+	if !bytes.HasPrefix(key, UnclaimedReceiptStakingKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	stakingCoinDenom = string(key[1:])
+	return
+}
+
+// ParseTotalStakingCoinsKey parses a key for the total staked and queued coins of the staking coin denom.
+func ParseTotalStakingCoinsKey(key []byte) (stakingCoinDenom string) {
+	if !bytes.HasPrefix(key, TotalStakingCoinsKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	stakingCoinDenom = string(key[1:])
+	return
+}
+
 func ParseHistoricalRewardsKey(key []byte) (stakingCoinDenom string, epoch uint64) {
 	if !bytes.HasPrefix(key, HistoricalRewardsKeyPrefix) {
 		panic("key does not have proper prefix")
@@ -152,6 +414,31 @@ func ParseOutstandingRewardsKey(key []byte) (stakingCoinDenom string) {
 	return
 }
 
+func ParseRewardWithdrawAddrKey(key []byte) (farmerAcc sdk.AccAddress) {
+	if !bytes.HasPrefix(key, RewardWithdrawAddrKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	farmerAcc = key[1:]
+	return
+}
+
+func ParseAutoCompoundKey(key []byte) (farmerAcc sdk.AccAddress) {
+	if !bytes.HasPrefix(key, AutoCompoundKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	farmerAcc = key[1:]
+	return
+}
+
+func ParseRewardVestingKey(key []byte) (farmerAcc sdk.AccAddress) {
+	if !bytes.HasPrefix(key, RewardVestingKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	addrLen := key[1]
+	farmerAcc = key[2 : 2+addrLen]
+	return
+}
+
 // LengthPrefixString is LengthPrefix for string.
 func LengthPrefixString(s string) []byte {
 	bz := []byte(s)
@@ -1,7 +1,9 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -25,6 +27,19 @@ func (s *keysTestSuite) TestGetPlanKey() {
 	s.Require().Equal([]byte{0x11, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetPlanKey(10))
 }
 
+func (s *keysTestSuite) TestGetPlanEndTimeQueueKey() {
+	endTime := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	key := types.GetPlanEndTimeQueueKey(endTime, 10)
+	s.Require().True(bytes.HasPrefix(key, types.GetPlanEndTimeQueueByTimePrefix(endTime)))
+
+	t, planID := types.ParsePlanEndTimeQueueKey(key)
+	s.Require().True(endTime.Equal(t))
+	s.Require().Equal(uint64(10), planID)
+
+	s.Require().Equal(uint64(10), types.ParseActivePlanKey(types.GetActivePlanKey(10)))
+}
+
 func (s *keysTestSuite) TestGetStakingKey() {
 	testCases := []struct {
 		stakingCoinDenom string
@@ -255,6 +270,36 @@ func (s *keysTestSuite) TestGetCurrentEpochKey() {
 	s.Require().Equal(stakingCoinDenom, stakingCoinDenom1)
 }
 
+func (s *keysTestSuite) TestGetUnbondingQueueKey() {
+	farmerAcc := sdk.AccAddress(crypto.AddressHash([]byte("farmer1")))
+	completionTime := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	key := types.GetUnbondingQueueKey(completionTime, farmerAcc)
+	s.Require().True(bytes.HasPrefix(key, types.GetUnbondingQueueByTimePrefix(completionTime)))
+
+	t, farmer := types.ParseUnbondingQueueKey(key)
+	s.Require().True(completionTime.Equal(t))
+	s.Require().Equal(farmerAcc, farmer)
+
+	s.Require().Equal(farmerAcc, types.ParseUnbondingStakingKey(types.GetUnbondingStakingKey(farmerAcc)))
+}
+
+func (s *keysTestSuite) TestGetReceiptStakingKey() {
+	farmerAcc := sdk.AccAddress(crypto.AddressHash([]byte("farmer1")))
+
+	key := types.GetReceiptStakingKey("denom1", farmerAcc)
+	stakingCoinDenom, farmer := types.ParseReceiptStakingKey(key)
+	s.Require().Equal("denom1", stakingCoinDenom)
+	s.Require().Equal(farmerAcc, farmer)
+
+	s.Require().Equal("denom1", types.ParseUnclaimedReceiptStakingKey(types.GetUnclaimedReceiptStakingKey("denom1")))
+}
+
+func (s *keysTestSuite) TestGetAutoCompoundKey() {
+	farmerAcc := sdk.AccAddress(crypto.AddressHash([]byte("farmer1")))
+	s.Require().Equal(farmerAcc, types.ParseAutoCompoundKey(types.GetAutoCompoundKey(farmerAcc)))
+}
+
 func (s *keysTestSuite) TestLengthPrefix() {
 	denom0 := sdk.DefaultBondDenom
 	denom1 := "uatom"
@@ -282,3 +327,7 @@ func (s *keysTestSuite) TestLengthPrefix() {
 		s.Require().Equal(tc.expected, bz)
 	}
 }
+
+func (s *keysTestSuite) TestGetTotalStakingCoinsKey() {
+	s.Require().Equal("denom1", types.ParseTotalStakingCoinsKey(types.GetTotalStakingCoinsKey("denom1")))
+}
@@ -11,20 +11,46 @@ import (
 var (
 	_ sdk.Msg = (*MsgCreateFixedAmountPlan)(nil)
 	_ sdk.Msg = (*MsgCreateRatioPlan)(nil)
+	_ sdk.Msg = (*MsgCreateDecayingPlan)(nil)
+	_ sdk.Msg = (*MsgCreateSchedulePlan)(nil)
 	_ sdk.Msg = (*MsgStake)(nil)
 	_ sdk.Msg = (*MsgUnstake)(nil)
+	_ sdk.Msg = (*MsgCancelQueuedStaking)(nil)
+	_ sdk.Msg = (*MsgEmergencyUnstake)(nil)
 	_ sdk.Msg = (*MsgHarvest)(nil)
+	_ sdk.Msg = (*MsgTransferStaking)(nil)
+	_ sdk.Msg = (*MsgSyncReceiptStaking)(nil)
+	_ sdk.Msg = (*MsgTerminatePrivatePlan)(nil)
+	_ sdk.Msg = (*MsgUpdatePrivatePlan)(nil)
+	_ sdk.Msg = (*MsgClaimVestedRewards)(nil)
+	_ sdk.Msg = (*MsgAddPlanFarmers)(nil)
+	_ sdk.Msg = (*MsgRemovePlanFarmers)(nil)
+	_ sdk.Msg = (*MsgSetRewardWithdrawAddress)(nil)
+	_ sdk.Msg = (*MsgSetAutoCompound)(nil)
 	_ sdk.Msg = (*MsgAdvanceEpoch)(nil)
 )
 
 // Message types for the farming module
 const (
-	TypeMsgCreateFixedAmountPlan = "create_fixed_amount_plan"
-	TypeMsgCreateRatioPlan       = "create_ratio_plan"
-	TypeMsgStake                 = "stake"
-	TypeMsgUnstake               = "unstake"
-	TypeMsgHarvest               = "harvest"
-	TypeMsgAdvanceEpoch          = "advance_epoch"
+	TypeMsgCreateFixedAmountPlan    = "create_fixed_amount_plan"
+	TypeMsgCreateRatioPlan          = "create_ratio_plan"
+	TypeMsgCreateDecayingPlan       = "create_decaying_plan"
+	TypeMsgCreateSchedulePlan       = "create_schedule_plan"
+	TypeMsgStake                    = "stake"
+	TypeMsgUnstake                  = "unstake"
+	TypeMsgCancelQueuedStaking      = "cancel_queued_staking"
+	TypeMsgEmergencyUnstake         = "emergency_unstake"
+	TypeMsgHarvest                  = "harvest"
+	TypeMsgTransferStaking          = "transfer_staking"
+	TypeMsgSyncReceiptStaking       = "sync_receipt_staking"
+	TypeMsgTerminatePrivatePlan     = "terminate_private_plan"
+	TypeMsgUpdatePrivatePlan        = "update_private_plan"
+	TypeMsgClaimVestedRewards       = "claim_vested_rewards"
+	TypeMsgAddPlanFarmers           = "add_plan_farmers"
+	TypeMsgRemovePlanFarmers        = "remove_plan_farmers"
+	TypeMsgSetRewardWithdrawAddress = "set_reward_withdraw_address"
+	TypeMsgSetAutoCompound          = "set_auto_compound"
+	TypeMsgAdvanceEpoch             = "advance_epoch"
 )
 
 // NewMsgCreateFixedAmountPlan creates a new MsgCreateFixedAmountPlan.
@@ -72,6 +98,9 @@ func (msg MsgCreateFixedAmountPlan) ValidateBasic() error {
 	if err := msg.EpochAmount.Validate(); err != nil {
 		return err
 	}
+	if err := ValidateRewardVestingDuration(msg.RewardVestingDuration); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -140,6 +169,9 @@ func (msg MsgCreateRatioPlan) ValidateBasic() error {
 	if msg.EpochRatio.GT(sdk.NewDec(1)) {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid epoch ratio")
 	}
+	if err := ValidateRewardVestingDuration(msg.RewardVestingDuration); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -163,6 +195,146 @@ func (msg MsgCreateRatioPlan) GetCreator() sdk.AccAddress {
 	return addr
 }
 
+// NewMsgCreateDecayingPlan creates a new MsgCreateDecayingPlan.
+func NewMsgCreateDecayingPlan(
+	name string,
+	creatorAcc sdk.AccAddress,
+	stakingCoinWeights sdk.DecCoins,
+	startTime time.Time,
+	endTime time.Time,
+	epochAmount sdk.Coins,
+	decayRate sdk.Dec,
+	decayEpochs uint32,
+) *MsgCreateDecayingPlan {
+	return &MsgCreateDecayingPlan{
+		Name:               name,
+		Creator:            creatorAcc.String(),
+		StakingCoinWeights: stakingCoinWeights,
+		StartTime:          startTime,
+		EndTime:            endTime,
+		EpochAmount:        epochAmount,
+		DecayRate:          decayRate,
+		DecayEpochs:        decayEpochs,
+	}
+}
+
+func (msg MsgCreateDecayingPlan) Route() string { return RouterKey }
+
+func (msg MsgCreateDecayingPlan) Type() string { return TypeMsgCreateDecayingPlan }
+
+func (msg MsgCreateDecayingPlan) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address %q: %v", msg.Creator, err)
+	}
+	if !msg.EndTime.After(msg.StartTime) {
+		return sdkerrors.Wrapf(ErrInvalidPlanEndTime, "end time %s must be greater than start time %s", msg.EndTime.Format(time.RFC3339), msg.StartTime.Format(time.RFC3339))
+	}
+	if msg.StakingCoinWeights.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "staking coin weights must not be empty")
+	}
+	if err := msg.StakingCoinWeights.Validate(); err != nil {
+		return err
+	}
+	if ok := ValidateStakingCoinTotalWeights(msg.StakingCoinWeights); !ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "total weight must be 1")
+	}
+	if err := ValidateDecayingParams(msg.EpochAmount, msg.DecayRate, msg.DecayEpochs); err != nil {
+		return err
+	}
+	if err := ValidateRewardVestingDuration(msg.RewardVestingDuration); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgCreateDecayingPlan) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateDecayingPlan) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCreateDecayingPlan) GetCreator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgCreateSchedulePlan creates a new MsgCreateSchedulePlan.
+func NewMsgCreateSchedulePlan(
+	name string,
+	creatorAcc sdk.AccAddress,
+	stakingCoinWeights sdk.DecCoins,
+	startTime time.Time,
+	endTime time.Time,
+	phases []SchedulePhase,
+) *MsgCreateSchedulePlan {
+	return &MsgCreateSchedulePlan{
+		Name:               name,
+		Creator:            creatorAcc.String(),
+		StakingCoinWeights: stakingCoinWeights,
+		StartTime:          startTime,
+		EndTime:            endTime,
+		Phases:             phases,
+	}
+}
+
+func (msg MsgCreateSchedulePlan) Route() string { return RouterKey }
+
+func (msg MsgCreateSchedulePlan) Type() string { return TypeMsgCreateSchedulePlan }
+
+func (msg MsgCreateSchedulePlan) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address %q: %v", msg.Creator, err)
+	}
+	if !msg.EndTime.After(msg.StartTime) {
+		return sdkerrors.Wrapf(ErrInvalidPlanEndTime, "end time %s must be greater than start time %s", msg.EndTime.Format(time.RFC3339), msg.StartTime.Format(time.RFC3339))
+	}
+	if msg.StakingCoinWeights.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "staking coin weights must not be empty")
+	}
+	if err := msg.StakingCoinWeights.Validate(); err != nil {
+		return err
+	}
+	if ok := ValidateStakingCoinTotalWeights(msg.StakingCoinWeights); !ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "total weight must be 1")
+	}
+	if err := ValidateSchedulePhases(msg.Phases, msg.StartTime, msg.EndTime); err != nil {
+		return err
+	}
+	if err := ValidateRewardVestingDuration(msg.RewardVestingDuration); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgCreateSchedulePlan) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateSchedulePlan) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCreateSchedulePlan) GetCreator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // NewMsgStake creates a new MsgStake.
 func NewMsgStake(
 	farmer sdk.AccAddress,
@@ -188,6 +360,19 @@ func (msg MsgStake) ValidateBasic() error {
 	if err := msg.StakingCoins.Validate(); err != nil {
 		return err
 	}
+	if msg.LockDuration < 0 {
+		return sdkerrors.Wrapf(ErrInvalidLockDuration, "lock duration must not be negative: %s", msg.LockDuration)
+	}
+	if msg.MintReceipt {
+		if msg.LockDuration > 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "receipts can't be minted for locked coins")
+		}
+		for _, coin := range msg.StakingCoins {
+			if IsReceiptDenom(coin.Denom) {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "receipts can't be minted for receipt coins %s", coin.Denom)
+			}
+		}
+	}
 	return nil
 }
 
@@ -259,6 +444,104 @@ func (msg MsgUnstake) GetFarmer() sdk.AccAddress {
 	return addr
 }
 
+// NewMsgCancelQueuedStaking creates a new MsgCancelQueuedStaking.
+func NewMsgCancelQueuedStaking(
+	farmer sdk.AccAddress,
+	queuedCoins sdk.Coins,
+) *MsgCancelQueuedStaking {
+	return &MsgCancelQueuedStaking{
+		Farmer:      farmer.String(),
+		QueuedCoins: queuedCoins,
+	}
+}
+
+func (msg MsgCancelQueuedStaking) Route() string { return RouterKey }
+
+func (msg MsgCancelQueuedStaking) Type() string { return TypeMsgCancelQueuedStaking }
+
+func (msg MsgCancelQueuedStaking) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", msg.Farmer, err)
+	}
+	if ok := msg.QueuedCoins.IsZero(); ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "queued coins must not be zero")
+	}
+	if err := msg.QueuedCoins.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgCancelQueuedStaking) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelQueuedStaking) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCancelQueuedStaking) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgEmergencyUnstake creates a new MsgEmergencyUnstake.
+func NewMsgEmergencyUnstake(
+	farmer sdk.AccAddress,
+	stakingCoinDenoms []string,
+) *MsgEmergencyUnstake {
+	return &MsgEmergencyUnstake{
+		Farmer:            farmer.String(),
+		StakingCoinDenoms: stakingCoinDenoms,
+	}
+}
+
+func (msg MsgEmergencyUnstake) Route() string { return RouterKey }
+
+func (msg MsgEmergencyUnstake) Type() string { return TypeMsgEmergencyUnstake }
+
+func (msg MsgEmergencyUnstake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", msg.Farmer, err)
+	}
+	if len(msg.StakingCoinDenoms) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "staking coin denoms must be provided at least one")
+	}
+	for _, denom := range msg.StakingCoinDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg MsgEmergencyUnstake) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgEmergencyUnstake) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgEmergencyUnstake) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // NewMsgHarvest creates a new MsgHarvest.
 func NewMsgHarvest(
 	farmer sdk.AccAddress,
@@ -309,6 +592,515 @@ func (msg MsgHarvest) GetFarmer() sdk.AccAddress {
 	return addr
 }
 
+// NewMsgTransferStaking creates a new MsgTransferStaking.
+func NewMsgTransferStaking(farmer, recipient sdk.AccAddress, stakingCoinDenoms []string) *MsgTransferStaking {
+	return &MsgTransferStaking{
+		Farmer:            farmer.String(),
+		Recipient:         recipient.String(),
+		StakingCoinDenoms: stakingCoinDenoms,
+	}
+}
+
+func (msg MsgTransferStaking) Route() string { return RouterKey }
+
+func (msg MsgTransferStaking) Type() string { return TypeMsgTransferStaking }
+
+func (msg MsgTransferStaking) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", msg.Farmer, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address %q: %v", msg.Recipient, err)
+	}
+	if msg.Farmer == msg.Recipient {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "recipient must be different from the farmer")
+	}
+	if len(msg.StakingCoinDenoms) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "staking coin denoms must be provided at least one")
+	}
+	seen := map[string]bool{}
+	for _, denom := range msg.StakingCoinDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate staking coin denom %s", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
+func (msg MsgTransferStaking) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgTransferStaking) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgTransferStaking) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (msg MsgTransferStaking) GetRecipient() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgSyncReceiptStaking creates a new MsgSyncReceiptStaking.
+func NewMsgSyncReceiptStaking(farmer sdk.AccAddress, stakingCoinDenoms []string) *MsgSyncReceiptStaking {
+	return &MsgSyncReceiptStaking{
+		Farmer:            farmer.String(),
+		StakingCoinDenoms: stakingCoinDenoms,
+	}
+}
+
+func (msg MsgSyncReceiptStaking) Route() string { return RouterKey }
+
+func (msg MsgSyncReceiptStaking) Type() string { return TypeMsgSyncReceiptStaking }
+
+func (msg MsgSyncReceiptStaking) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", msg.Farmer, err)
+	}
+	if len(msg.StakingCoinDenoms) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "staking coin denoms must be provided at least one")
+	}
+	for _, denom := range msg.StakingCoinDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg MsgSyncReceiptStaking) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSyncReceiptStaking) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgSyncReceiptStaking) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgTerminatePrivatePlan creates a new MsgTerminatePrivatePlan.
+func NewMsgTerminatePrivatePlan(creatorAcc sdk.AccAddress, planId uint64) *MsgTerminatePrivatePlan {
+	return &MsgTerminatePrivatePlan{
+		Creator: creatorAcc.String(),
+		PlanId:  planId,
+	}
+}
+
+func (msg MsgTerminatePrivatePlan) Route() string { return RouterKey }
+
+func (msg MsgTerminatePrivatePlan) Type() string { return TypeMsgTerminatePrivatePlan }
+
+func (msg MsgTerminatePrivatePlan) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address %q: %v", msg.Creator, err)
+	}
+	if msg.PlanId == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid plan id: %d", msg.PlanId)
+	}
+	return nil
+}
+
+func (msg MsgTerminatePrivatePlan) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgTerminatePrivatePlan) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgTerminatePrivatePlan) GetCreator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgUpdatePrivatePlan creates a new MsgUpdatePrivatePlan.
+func NewMsgUpdatePrivatePlan(
+	creatorAcc sdk.AccAddress,
+	planId uint64,
+	name string,
+	stakingCoinWeights sdk.DecCoins,
+	endTime *time.Time,
+	epochAmount sdk.Coins,
+	epochRatio sdk.Dec,
+	decayRate sdk.Dec,
+	decayEpochs uint32,
+	phases []SchedulePhase,
+) *MsgUpdatePrivatePlan {
+	return &MsgUpdatePrivatePlan{
+		Creator:            creatorAcc.String(),
+		PlanId:             planId,
+		Name:               name,
+		StakingCoinWeights: stakingCoinWeights,
+		EndTime:            endTime,
+		EpochAmount:        epochAmount,
+		EpochRatio:         epochRatio,
+		DecayRate:          decayRate,
+		DecayEpochs:        decayEpochs,
+		Phases:             phases,
+	}
+}
+
+func (msg MsgUpdatePrivatePlan) Route() string { return RouterKey }
+
+func (msg MsgUpdatePrivatePlan) Type() string { return TypeMsgUpdatePrivatePlan }
+
+func (msg MsgUpdatePrivatePlan) IsForFixedAmountPlan() bool {
+	return !msg.EpochAmount.IsZero()
+}
+
+func (msg MsgUpdatePrivatePlan) IsForRatioPlan() bool {
+	return !msg.EpochRatio.IsNil() && !msg.EpochRatio.IsZero()
+}
+
+func (msg MsgUpdatePrivatePlan) IsForDecayingPlan() bool {
+	return !msg.DecayRate.IsNil() && !msg.DecayRate.IsZero()
+}
+
+func (msg MsgUpdatePrivatePlan) IsForSchedulePlan() bool {
+	return len(msg.Phases) > 0
+}
+
+func (msg MsgUpdatePrivatePlan) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address %q: %v", msg.Creator, err)
+	}
+	if msg.PlanId == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid plan id: %d", msg.PlanId)
+	}
+	if len(msg.Name) > MaxNameLength {
+		return sdkerrors.Wrapf(ErrInvalidPlanNameLength, "plan name cannot be longer than max length of %d", MaxNameLength)
+	}
+	if msg.StakingCoinWeights.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "staking coin weights must not be empty")
+	}
+	if err := msg.StakingCoinWeights.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid staking coin weights: %v", err)
+	}
+	if ok := ValidateStakingCoinTotalWeights(msg.StakingCoinWeights); !ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "total weight must be 1")
+	}
+	provided := 0
+	for _, ok := range []bool{msg.IsForFixedAmountPlan(), msg.IsForRatioPlan(), msg.IsForSchedulePlan()} {
+		if ok {
+			provided++
+		}
+	}
+	if provided != 1 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only one of epoch amount, epoch ratio or phases must be provided")
+	}
+	if msg.IsForFixedAmountPlan() {
+		if err := msg.EpochAmount.Validate(); err != nil {
+			return err
+		}
+	}
+	if msg.IsForDecayingPlan() {
+		if !msg.IsForFixedAmountPlan() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "epoch amount must be provided for a decaying plan")
+		}
+		if err := ValidateDecayingParams(msg.EpochAmount, msg.DecayRate, msg.DecayEpochs); err != nil {
+			return err
+		}
+	}
+	if msg.IsForRatioPlan() {
+		if !msg.EpochRatio.IsPositive() || msg.EpochRatio.GT(sdk.NewDec(1)) {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid epoch ratio")
+		}
+	}
+	if msg.IsForSchedulePlan() {
+		// The phases are validated against the plan's start time and end time when the plan is updated.
+		if err := ValidateSchedulePhases(msg.Phases, msg.Phases[0].StartTime, msg.Phases[len(msg.Phases)-1].EndTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg MsgUpdatePrivatePlan) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdatePrivatePlan) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgUpdatePrivatePlan) GetCreator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgClaimVestedRewards creates a new MsgClaimVestedRewards.
+func NewMsgClaimVestedRewards(farmer sdk.AccAddress) *MsgClaimVestedRewards {
+	return &MsgClaimVestedRewards{
+		Farmer: farmer.String(),
+	}
+}
+
+func (msg MsgClaimVestedRewards) Route() string { return RouterKey }
+
+func (msg MsgClaimVestedRewards) Type() string { return TypeMsgClaimVestedRewards }
+
+func (msg MsgClaimVestedRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", msg.Farmer, err)
+	}
+	return nil
+}
+
+func (msg MsgClaimVestedRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClaimVestedRewards) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgClaimVestedRewards) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgAddPlanFarmers creates a new MsgAddPlanFarmers.
+func NewMsgAddPlanFarmers(creatorAcc sdk.AccAddress, planId uint64, farmers []string) *MsgAddPlanFarmers {
+	return &MsgAddPlanFarmers{
+		Creator: creatorAcc.String(),
+		PlanId:  planId,
+		Farmers: farmers,
+	}
+}
+
+func (msg MsgAddPlanFarmers) Route() string { return RouterKey }
+
+func (msg MsgAddPlanFarmers) Type() string { return TypeMsgAddPlanFarmers }
+
+func (msg MsgAddPlanFarmers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address %q: %v", msg.Creator, err)
+	}
+	if msg.PlanId == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid plan id: %d", msg.PlanId)
+	}
+	return validatePlanFarmers(msg.Farmers)
+}
+
+func (msg MsgAddPlanFarmers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAddPlanFarmers) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgAddPlanFarmers) GetCreator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgRemovePlanFarmers creates a new MsgRemovePlanFarmers.
+func NewMsgRemovePlanFarmers(creatorAcc sdk.AccAddress, planId uint64, farmers []string) *MsgRemovePlanFarmers {
+	return &MsgRemovePlanFarmers{
+		Creator: creatorAcc.String(),
+		PlanId:  planId,
+		Farmers: farmers,
+	}
+}
+
+func (msg MsgRemovePlanFarmers) Route() string { return RouterKey }
+
+func (msg MsgRemovePlanFarmers) Type() string { return TypeMsgRemovePlanFarmers }
+
+func (msg MsgRemovePlanFarmers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address %q: %v", msg.Creator, err)
+	}
+	if msg.PlanId == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid plan id: %d", msg.PlanId)
+	}
+	return validatePlanFarmers(msg.Farmers)
+}
+
+func (msg MsgRemovePlanFarmers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemovePlanFarmers) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgRemovePlanFarmers) GetCreator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgSetRewardWithdrawAddress creates a new MsgSetRewardWithdrawAddress.
+func NewMsgSetRewardWithdrawAddress(farmer, withdrawAddr sdk.AccAddress) *MsgSetRewardWithdrawAddress {
+	return &MsgSetRewardWithdrawAddress{
+		Farmer:          farmer.String(),
+		WithdrawAddress: withdrawAddr.String(),
+	}
+}
+
+func (msg MsgSetRewardWithdrawAddress) Route() string { return RouterKey }
+
+func (msg MsgSetRewardWithdrawAddress) Type() string { return TypeMsgSetRewardWithdrawAddress }
+
+func (msg MsgSetRewardWithdrawAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", msg.Farmer, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdraw address %q: %v", msg.WithdrawAddress, err)
+	}
+	return nil
+}
+
+func (msg MsgSetRewardWithdrawAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetRewardWithdrawAddress) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgSetRewardWithdrawAddress) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (msg MsgSetRewardWithdrawAddress) GetWithdrawAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.WithdrawAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgSetAutoCompound creates a new MsgSetAutoCompound.
+func NewMsgSetAutoCompound(farmer sdk.AccAddress, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		Farmer:  farmer.String(),
+		Enabled: enabled,
+	}
+}
+
+func (msg MsgSetAutoCompound) Route() string { return RouterKey }
+
+func (msg MsgSetAutoCompound) Type() string { return TypeMsgSetAutoCompound }
+
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", msg.Farmer, err)
+	}
+	return nil
+}
+
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgSetAutoCompound) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// validatePlanFarmers validates the farmer addresses of the allowlist messages.
+func validatePlanFarmers(farmers []string) error {
+	if len(farmers) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "farmers must not be empty")
+	}
+	seen := map[string]bool{}
+	for _, farmer := range farmers {
+		if _, err := sdk.AccAddressFromBech32(farmer); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", farmer, err)
+		}
+		if seen[farmer] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate farmer address %s", farmer)
+		}
+		seen[farmer] = true
+	}
+	return nil
+}
+
 // NewMsgAdvanceEpoch creates a new MsgAdvanceEpoch.
 func NewMsgAdvanceEpoch(requesterAcc sdk.AccAddress) *MsgAdvanceEpoch {
 	return &MsgAdvanceEpoch{
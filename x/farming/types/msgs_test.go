@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -145,6 +146,174 @@ func TestMsgCreateRatioPlan(t *testing.T) {
 	}
 }
 
+func TestMsgCreateDecayingPlan(t *testing.T) {
+	name := "test"
+	creatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("creatorPoolAddr")))
+	stakingCoinWeights := sdk.NewDecCoins(sdk.DecCoin{Denom: "farmingCoinDenom", Amount: sdk.MustNewDecFromStr("1.0")})
+	startTime, _ := time.Parse(time.RFC3339, "2021-11-01T22:08:41+00:00") // needs to be deterministic for test
+	endTime := startTime.AddDate(1, 0, 0)
+	epochAmount := sdk.Coins{sdk.NewCoin("uatom", sdk.NewInt(1000))}
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgCreateDecayingPlan
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgCreateDecayingPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, epochAmount, sdk.NewDecWithPrec(5, 1), 10,
+			),
+		},
+		{
+			"invalid creator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgCreateDecayingPlan(
+				name, sdk.AccAddress{}, stakingCoinWeights,
+				startTime, endTime, epochAmount, sdk.NewDecWithPrec(5, 1), 10,
+			),
+		},
+		{
+			"end time 2020-11-01T22:08:41Z must be greater than start time 2021-11-01T22:08:41Z: invalid plan end time",
+			types.NewMsgCreateDecayingPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, startTime.AddDate(-1, 0, 0), epochAmount, sdk.NewDecWithPrec(5, 1), 10,
+			),
+		},
+		{
+			"staking coin weights must not be empty: invalid request",
+			types.NewMsgCreateDecayingPlan(
+				name, creatorAddr, sdk.NewDecCoins(),
+				startTime, endTime, epochAmount, sdk.NewDecWithPrec(5, 1), 10,
+			),
+		},
+		{
+			"epoch amount must not be empty: invalid request",
+			types.NewMsgCreateDecayingPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, sdk.Coins{}, sdk.NewDecWithPrec(5, 1), 10,
+			),
+		},
+		{
+			"decay rate must be between 0 and 1 exclusively: 0.000000000000000000: invalid decay rate",
+			types.NewMsgCreateDecayingPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, epochAmount, sdk.ZeroDec(), 10,
+			),
+		},
+		{
+			"decay rate must be between 0 and 1 exclusively: 1.000000000000000000: invalid decay rate",
+			types.NewMsgCreateDecayingPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, epochAmount, sdk.OneDec(), 10,
+			),
+		},
+		{
+			"decay epochs must be positive: invalid decay epochs",
+			types.NewMsgCreateDecayingPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, epochAmount, sdk.NewDecWithPrec(5, 1), 0,
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgCreateDecayingPlan{}, tc.msg)
+		require.Equal(t, types.TypeMsgCreateDecayingPlan, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetCreator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgCreateSchedulePlan(t *testing.T) {
+	name := "test"
+	creatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("creatorPoolAddr")))
+	stakingCoinWeights := sdk.NewDecCoins(sdk.DecCoin{Denom: "farmingCoinDenom", Amount: sdk.MustNewDecFromStr("1.0")})
+	startTime, _ := time.Parse(time.RFC3339, "2021-11-01T22:08:41+00:00") // needs to be deterministic for test
+	endTime := startTime.AddDate(1, 0, 0)
+	phases := []types.SchedulePhase{
+		types.NewSchedulePhase(startTime, startTime.AddDate(0, 1, 0), sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))),
+		types.NewSchedulePhase(startTime.AddDate(0, 1, 0), endTime, sdk.NewCoins(sdk.NewInt64Coin("uatom", 500))),
+	}
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgCreateSchedulePlan
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgCreateSchedulePlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, phases,
+			),
+		},
+		{
+			"invalid creator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgCreateSchedulePlan(
+				name, sdk.AccAddress{}, stakingCoinWeights,
+				startTime, endTime, phases,
+			),
+		},
+		{
+			"end time 2020-11-01T22:08:41Z must be greater than start time 2021-11-01T22:08:41Z: invalid plan end time",
+			types.NewMsgCreateSchedulePlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, startTime.AddDate(-1, 0, 0), phases,
+			),
+		},
+		{
+			"staking coin weights must not be empty: invalid request",
+			types.NewMsgCreateSchedulePlan(
+				name, creatorAddr, sdk.NewDecCoins(),
+				startTime, endTime, phases,
+			),
+		},
+		{
+			"phases must not be empty: invalid schedule phases",
+			types.NewMsgCreateSchedulePlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, []types.SchedulePhase{},
+			),
+		},
+		{
+			"phase 1: epoch amount must not be empty: invalid schedule phases",
+			types.NewMsgCreateSchedulePlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, []types.SchedulePhase{
+					phases[0],
+					types.NewSchedulePhase(phases[1].StartTime, phases[1].EndTime, sdk.Coins{}),
+				},
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgCreateSchedulePlan{}, tc.msg)
+		require.Equal(t, types.TypeMsgCreateSchedulePlan, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetCreator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
 func TestMsgStake(t *testing.T) {
 	farmingPoolAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmingPoolAddr")))
 	stakingCoins := sdk.NewCoins(sdk.NewCoin("farmingCoinDenom", sdk.NewInt(1)))
@@ -165,6 +334,22 @@ func TestMsgStake(t *testing.T) {
 			"staking coins must not be zero: invalid request",
 			types.NewMsgStake(farmingPoolAddr, sdk.NewCoins(sdk.NewCoin("farmingCoinDenom", sdk.NewInt(0)))),
 		},
+		{
+			"lock duration must not be negative: -1h0m0s: invalid lock duration",
+			&types.MsgStake{Farmer: farmingPoolAddr.String(), StakingCoins: stakingCoins, LockDuration: -time.Hour},
+		},
+		{
+			"",
+			&types.MsgStake{Farmer: farmingPoolAddr.String(), StakingCoins: stakingCoins, MintReceipt: true},
+		},
+		{
+			"receipts can't be minted for locked coins: invalid request",
+			&types.MsgStake{Farmer: farmingPoolAddr.String(), StakingCoins: stakingCoins, LockDuration: time.Hour, MintReceipt: true},
+		},
+		{
+			"receipts can't be minted for receipt coins farm/farmingCoinDenom: invalid request",
+			&types.MsgStake{Farmer: farmingPoolAddr.String(), StakingCoins: sdk.NewCoins(sdk.NewInt64Coin("farm/farmingCoinDenom", 1)), MintReceipt: true},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -225,6 +410,90 @@ func TestMsgUnstake(t *testing.T) {
 	}
 }
 
+func TestMsgCancelQueuedStaking(t *testing.T) {
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmer")))
+	queuedCoins := sdk.NewCoins(sdk.NewCoin("farmingCoinDenom", sdk.NewInt(1)))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgCancelQueuedStaking
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgCancelQueuedStaking(farmerAddr, queuedCoins),
+		},
+		{
+			"invalid farmer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgCancelQueuedStaking(sdk.AccAddress{}, queuedCoins),
+		},
+		{
+			"queued coins must not be zero: invalid request",
+			types.NewMsgCancelQueuedStaking(farmerAddr, sdk.NewCoins(sdk.NewInt64Coin("farmingCoinDenom", 0))),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgCancelQueuedStaking{}, tc.msg)
+		require.Equal(t, types.TypeMsgCancelQueuedStaking, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetFarmer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgEmergencyUnstake(t *testing.T) {
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmer")))
+	stakingCoinDenoms := []string{"uatom", "uiris"}
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgEmergencyUnstake
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgEmergencyUnstake(farmerAddr, stakingCoinDenoms),
+		},
+		{
+			"invalid farmer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgEmergencyUnstake(sdk.AccAddress{}, stakingCoinDenoms),
+		},
+		{
+			"staking coin denoms must be provided at least one: invalid request",
+			types.NewMsgEmergencyUnstake(farmerAddr, []string{}),
+		},
+		{
+			"invalid denom: !",
+			types.NewMsgEmergencyUnstake(farmerAddr, []string{"!"}),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgEmergencyUnstake{}, tc.msg)
+		require.Equal(t, types.TypeMsgEmergencyUnstake, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetFarmer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
 func TestMsgHarvest(t *testing.T) {
 	farmingPoolAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmingPoolAddr")))
 	stakingCoinDenoms := []string{"uatom", "uiris", "ukava"}
@@ -264,3 +533,464 @@ func TestMsgHarvest(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgTerminatePrivatePlan(t *testing.T) {
+	creatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("creatorAddr")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgTerminatePrivatePlan
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgTerminatePrivatePlan(creatorAddr, 1),
+		},
+		{
+			"invalid creator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgTerminatePrivatePlan(sdk.AccAddress{}, 1),
+		},
+		{
+			"invalid plan id: 0: invalid request",
+			types.NewMsgTerminatePrivatePlan(creatorAddr, 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgTerminatePrivatePlan{}, tc.msg)
+		require.Equal(t, types.TypeMsgTerminatePrivatePlan, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetCreator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgAddPlanFarmers(t *testing.T) {
+	creatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("creatorAddr")))
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmerAddr")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgAddPlanFarmers
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgAddPlanFarmers(creatorAddr, 1, []string{farmerAddr.String()}),
+		},
+		{
+			"invalid creator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgAddPlanFarmers(sdk.AccAddress{}, 1, []string{farmerAddr.String()}),
+		},
+		{
+			"invalid plan id: 0: invalid request",
+			types.NewMsgAddPlanFarmers(creatorAddr, 0, []string{farmerAddr.String()}),
+		},
+		{
+			"farmers must not be empty: invalid request",
+			types.NewMsgAddPlanFarmers(creatorAddr, 1, []string{}),
+		},
+		{
+			"invalid farmer address \"invalid\": decoding bech32 failed: invalid bech32 string length 7: invalid address",
+			types.NewMsgAddPlanFarmers(creatorAddr, 1, []string{"invalid"}),
+		},
+		{
+			fmt.Sprintf("duplicate farmer address %s: invalid request", farmerAddr),
+			types.NewMsgAddPlanFarmers(creatorAddr, 1, []string{farmerAddr.String(), farmerAddr.String()}),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgAddPlanFarmers{}, tc.msg)
+		require.Equal(t, types.TypeMsgAddPlanFarmers, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetCreator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgRemovePlanFarmers(t *testing.T) {
+	creatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("creatorAddr")))
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmerAddr")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgRemovePlanFarmers
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgRemovePlanFarmers(creatorAddr, 1, []string{farmerAddr.String()}),
+		},
+		{
+			"invalid creator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgRemovePlanFarmers(sdk.AccAddress{}, 1, []string{farmerAddr.String()}),
+		},
+		{
+			"invalid plan id: 0: invalid request",
+			types.NewMsgRemovePlanFarmers(creatorAddr, 0, []string{farmerAddr.String()}),
+		},
+		{
+			"farmers must not be empty: invalid request",
+			types.NewMsgRemovePlanFarmers(creatorAddr, 1, nil),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgRemovePlanFarmers{}, tc.msg)
+		require.Equal(t, types.TypeMsgRemovePlanFarmers, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetCreator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgUpdatePrivatePlan(t *testing.T) {
+	creatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("creatorAddr")))
+	stakingCoinWeights := sdk.NewDecCoins(
+		sdk.DecCoin{Denom: "testFarmStakingCoinDenom", Amount: sdk.MustNewDecFromStr("1.0")},
+	)
+	endTime := time.Now().UTC().AddDate(0, 1, 0)
+	phases := []types.SchedulePhase{
+		types.NewSchedulePhase(endTime.AddDate(0, -1, 0), endTime, sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1)))),
+	}
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgUpdatePrivatePlan
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgUpdatePrivatePlan(creatorAddr, 1, "new name", stakingCoinWeights, &endTime,
+				sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1))), sdk.Dec{}, sdk.Dec{}, 0, nil),
+		},
+		{
+			"", // empty means no error expected
+			types.NewMsgUpdatePrivatePlan(creatorAddr, 1, "", stakingCoinWeights, nil,
+				nil, sdk.NewDecWithPrec(5, 1), sdk.Dec{}, 0, nil),
+		},
+		{
+			"invalid creator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgUpdatePrivatePlan(sdk.AccAddress{}, 1, "", stakingCoinWeights, nil,
+				nil, sdk.NewDecWithPrec(5, 1), sdk.Dec{}, 0, nil),
+		},
+		{
+			"invalid plan id: 0: invalid request",
+			types.NewMsgUpdatePrivatePlan(creatorAddr, 0, "", stakingCoinWeights, nil,
+				nil, sdk.NewDecWithPrec(5, 1), sdk.Dec{}, 0, nil),
+		},
+		{
+			"staking coin weights must not be empty: invalid request",
+			types.NewMsgUpdatePrivatePlan(creatorAddr, 1, "", sdk.NewDecCoins(), nil,
+				nil, sdk.NewDecWithPrec(5, 1), sdk.Dec{}, 0, nil),
+		},
+		{
+			"total weight must be 1: invalid request",
+			types.NewMsgUpdatePrivatePlan(creatorAddr, 1, "", sdk.NewDecCoins(
+				sdk.DecCoin{Denom: "testFarmStakingCoinDenom", Amount: sdk.MustNewDecFromStr("0.5")},
+			), nil, nil, sdk.NewDecWithPrec(5, 1), sdk.Dec{}, 0, nil),
+		},
+		{
+			"only one of epoch amount, epoch ratio or phases must be provided: invalid request",
+			types.NewMsgUpdatePrivatePlan(creatorAddr, 1, "", stakingCoinWeights, nil,
+				sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1))), sdk.NewDecWithPrec(5, 1), sdk.Dec{}, 0, nil),
+		},
+		{
+			"only one of epoch amount, epoch ratio or phases must be provided: invalid request",
+			types.NewMsgUpdatePrivatePlan(creatorAddr, 1, "", stakingCoinWeights, nil,
+				nil, sdk.Dec{}, sdk.Dec{}, 0, nil),
+		},
+		{
+			"invalid epoch ratio: invalid request",
+			types.NewMsgUpdatePrivatePlan(creatorAddr, 1, "", stakingCoinWeights, nil,
+				nil, sdk.NewDec(2), sdk.Dec{}, 0, nil),
+		},
+		{
+			"", // empty means no error expected
+			types.NewMsgUpdatePrivatePlan(creatorAddr, 1, "", stakingCoinWeights, nil,
+				sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1))), sdk.Dec{}, sdk.NewDecWithPrec(5, 1), 10, nil),
+		},
+		{
+			"epoch amount must be provided for a decaying plan: invalid request",
+			types.NewMsgUpdatePrivatePlan(creatorAddr, 1, "", stakingCoinWeights, nil,
+				nil, sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), 10, nil),
+		},
+		{
+			"decay epochs must be positive: invalid decay epochs",
+			types.NewMsgUpdatePrivatePlan(creatorAddr, 1, "", stakingCoinWeights, nil,
+				sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1))), sdk.Dec{}, sdk.NewDecWithPrec(5, 1), 0, nil),
+		},
+		{
+			"", // empty means no error expected
+			types.NewMsgUpdatePrivatePlan(creatorAddr, 1, "", stakingCoinWeights, &endTime,
+				nil, sdk.Dec{}, sdk.Dec{}, 0, phases),
+		},
+		{
+			"only one of epoch amount, epoch ratio or phases must be provided: invalid request",
+			types.NewMsgUpdatePrivatePlan(creatorAddr, 1, "", stakingCoinWeights, nil,
+				sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1))), sdk.Dec{}, sdk.Dec{}, 0, phases),
+		},
+		{
+			"phase 0: epoch amount must not be empty: invalid schedule phases",
+			types.NewMsgUpdatePrivatePlan(creatorAddr, 1, "", stakingCoinWeights, nil,
+				nil, sdk.Dec{}, sdk.Dec{}, 0, []types.SchedulePhase{
+					types.NewSchedulePhase(endTime.AddDate(0, -1, 0), endTime, sdk.Coins{}),
+				}),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgUpdatePrivatePlan{}, tc.msg)
+		require.Equal(t, types.TypeMsgUpdatePrivatePlan, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetCreator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgClaimVestedRewards(t *testing.T) {
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmer")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgClaimVestedRewards
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgClaimVestedRewards(farmerAddr),
+		},
+		{
+			"invalid farmer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgClaimVestedRewards(sdk.AccAddress{}),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgClaimVestedRewards{}, tc.msg)
+		require.Equal(t, types.TypeMsgClaimVestedRewards, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetFarmer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgSetRewardWithdrawAddress(t *testing.T) {
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmer")))
+	withdrawAddr := sdk.AccAddress(crypto.AddressHash([]byte("withdraw")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgSetRewardWithdrawAddress
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgSetRewardWithdrawAddress(farmerAddr, withdrawAddr),
+		},
+		{
+			"invalid farmer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgSetRewardWithdrawAddress(sdk.AccAddress{}, withdrawAddr),
+		},
+		{
+			"invalid withdraw address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgSetRewardWithdrawAddress(farmerAddr, sdk.AccAddress{}),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgSetRewardWithdrawAddress{}, tc.msg)
+		require.Equal(t, types.TypeMsgSetRewardWithdrawAddress, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetFarmer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgTransferStaking(t *testing.T) {
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmer")))
+	recipientAddr := sdk.AccAddress(crypto.AddressHash([]byte("recipient")))
+	stakingCoinDenoms := []string{"denom1", "denom2"}
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgTransferStaking
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgTransferStaking(farmerAddr, recipientAddr, stakingCoinDenoms),
+		},
+		{
+			"invalid farmer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgTransferStaking(sdk.AccAddress{}, recipientAddr, stakingCoinDenoms),
+		},
+		{
+			"invalid recipient address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgTransferStaking(farmerAddr, sdk.AccAddress{}, stakingCoinDenoms),
+		},
+		{
+			"recipient must be different from the farmer: invalid request",
+			types.NewMsgTransferStaking(farmerAddr, farmerAddr, stakingCoinDenoms),
+		},
+		{
+			"staking coin denoms must be provided at least one: invalid request",
+			types.NewMsgTransferStaking(farmerAddr, recipientAddr, []string{}),
+		},
+		{
+			"invalid denom: ",
+			types.NewMsgTransferStaking(farmerAddr, recipientAddr, []string{""}),
+		},
+		{
+			"duplicate staking coin denom denom1: invalid request",
+			types.NewMsgTransferStaking(farmerAddr, recipientAddr, []string{"denom1", "denom1"}),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgTransferStaking{}, tc.msg)
+		require.Equal(t, types.TypeMsgTransferStaking, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetFarmer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgSyncReceiptStaking(t *testing.T) {
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmer")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgSyncReceiptStaking
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgSyncReceiptStaking(farmerAddr, []string{"denom1"}),
+		},
+		{
+			"invalid farmer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgSyncReceiptStaking(sdk.AccAddress{}, []string{"denom1"}),
+		},
+		{
+			"staking coin denoms must be provided at least one: invalid request",
+			types.NewMsgSyncReceiptStaking(farmerAddr, []string{}),
+		},
+		{
+			"invalid denom: ",
+			types.NewMsgSyncReceiptStaking(farmerAddr, []string{""}),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgSyncReceiptStaking{}, tc.msg)
+		require.Equal(t, types.TypeMsgSyncReceiptStaking, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetFarmer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgSetAutoCompound(t *testing.T) {
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmer")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgSetAutoCompound
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgSetAutoCompound(farmerAddr, true),
+		},
+		{
+			"", // empty means no error expected
+			types.NewMsgSetAutoCompound(farmerAddr, false),
+		},
+		{
+			"invalid farmer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgSetAutoCompound(sdk.AccAddress{}, true),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgSetAutoCompound{}, tc.msg)
+		require.Equal(t, types.TypeMsgSetAutoCompound, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetFarmer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
@@ -15,15 +16,53 @@ var (
 	KeyPrivatePlanCreationFee = []byte("PrivatePlanCreationFee")
 	KeyNextEpochDays          = []byte("NextEpochDays")
 	KeyFarmingFeeCollector    = []byte("FarmingFeeCollector")
+	KeyLockMultipliers        = []byte("LockMultipliers")
+	KeyUnstakingPeriod        = []byte("UnstakingPeriod")
+	KeyAllowedStakingDenoms   = []byte("AllowedStakingDenoms")
+	KeyMaxTotalStakings       = []byte("MaxTotalStakings")
+	KeyMinStakingAmounts      = []byte("MinStakingAmounts")
+	KeyPausedOperations       = []byte("PausedOperations")
+	KeyNextEpochDuration      = []byte("NextEpochDuration")
 
 	DefaultPrivatePlanCreationFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100_000_000)))
 	DefaultCurrentEpochDays       = uint32(1)
+	DefaultCurrentEpochDuration   = 24 * time.Hour
 	DefaultNextEpochDays          = uint32(1)
 	DefaultFarmingFeeCollector    = sdk.AccAddress(address.Module(ModuleName, []byte("FarmingFeeCollectorAcc"))).String()
-	StakingReserveAcc             = sdk.AccAddress(address.Module(ModuleName, []byte("StakingReserveAcc")))
-	RewardsReserveAcc             = sdk.AccAddress(address.Module(ModuleName, []byte("RewardsReserveAcc")))
+	DefaultLockMultipliers        = []LockMultiplier{
+		{LockDuration: 30 * 24 * time.Hour, Multiplier: sdk.NewDecWithPrec(11, 1)},  // 1.1x for 30 days
+		{LockDuration: 90 * 24 * time.Hour, Multiplier: sdk.NewDecWithPrec(125, 2)}, // 1.25x for 90 days
+		{LockDuration: 180 * 24 * time.Hour, Multiplier: sdk.NewDecWithPrec(15, 1)}, // 1.5x for 180 days
+	}
+	DefaultUnstakingPeriod      = time.Duration(0)
+	DefaultAllowedStakingDenoms = []string{}
+	DefaultMaxTotalStakings     = sdk.Coins{}
+	DefaultMinStakingAmounts    = sdk.Coins{}
+	DefaultPausedOperations     = []string{}
+	DefaultNextEpochDuration    = time.Duration(0)
+	StakingReserveAcc           = sdk.AccAddress(address.Module(ModuleName, []byte("StakingReserveAcc")))
+	RewardsReserveAcc           = sdk.AccAddress(address.Module(ModuleName, []byte("RewardsReserveAcc")))
+	VestingRewardsAcc           = sdk.AccAddress(address.Module(ModuleName, []byte("VestingRewardsAcc")))
+)
+
+// Operations which can be paused in an emergency
+const (
+	OperationStaking          = "staking"
+	OperationHarvesting       = "harvesting"
+	OperationPlanCreation     = "plan_creation"
+	OperationEpochAdvancement = "epoch_advancement"
 )
 
+// IsValidOperation returns whether the operation can be paused.
+func IsValidOperation(operation string) bool {
+	switch operation {
+	case OperationStaking, OperationHarvesting, OperationPlanCreation, OperationEpochAdvancement:
+		return true
+	default:
+		return false
+	}
+}
+
 var _ paramstypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable returns the parameter key table.
@@ -37,6 +76,13 @@ func DefaultParams() Params {
 		PrivatePlanCreationFee: DefaultPrivatePlanCreationFee,
 		NextEpochDays:          DefaultNextEpochDays,
 		FarmingFeeCollector:    DefaultFarmingFeeCollector,
+		LockMultipliers:        DefaultLockMultipliers,
+		UnstakingPeriod:        DefaultUnstakingPeriod,
+		AllowedStakingDenoms:   DefaultAllowedStakingDenoms,
+		MaxTotalStakings:       DefaultMaxTotalStakings,
+		MinStakingAmounts:      DefaultMinStakingAmounts,
+		PausedOperations:       DefaultPausedOperations,
+		NextEpochDuration:      DefaultNextEpochDuration,
 	}
 }
 
@@ -46,6 +92,13 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyPrivatePlanCreationFee, &p.PrivatePlanCreationFee, validatePrivatePlanCreationFee),
 		paramstypes.NewParamSetPair(KeyNextEpochDays, &p.NextEpochDays, validateNextEpochDays),
 		paramstypes.NewParamSetPair(KeyFarmingFeeCollector, &p.FarmingFeeCollector, validateFarmingFeeCollector),
+		paramstypes.NewParamSetPair(KeyLockMultipliers, &p.LockMultipliers, validateLockMultipliers),
+		paramstypes.NewParamSetPair(KeyUnstakingPeriod, &p.UnstakingPeriod, validateUnstakingPeriod),
+		paramstypes.NewParamSetPair(KeyAllowedStakingDenoms, &p.AllowedStakingDenoms, validateAllowedStakingDenoms),
+		paramstypes.NewParamSetPair(KeyMaxTotalStakings, &p.MaxTotalStakings, validateMaxTotalStakings),
+		paramstypes.NewParamSetPair(KeyMinStakingAmounts, &p.MinStakingAmounts, validateMinStakingAmounts),
+		paramstypes.NewParamSetPair(KeyPausedOperations, &p.PausedOperations, validatePausedOperations),
+		paramstypes.NewParamSetPair(KeyNextEpochDuration, &p.NextEpochDuration, validateNextEpochDuration),
 	}
 }
 
@@ -64,6 +117,13 @@ func (p Params) Validate() error {
 		{p.PrivatePlanCreationFee, validatePrivatePlanCreationFee},
 		{p.NextEpochDays, validateNextEpochDays},
 		{p.FarmingFeeCollector, validateFarmingFeeCollector},
+		{p.LockMultipliers, validateLockMultipliers},
+		{p.UnstakingPeriod, validateUnstakingPeriod},
+		{p.AllowedStakingDenoms, validateAllowedStakingDenoms},
+		{p.MaxTotalStakings, validateMaxTotalStakings},
+		{p.MinStakingAmounts, validateMinStakingAmounts},
+		{p.PausedOperations, validatePausedOperations},
+		{p.NextEpochDuration, validateNextEpochDuration},
 	} {
 		if err := v.validator(v.value); err != nil {
 			return err
@@ -72,6 +132,50 @@ func (p Params) Validate() error {
 	return nil
 }
 
+// EpochDuration returns the epoch length which is applied from the next epoch.
+// NextEpochDuration takes precedence over NextEpochDays unless it is zero.
+func (p Params) EpochDuration() time.Duration {
+	if p.NextEpochDuration > 0 {
+		return p.NextEpochDuration
+	}
+	return time.Duration(p.NextEpochDays) * 24 * time.Hour
+}
+
+// GetLockMultiplier returns the reward multiplier of the lock duration.
+// It returns false if the lock duration is not allowed.
+func (p Params) GetLockMultiplier(lockDuration time.Duration) (sdk.Dec, bool) {
+	for _, m := range p.LockMultipliers {
+		if m.LockDuration == lockDuration {
+			return m.Multiplier, true
+		}
+	}
+	return sdk.Dec{}, false
+}
+
+// IsStakingDenomAllowed returns whether the coins of the denom can be staked.
+// Coins of any denom can be staked if the allowed staking denoms are empty.
+func (p Params) IsStakingDenomAllowed(denom string) bool {
+	if len(p.AllowedStakingDenoms) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedStakingDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOperationPaused returns whether the operation is paused.
+func (p Params) IsOperationPaused(operation string) bool {
+	for _, paused := range p.PausedOperations {
+		if paused == operation {
+			return true
+		}
+	}
+	return false
+}
+
 func validatePrivatePlanCreationFee(i interface{}) error {
 	v, ok := i.(sdk.Coins)
 	if !ok {
@@ -115,3 +219,118 @@ func validateFarmingFeeCollector(i interface{}) error {
 
 	return nil
 }
+
+func validateLockMultipliers(i interface{}) error {
+	v, ok := i.([]LockMultiplier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	durations := map[time.Duration]struct{}{}
+	for _, m := range v {
+		if m.LockDuration <= 0 {
+			return fmt.Errorf("lock duration must be positive: %s", m.LockDuration)
+		}
+		if _, ok := durations[m.LockDuration]; ok {
+			return fmt.Errorf("duplicate lock duration: %s", m.LockDuration)
+		}
+		durations[m.LockDuration] = struct{}{}
+		if m.Multiplier.IsNil() || m.Multiplier.LT(sdk.OneDec()) {
+			return fmt.Errorf("lock multiplier must not be less than 1: %s", m.Multiplier)
+		}
+	}
+
+	return nil
+}
+
+func validateUnstakingPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("unstaking period must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateAllowedStakingDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := map[string]struct{}{}
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if _, ok := denoms[denom]; ok {
+			return fmt.Errorf("duplicate allowed staking denom: %s", denom)
+		}
+		denoms[denom] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateMaxTotalStakings(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateMinStakingAmounts(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validatePausedOperations(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	operations := map[string]struct{}{}
+	for _, operation := range v {
+		if !IsValidOperation(operation) {
+			return fmt.Errorf("unknown operation: %s", operation)
+		}
+		if _, ok := operations[operation]; ok {
+			return fmt.Errorf("duplicate paused operation: %s", operation)
+		}
+		operations[operation] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateNextEpochDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("next epoch duration must not be negative: %s", v)
+	}
+
+	return nil
+}
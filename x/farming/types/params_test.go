@@ -3,6 +3,7 @@ package types_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -22,6 +23,19 @@ func TestParams(t *testing.T) {
   amount: "100000000"
 next_epoch_days: 1
 farming_fee_collector: cosmos1h292smhhttwy0rl3qr4p6xsvpvxc4v05s6rxtczwq3cs6qc462mqejwy8x
+lock_multipliers:
+- lock_duration: 720h0m0s
+  multiplier: "1.100000000000000000"
+- lock_duration: 2160h0m0s
+  multiplier: "1.250000000000000000"
+- lock_duration: 4320h0m0s
+  multiplier: "1.500000000000000000"
+unstaking_period: 0s
+allowed_staking_denoms: []
+max_total_stakings: []
+min_staking_amounts: []
+paused_operations: []
+next_epoch_duration: 0s
 `
 	require.Equal(t, paramsStr, defaultParams.String())
 }
@@ -55,6 +69,93 @@ func TestParamsValidate(t *testing.T) {
 			},
 			"farming fee collector address must not be empty",
 		},
+		{
+			"EmptyLockMultipliers",
+			func(params *types.Params) {
+				params.LockMultipliers = nil
+			},
+			"",
+		},
+		{
+			"ZeroLockDuration",
+			func(params *types.Params) {
+				params.LockMultipliers = []types.LockMultiplier{{LockDuration: 0, Multiplier: sdk.OneDec()}}
+			},
+			"lock duration must be positive: 0s",
+		},
+		{
+			"DuplicateLockDuration",
+			func(params *types.Params) {
+				params.LockMultipliers = []types.LockMultiplier{
+					{LockDuration: time.Hour, Multiplier: sdk.OneDec()},
+					{LockDuration: time.Hour, Multiplier: sdk.NewDec(2)},
+				}
+			},
+			"duplicate lock duration: 1h0m0s",
+		},
+		{
+			"LockMultiplierLessThanOne",
+			func(params *types.Params) {
+				params.LockMultipliers = []types.LockMultiplier{{LockDuration: time.Hour, Multiplier: sdk.NewDecWithPrec(9, 1)}}
+			},
+			"lock multiplier must not be less than 1: 0.900000000000000000",
+		},
+		{
+			"NegativeUnstakingPeriod",
+			func(params *types.Params) {
+				params.UnstakingPeriod = -time.Hour
+			},
+			"unstaking period must not be negative: -1h0m0s",
+		},
+		{
+			"InvalidAllowedStakingDenom",
+			func(params *types.Params) {
+				params.AllowedStakingDenoms = []string{"!"}
+			},
+			"invalid denom: !",
+		},
+		{
+			"DuplicateAllowedStakingDenom",
+			func(params *types.Params) {
+				params.AllowedStakingDenoms = []string{"denom1", "denom1"}
+			},
+			"duplicate allowed staking denom: denom1",
+		},
+		{
+			"ZeroMaxTotalStaking",
+			func(params *types.Params) {
+				params.MaxTotalStakings = sdk.Coins{sdk.NewInt64Coin("denom1", 0)}
+			},
+			"coin 0denom1 amount is not positive",
+		},
+		{
+			"UnsortedMinStakingAmounts",
+			func(params *types.Params) {
+				params.MinStakingAmounts = sdk.Coins{sdk.NewInt64Coin("denom2", 1), sdk.NewInt64Coin("denom1", 1)}
+			},
+			"denomination denom1 is not sorted",
+		},
+		{
+			"UnknownPausedOperation",
+			func(params *types.Params) {
+				params.PausedOperations = []string{types.OperationStaking, "unstaking"}
+			},
+			"unknown operation: unstaking",
+		},
+		{
+			"DuplicatePausedOperation",
+			func(params *types.Params) {
+				params.PausedOperations = []string{types.OperationStaking, types.OperationStaking}
+			},
+			"duplicate paused operation: staking",
+		},
+		{
+			"NegativeNextEpochDuration",
+			func(params *types.Params) {
+				params.NextEpochDuration = -time.Hour
+			},
+			"next epoch duration must not be negative: -1h0m0s",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -81,3 +182,12 @@ func TestParamsValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestParamsEpochDuration(t *testing.T) {
+	params := types.DefaultParams()
+	params.NextEpochDays = 3
+	require.Equal(t, 72*time.Hour, params.EpochDuration())
+
+	params.NextEpochDuration = time.Hour
+	require.Equal(t, time.Hour, params.EpochDuration())
+}
@@ -22,6 +22,8 @@ const (
 var (
 	_ PlanI = (*FixedAmountPlan)(nil)
 	_ PlanI = (*RatioPlan)(nil)
+	_ PlanI = (*DecayingPlan)(nil)
+	_ PlanI = (*SchedulePlan)(nil)
 )
 
 // NewBasePlan creates a new BasePlan object
@@ -144,19 +146,39 @@ func (plan *BasePlan) SetDistributedCoins(distributedCoins sdk.Coins) error {
 	return nil
 }
 
+func (plan BasePlan) GetRewardVestingDuration() time.Duration {
+	return plan.RewardVestingDuration
+}
+
+func (plan *BasePlan) SetRewardVestingDuration(d time.Duration) error {
+	plan.RewardVestingDuration = d
+	return nil
+}
+
+func (plan BasePlan) GetRestricted() bool {
+	return plan.Restricted
+}
+
+func (plan *BasePlan) SetRestricted(restricted bool) error {
+	plan.Restricted = restricted
+	return nil
+}
+
 func (plan BasePlan) GetBasePlan() *BasePlan {
 	return &BasePlan{
-		Id:                   plan.GetId(),
-		Name:                 plan.GetName(),
-		Type:                 plan.GetType(),
-		FarmingPoolAddress:   plan.GetFarmingPoolAddress().String(),
-		TerminationAddress:   plan.GetTerminationAddress().String(),
-		StakingCoinWeights:   plan.GetStakingCoinWeights(),
-		StartTime:            plan.GetStartTime(),
-		EndTime:              plan.GetEndTime(),
-		Terminated:           plan.GetTerminated(),
-		LastDistributionTime: plan.GetLastDistributionTime(),
-		DistributedCoins:     plan.GetDistributedCoins(),
+		Id:                    plan.GetId(),
+		Name:                  plan.GetName(),
+		Type:                  plan.GetType(),
+		FarmingPoolAddress:    plan.GetFarmingPoolAddress().String(),
+		TerminationAddress:    plan.GetTerminationAddress().String(),
+		StakingCoinWeights:    plan.GetStakingCoinWeights(),
+		StartTime:             plan.GetStartTime(),
+		EndTime:               plan.GetEndTime(),
+		Terminated:            plan.GetTerminated(),
+		LastDistributionTime:  plan.GetLastDistributionTime(),
+		DistributedCoins:      plan.GetDistributedCoins(),
+		RewardVestingDuration: plan.GetRewardVestingDuration(),
+		Restricted:            plan.GetRestricted(),
 	}
 }
 
@@ -193,6 +215,12 @@ func (plan BasePlan) Validate() error {
 	if err := plan.DistributedCoins.Validate(); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid distributed coins: %v", err)
 	}
+	if err := ValidateRewardVestingDuration(plan.RewardVestingDuration); err != nil {
+		return err
+	}
+	if plan.Restricted && plan.Type != PlanTypePrivate {
+		return sdkerrors.Wrapf(ErrInvalidPlanType, "only private plans can be restricted")
+	}
 	return nil
 }
 
@@ -224,6 +252,128 @@ func NewRatioPlan(basePlan *BasePlan, epochRatio sdk.Dec) *RatioPlan {
 	}
 }
 
+func NewDecayingPlan(basePlan *BasePlan, epochAmount sdk.Coins, decayRate sdk.Dec, decayEpochs uint32) *DecayingPlan {
+	return &DecayingPlan{
+		BasePlan:        basePlan,
+		EpochAmount:     epochAmount,
+		DecayRate:       decayRate,
+		DecayEpochs:     decayEpochs,
+		AllocatedEpochs: 0,
+	}
+}
+
+// NewDecayingPlanFrom creates a new DecayingPlan from the given plan.
+// The number of allocated epochs is carried over if the given plan is already a decaying plan.
+func NewDecayingPlanFrom(plan PlanI, epochAmount sdk.Coins, decayRate sdk.Dec, decayEpochs uint32) *DecayingPlan {
+	decayingPlan := NewDecayingPlan(plan.GetBasePlan(), epochAmount, decayRate, decayEpochs)
+	if p, ok := plan.(*DecayingPlan); ok {
+		decayingPlan.AllocatedEpochs = p.AllocatedEpochs
+	}
+	return decayingPlan
+}
+
+// CurrentEpochAmount returns the distributing amount for the current epoch,
+// which is the initial epoch amount decayed by the decay rate once for
+// every decay epochs that the plan has allocated rewards for.
+func (plan DecayingPlan) CurrentEpochAmount() sdk.Coins {
+	if plan.DecayEpochs == 0 {
+		return plan.EpochAmount
+	}
+	factor := plan.DecayRate.Power(plan.AllocatedEpochs / uint64(plan.DecayEpochs))
+	amt, _ := sdk.NewDecCoinsFromCoins(plan.EpochAmount...).MulDecTruncate(factor).TruncateDecimal()
+	return amt
+}
+
+// Validate checks for errors on the DecayingPlan fields
+func (plan DecayingPlan) Validate() error {
+	if err := plan.BasePlan.Validate(); err != nil {
+		return err
+	}
+	if err := ValidateDecayingParams(plan.EpochAmount, plan.DecayRate, plan.DecayEpochs); err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewSchedulePlan(basePlan *BasePlan, phases []SchedulePhase) *SchedulePlan {
+	return &SchedulePlan{
+		BasePlan:     basePlan,
+		Phases:       phases,
+		CurrentPhase: nil,
+	}
+}
+
+func NewSchedulePhase(startTime, endTime time.Time, epochAmount sdk.Coins) SchedulePhase {
+	return SchedulePhase{
+		StartTime:   startTime,
+		EndTime:     endTime,
+		EpochAmount: epochAmount,
+	}
+}
+
+// PhaseAt returns the phase active at given time t.
+// It returns false if there is no such phase.
+func (plan SchedulePlan) PhaseAt(t time.Time) (SchedulePhase, bool) {
+	for _, phase := range plan.Phases {
+		if phase.IsActiveAt(t) {
+			return phase, true
+		}
+	}
+	return SchedulePhase{}, false
+}
+
+// EpochAmountAt returns the distributing amount of the phase active at given time t.
+// It returns empty coins if there is no such phase.
+func (plan SchedulePlan) EpochAmountAt(t time.Time) sdk.Coins {
+	phase, found := plan.PhaseAt(t)
+	if !found {
+		return sdk.Coins{}
+	}
+	return phase.EpochAmount
+}
+
+// SetCurrentPhaseAt sets the current phase of the plan to the phase active at given time t.
+// The current phase is only used for displaying purpose and is not stored in state.
+func (plan *SchedulePlan) SetCurrentPhaseAt(t time.Time) {
+	phase, found := plan.PhaseAt(t)
+	if !found {
+		plan.CurrentPhase = nil
+		return
+	}
+	plan.CurrentPhase = &phase
+}
+
+// Validate checks for errors on the SchedulePlan fields
+func (plan SchedulePlan) Validate() error {
+	if err := plan.BasePlan.Validate(); err != nil {
+		return err
+	}
+	if err := ValidateSchedulePhases(plan.Phases, plan.StartTime, plan.EndTime); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (plan SchedulePlan) String() string {
+	out, _ := plan.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a SchedulePlan,
+// including its phases and the current phase.
+func (plan SchedulePlan) MarshalYAML() (interface{}, error) {
+	bz, err := codec.MarshalYAML(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), &plan)
+	if err != nil {
+		return nil, err
+	}
+	return string(bz), err
+}
+
+// IsActiveAt returns if the phase is active at given time t.
+func (phase SchedulePhase) IsActiveAt(t time.Time) bool {
+	return !phase.StartTime.After(t) && phase.EndTime.After(t)
+}
+
 type PlanI interface {
 	proto.Message
 
@@ -260,6 +410,12 @@ type PlanI interface {
 	GetDistributedCoins() sdk.Coins
 	SetDistributedCoins(sdk.Coins) error
 
+	GetRewardVestingDuration() time.Duration
+	SetRewardVestingDuration(time.Duration) error
+
+	GetRestricted() bool
+	SetRestricted(bool) error
+
 	GetBasePlan() *BasePlan
 
 	String() string
@@ -357,6 +513,53 @@ func ValidateStakingCoinTotalWeights(weights sdk.DecCoins) bool {
 	return totalWeight.Equal(sdk.NewDec(1))
 }
 
+// ValidateDecayingParams validates the epoch amount, decay rate and decay epochs of a decaying plan.
+func ValidateDecayingParams(epochAmount sdk.Coins, decayRate sdk.Dec, decayEpochs uint32) error {
+	if epochAmount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "epoch amount must not be empty")
+	}
+	if err := epochAmount.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid epoch amount: %v", err)
+	}
+	if decayRate.IsNil() || !decayRate.IsPositive() || decayRate.GTE(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidDecayRate, "decay rate must be between 0 and 1 exclusively: %s", decayRate)
+	}
+	if decayEpochs == 0 {
+		return sdkerrors.Wrap(ErrInvalidDecayEpochs, "decay epochs must be positive")
+	}
+	return nil
+}
+
+// ValidateSchedulePhases validates the phases of a schedule plan.
+// Phases must not be empty, must be ordered by time without overlapping each other,
+// and must be within the plan's start and end time.
+func ValidateSchedulePhases(phases []SchedulePhase, startTime, endTime time.Time) error {
+	if len(phases) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedulePhases, "phases must not be empty")
+	}
+	for i, phase := range phases {
+		if !phase.EndTime.After(phase.StartTime) {
+			return sdkerrors.Wrapf(ErrInvalidSchedulePhases, "phase %d: end time %s must be greater than start time %s", i, phase.EndTime, phase.StartTime)
+		}
+		if phase.EpochAmount.Empty() {
+			return sdkerrors.Wrapf(ErrInvalidSchedulePhases, "phase %d: epoch amount must not be empty", i)
+		}
+		if err := phase.EpochAmount.Validate(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidSchedulePhases, "phase %d: invalid epoch amount: %v", i, err)
+		}
+		if i > 0 && phase.StartTime.Before(phases[i-1].EndTime) {
+			return sdkerrors.Wrapf(ErrInvalidSchedulePhases, "phase %d: start time %s must not be before the end time of the previous phase %s", i, phase.StartTime, phases[i-1].EndTime)
+		}
+	}
+	if phases[0].StartTime.Before(startTime) {
+		return sdkerrors.Wrapf(ErrInvalidSchedulePhases, "the first phase must not start before the plan's start time %s", startTime)
+	}
+	if phases[len(phases)-1].EndTime.After(endTime) {
+		return sdkerrors.Wrapf(ErrInvalidSchedulePhases, "the last phase must not end after the plan's end time %s", endTime)
+	}
+	return nil
+}
+
 // IsPlanActiveAt returns if the plan is active at given time t.
 func IsPlanActiveAt(plan PlanI, t time.Time) bool {
 	return !plan.GetStartTime().After(t) && plan.GetEndTime().After(t)
@@ -366,3 +569,35 @@ func PrivatePlanFarmingPoolAddress(name string, planId uint64) sdk.AccAddress {
 	poolAddrName := strings.Join([]string{PrivatePlanFarmingPoolAddrPrefix, fmt.Sprint(planId), name}, PoolAddrSplitter)
 	return address.Module(ModuleName, []byte(poolAddrName))
 }
+
+// AddPlanUnitRewards returns the cumulative plan unit rewards with the given
+// unit rewards added to the entry of the plan.
+// The entries are kept sorted by the plan id.
+func AddPlanUnitRewards(rewards []PlanUnitRewards, planId uint64, unitRewards sdk.DecCoins) []PlanUnitRewards {
+	result := make([]PlanUnitRewards, 0, len(rewards)+1)
+	added := false
+	for _, r := range rewards {
+		if !added && r.PlanId == planId {
+			r.CumulativeUnitRewards = r.CumulativeUnitRewards.Add(unitRewards...)
+			added = true
+		} else if !added && r.PlanId > planId {
+			result = append(result, PlanUnitRewards{PlanId: planId, CumulativeUnitRewards: unitRewards})
+			added = true
+		}
+		result = append(result, r)
+	}
+	if !added {
+		result = append(result, PlanUnitRewards{PlanId: planId, CumulativeUnitRewards: unitRewards})
+	}
+	return result
+}
+
+// PlanUnitRewardsOf returns the cumulative unit rewards of the plan.
+func PlanUnitRewardsOf(rewards []PlanUnitRewards, planId uint64) sdk.DecCoins {
+	for _, r := range rewards {
+		if r.PlanId == planId {
+			return r.CumulativeUnitRewards
+		}
+	}
+	return sdk.DecCoins{}
+}
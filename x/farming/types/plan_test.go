@@ -317,6 +317,21 @@ func TestBasePlanValidate(t *testing.T) {
 			},
 			"invalid distributed coins: coin 0reward1 amount is not positive: invalid coins",
 		},
+		{
+			"restricted private plan",
+			func(plan *types.BasePlan) {
+				plan.Type = types.PlanTypePrivate
+				plan.Restricted = true
+			},
+			"",
+		},
+		{
+			"restricted public plan",
+			func(plan *types.BasePlan) {
+				plan.Restricted = true
+			},
+			"only private plans can be restricted: invalid plan type",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			bp := types.NewBasePlan(
@@ -340,6 +355,120 @@ func TestBasePlanValidate(t *testing.T) {
 	}
 }
 
+func TestDecayingPlan(t *testing.T) {
+	bp := types.NewBasePlan(
+		1,
+		"sample plan",
+		types.PlanTypePublic,
+		sdk.AccAddress(crypto.AddressHash([]byte("address1"))).String(),
+		sdk.AccAddress(crypto.AddressHash([]byte("address2"))).String(),
+		sdk.NewDecCoins(sdk.NewInt64DecCoin("stake1", 1)),
+		types.ParseTime("0001-01-01T00:00:00Z"),
+		types.ParseTime("9999-12-31T00:00:00Z"),
+	)
+	plan := types.NewDecayingPlan(bp, sdk.NewCoins(sdk.NewInt64Coin("reward1", 1000000)), sdk.NewDecWithPrec(5, 1), 3)
+	require.NoError(t, plan.Validate())
+
+	for _, tc := range []struct {
+		allocatedEpochs uint64
+		expected        sdk.Coins
+	}{
+		{0, sdk.NewCoins(sdk.NewInt64Coin("reward1", 1000000))},
+		{2, sdk.NewCoins(sdk.NewInt64Coin("reward1", 1000000))},
+		{3, sdk.NewCoins(sdk.NewInt64Coin("reward1", 500000))},
+		{8, sdk.NewCoins(sdk.NewInt64Coin("reward1", 250000))},
+		{9, sdk.NewCoins(sdk.NewInt64Coin("reward1", 125000))},
+		{30, sdk.NewCoins(sdk.NewInt64Coin("reward1", 976))},
+		{60, sdk.NewCoins()},
+	} {
+		plan.AllocatedEpochs = tc.allocatedEpochs
+		require.True(t, tc.expected.IsEqual(plan.CurrentEpochAmount()), tc.allocatedEpochs)
+	}
+
+	plan.DecayRate = sdk.OneDec()
+	require.EqualError(t, plan.Validate(), "decay rate must be between 0 and 1 exclusively: 1.000000000000000000: invalid decay rate")
+	plan.DecayRate = sdk.NewDecWithPrec(5, 1)
+	plan.DecayEpochs = 0
+	require.EqualError(t, plan.Validate(), "decay epochs must be positive: invalid decay epochs")
+	plan.DecayEpochs = 3
+	plan.EndTime = plan.StartTime
+	require.Error(t, plan.Validate())
+
+	// a decaying plan created from another decaying plan keeps the number of allocated epochs
+	plan.EndTime = types.ParseTime("9999-12-31T00:00:00Z")
+	plan.AllocatedEpochs = 5
+	newPlan := types.NewDecayingPlanFrom(plan, sdk.NewCoins(sdk.NewInt64Coin("reward1", 10)), sdk.NewDecWithPrec(1, 1), 1)
+	require.Equal(t, uint64(5), newPlan.AllocatedEpochs)
+	newPlan = types.NewDecayingPlanFrom(types.NewFixedAmountPlan(bp, sdk.NewCoins(sdk.NewInt64Coin("reward1", 10))),
+		sdk.NewCoins(sdk.NewInt64Coin("reward1", 10)), sdk.NewDecWithPrec(1, 1), 1)
+	require.Equal(t, uint64(0), newPlan.AllocatedEpochs)
+}
+
+func TestSchedulePlan(t *testing.T) {
+	bp := types.NewBasePlan(
+		1,
+		"sample plan",
+		types.PlanTypePublic,
+		sdk.AccAddress(crypto.AddressHash([]byte("address1"))).String(),
+		sdk.AccAddress(crypto.AddressHash([]byte("address2"))).String(),
+		sdk.NewDecCoins(sdk.NewInt64DecCoin("stake1", 1)),
+		types.ParseTime("2021-10-01T00:00:00Z"),
+		types.ParseTime("2022-01-01T00:00:00Z"),
+	)
+	plan := types.NewSchedulePlan(bp, []types.SchedulePhase{
+		types.NewSchedulePhase(
+			types.ParseTime("2021-10-01T00:00:00Z"), types.ParseTime("2021-11-01T00:00:00Z"),
+			sdk.NewCoins(sdk.NewInt64Coin("reward1", 1000))),
+		types.NewSchedulePhase(
+			types.ParseTime("2021-11-01T00:00:00Z"), types.ParseTime("2021-12-01T00:00:00Z"),
+			sdk.NewCoins(sdk.NewInt64Coin("reward1", 500))),
+		types.NewSchedulePhase(
+			types.ParseTime("2021-12-15T00:00:00Z"), types.ParseTime("2022-01-01T00:00:00Z"),
+			sdk.NewCoins(sdk.NewInt64Coin("reward1", 100))),
+	})
+	require.NoError(t, plan.Validate())
+
+	for _, tc := range []struct {
+		t        string
+		expected sdk.Coins
+	}{
+		{"2021-09-30T23:59:59Z", sdk.Coins{}},
+		{"2021-10-01T00:00:00Z", sdk.NewCoins(sdk.NewInt64Coin("reward1", 1000))},
+		{"2021-10-31T23:59:59Z", sdk.NewCoins(sdk.NewInt64Coin("reward1", 1000))},
+		{"2021-11-01T00:00:00Z", sdk.NewCoins(sdk.NewInt64Coin("reward1", 500))},
+		{"2021-12-10T00:00:00Z", sdk.Coins{}}, // gap between phases
+		{"2021-12-15T00:00:00Z", sdk.NewCoins(sdk.NewInt64Coin("reward1", 100))},
+		{"2022-01-01T00:00:00Z", sdk.Coins{}},
+	} {
+		require.True(t, tc.expected.IsEqual(plan.EpochAmountAt(types.ParseTime(tc.t))), tc.t)
+	}
+
+	require.Nil(t, plan.CurrentPhase)
+	plan.SetCurrentPhaseAt(types.ParseTime("2021-11-15T00:00:00Z"))
+	require.Equal(t, plan.Phases[1], *plan.CurrentPhase)
+	require.Contains(t, plan.String(), "current_phase:")
+	require.Contains(t, plan.String(), "amount: \"500\"")
+	plan.SetCurrentPhaseAt(types.ParseTime("2021-12-10T00:00:00Z"))
+	require.Nil(t, plan.CurrentPhase)
+
+	// overlapping phases
+	plan.Phases[1].StartTime = types.ParseTime("2021-10-15T00:00:00Z")
+	require.ErrorIs(t, plan.Validate(), types.ErrInvalidSchedulePhases)
+	plan.Phases[1].StartTime = types.ParseTime("2021-11-01T00:00:00Z")
+
+	// phases out of the plan's period
+	plan.EndTime = types.ParseTime("2021-12-31T00:00:00Z")
+	require.ErrorIs(t, plan.Validate(), types.ErrInvalidSchedulePhases)
+	plan.EndTime = types.ParseTime("2022-01-01T00:00:00Z")
+	plan.StartTime = types.ParseTime("2021-10-02T00:00:00Z")
+	require.ErrorIs(t, plan.Validate(), types.ErrInvalidSchedulePhases)
+	plan.StartTime = types.ParseTime("2021-10-01T00:00:00Z")
+
+	// phase with the end time not greater than the start time
+	plan.Phases[2].EndTime = plan.Phases[2].StartTime
+	require.ErrorIs(t, plan.Validate(), types.ErrInvalidSchedulePhases)
+}
+
 func TestIsPlanActiveAt(t *testing.T) {
 	plan := types.NewFixedAmountPlan(
 		types.NewBasePlan(
@@ -515,3 +644,20 @@ func TestUnpackPlan(t *testing.T) {
 	_, err = types.UnpackPlan(&planRecord.Plan)
 	require.NoError(t, err)
 }
+
+func TestAddPlanUnitRewards(t *testing.T) {
+	unitRewards := sdk.NewDecCoins(sdk.NewInt64DecCoin("denom1", 1))
+
+	var rewards []types.PlanUnitRewards
+	rewards = types.AddPlanUnitRewards(rewards, 2, unitRewards)
+	rewards = types.AddPlanUnitRewards(rewards, 1, unitRewards)
+	rewards = types.AddPlanUnitRewards(rewards, 3, unitRewards)
+	rewards = types.AddPlanUnitRewards(rewards, 2, unitRewards)
+
+	require.Len(t, rewards, 3)
+	for i, planId := range []uint64{1, 2, 3} {
+		require.Equal(t, planId, rewards[i].PlanId)
+	}
+	require.True(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("denom1", 2)).IsEqual(types.PlanUnitRewardsOf(rewards, 2)))
+	require.True(t, types.PlanUnitRewardsOf(rewards, 4).IsZero())
+}
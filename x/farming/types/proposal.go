@@ -112,6 +112,10 @@ func (p *AddRequestProposal) IsForRatioPlan() bool {
 	return !p.EpochRatio.IsNil() && !p.EpochRatio.IsZero()
 }
 
+func (p *AddRequestProposal) IsForDecayingPlan() bool {
+	return !p.DecayRate.IsNil() && !p.DecayRate.IsZero()
+}
+
 func (p *AddRequestProposal) Validate() error {
 	if len(p.Name) > MaxNameLength {
 		return sdkerrors.Wrapf(ErrInvalidPlanNameLength, "plan name cannot be longer than max length of %d", MaxNameLength)
@@ -137,6 +141,17 @@ func (p *AddRequestProposal) Validate() error {
 	if p.IsForFixedAmountPlan() == p.IsForRatioPlan() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only one of epoch amount or epoch ratio must be provided")
 	}
+	if p.IsForDecayingPlan() {
+		if !p.IsForFixedAmountPlan() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "epoch amount must be provided for a decaying plan")
+		}
+		if err := ValidateDecayingParams(p.EpochAmount, p.DecayRate, p.DecayEpochs); err != nil {
+			return err
+		}
+	}
+	if err := ValidateRewardVestingDuration(p.RewardVestingDuration); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -173,6 +188,10 @@ func (p *UpdateRequestProposal) IsForRatioPlan() bool {
 	return !p.EpochRatio.IsNil() && !p.EpochRatio.IsZero()
 }
 
+func (p *UpdateRequestProposal) IsForDecayingPlan() bool {
+	return !p.DecayRate.IsNil() && !p.DecayRate.IsZero()
+}
+
 func (p *UpdateRequestProposal) Validate() error {
 	if p.PlanId == 0 {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid plan id: %d", p.PlanId)
@@ -203,6 +222,14 @@ func (p *UpdateRequestProposal) Validate() error {
 	if p.IsForFixedAmountPlan() == p.IsForRatioPlan() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only one of epoch amount or epoch ratio must be provided")
 	}
+	if p.IsForDecayingPlan() {
+		if !p.IsForFixedAmountPlan() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "epoch amount must be provided for a decaying plan")
+		}
+		if err := ValidateDecayingParams(p.EpochAmount, p.DecayRate, p.DecayEpochs); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -11,6 +11,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -33,7 +34,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // PublicPlanProposal defines a public farming plan governance proposal that receives one of the following requests:
 // A request that creates a public farming plan, a request that updates the plan, and a request that deletes the plan.
 // For public plan creation, depending on which field is passed, either epoch amount or epoch ratio, it creates a fixed
-// amount plan or ratio plan.
+// amount plan or ratio plan. If decay rate is passed along with epoch amount, it creates a decaying plan.
 type PublicPlanProposal struct {
 	// title specifies the title of the plan
 	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -99,6 +100,14 @@ type AddRequestProposal struct {
 	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
 	// epoch_ratio specifies the distributing amount by ratio
 	EpochRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=epoch_ratio,json=epochRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_ratio" yaml:"epoch_ratio"`
+	// decay_rate specifies the factor that the distributing amount is multiplied by
+	// every decay_epochs; a decaying plan is created when it is provided along with epoch_amount
+	DecayRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=decay_rate,json=decayRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_rate" yaml:"decay_rate"`
+	// decay_epochs specifies the number of epochs between decays
+	DecayEpochs uint32 `protobuf:"varint,10,opt,name=decay_epochs,json=decayEpochs,proto3" json:"decay_epochs,omitempty" yaml:"decay_epochs"`
+	// reward_vesting_duration specifies the duration over which harvested rewards from the plan
+	// unlock linearly; zero means rewards are not vested
+	RewardVestingDuration time.Duration `protobuf:"bytes,11,opt,name=reward_vesting_duration,json=rewardVestingDuration,proto3,stdduration" json:"reward_vesting_duration" yaml:"reward_vesting_duration"`
 }
 
 func (m *AddRequestProposal) Reset()         { *m = AddRequestProposal{} }
@@ -183,6 +192,20 @@ func (m *AddRequestProposal) GetEpochAmount() github_com_cosmos_cosmos_sdk_types
 	return nil
 }
 
+func (m *AddRequestProposal) GetDecayEpochs() uint32 {
+	if m != nil {
+		return m.DecayEpochs
+	}
+	return 0
+}
+
+func (m *AddRequestProposal) GetRewardVestingDuration() time.Duration {
+	if m != nil {
+		return m.RewardVestingDuration
+	}
+	return 0
+}
+
 // UpdateRequestProposal details a proposal for updating an existing public plan.
 type UpdateRequestProposal struct {
 	// plan_id specifies index of the farming plan
@@ -205,6 +228,11 @@ type UpdateRequestProposal struct {
 	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
 	// epoch_ratio specifies the distributing amount by ratio
 	EpochRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=epoch_ratio,json=epochRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_ratio" yaml:"epoch_ratio"`
+	// decay_rate specifies the factor that the distributing amount is multiplied by
+	// every decay_epochs; a decaying plan is created when it is provided along with epoch_amount
+	DecayRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=decay_rate,json=decayRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_rate" yaml:"decay_rate"`
+	// decay_epochs specifies the number of epochs between decays
+	DecayEpochs uint32 `protobuf:"varint,11,opt,name=decay_epochs,json=decayEpochs,proto3" json:"decay_epochs,omitempty" yaml:"decay_epochs"`
 }
 
 func (m *UpdateRequestProposal) Reset()         { *m = UpdateRequestProposal{} }
@@ -296,6 +324,13 @@ func (m *UpdateRequestProposal) GetEpochAmount() github_com_cosmos_cosmos_sdk_ty
 	return nil
 }
 
+func (m *UpdateRequestProposal) GetDecayEpochs() uint32 {
+	if m != nil {
+		return m.DecayEpochs
+	}
+	return 0
+}
+
 // DeleteRequestProposal details a proposal for deleting an existing public plan.
 type DeleteRequestProposal struct {
 	// plan_id specifies index of the farming plan
@@ -354,56 +389,64 @@ func init() {
 }
 
 var fileDescriptor_4719b03c30c7910a = []byte{
-	// 775 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x3f, 0x6f, 0xdb, 0x38,
-	0x1c, 0xb5, 0xe2, 0xbf, 0xa1, 0x0f, 0x38, 0x1c, 0xe3, 0xe4, 0x1c, 0x27, 0x27, 0x19, 0x3a, 0xe0,
-	0xe0, 0xbb, 0x43, 0xa4, 0x26, 0xdd, 0xb2, 0xc5, 0x0d, 0x10, 0x74, 0xaa, 0x2b, 0xb4, 0x68, 0xd1,
-	0x45, 0xa0, 0x4d, 0xc6, 0x11, 0x22, 0x89, 0xaa, 0x48, 0xb7, 0xcd, 0xd6, 0xa5, 0x40, 0x87, 0xa2,
-	0xc8, 0xd8, 0x31, 0xe8, 0x98, 0x4f, 0x92, 0x31, 0x63, 0xd1, 0xc1, 0x29, 0x92, 0x6f, 0xe0, 0xa5,
-	0x6b, 0x21, 0x92, 0x72, 0x8d, 0x46, 0x4e, 0x1d, 0x20, 0x05, 0x32, 0x89, 0x3f, 0xf2, 0xf7, 0x1e,
-	0x9f, 0x1e, 0xf9, 0x6c, 0x81, 0x7f, 0x39, 0x09, 0x31, 0x89, 0x03, 0x2f, 0xe4, 0xf6, 0x2e, 0x4a,
-	0x9e, 0x7d, 0xfb, 0xc5, 0x7a, 0x97, 0x70, 0xb4, 0x6e, 0x47, 0x31, 0x8d, 0x28, 0x43, 0xbe, 0x15,
-	0xc5, 0x94, 0x53, 0xb8, 0xd4, 0xa3, 0x2c, 0xa0, 0xcc, 0x52, 0x6d, 0x96, 0x6a, 0x6b, 0xd4, 0xfa,
-	0xb4, 0x4f, 0x45, 0x8b, 0x9d, 0x8c, 0x64, 0x77, 0x63, 0x59, 0x76, 0xbb, 0x72, 0x41, 0x41, 0xe5,
-	0x92, 0x2e, 0x2b, 0xbb, 0x8b, 0x18, 0x19, 0x6f, 0xd6, 0xa3, 0x5e, 0xa8, 0xd6, 0x5b, 0x57, 0x68,
-	0x4a, 0x37, 0x97, 0x9d, 0x46, 0x9f, 0xd2, 0xbe, 0x4f, 0x6c, 0x51, 0x75, 0x07, 0xbb, 0x36, 0xf7,
-	0x02, 0xc2, 0x38, 0x0a, 0x22, 0xd9, 0x60, 0x7e, 0xcd, 0x03, 0xd8, 0x19, 0x74, 0x7d, 0xaf, 0xd7,
-	0xf1, 0x51, 0xd8, 0x51, 0x2f, 0x04, 0x6b, 0xa0, 0xc8, 0x3d, 0xee, 0x93, 0xba, 0xd6, 0xd4, 0x5a,
-	0xf3, 0x8e, 0x2c, 0x60, 0x13, 0x54, 0x31, 0x61, 0xbd, 0xd8, 0x8b, 0xb8, 0x47, 0xc3, 0xfa, 0x9c,
-	0x58, 0x9b, 0x9c, 0x82, 0xaf, 0x35, 0xb0, 0x88, 0x30, 0x76, 0x63, 0xf2, 0x7c, 0x40, 0x18, 0x77,
-	0x53, 0x87, 0x58, 0x3d, 0xdf, 0xcc, 0xb7, 0xaa, 0x1b, 0xff, 0x59, 0xd9, 0x1e, 0x59, 0x5b, 0x18,
-	0x3b, 0x12, 0x93, 0x6a, 0x68, 0x37, 0x47, 0x43, 0x63, 0xf5, 0x00, 0x05, 0xfe, 0xa6, 0x99, 0x49,
-	0x69, 0x3a, 0x0b, 0xe8, 0x12, 0x8a, 0xc1, 0x77, 0x1a, 0xa8, 0x0f, 0x22, 0x8c, 0x38, 0xc9, 0x50,
-	0x51, 0x10, 0x2a, 0xd6, 0xa6, 0xa9, 0x78, 0x2c, 0x70, 0x3f, 0x0a, 0xf9, 0x7b, 0x34, 0x34, 0x0c,
-	0x29, 0x64, 0x1a, 0xb1, 0xe9, 0x2c, 0x0d, 0xb2, 0xb0, 0x52, 0x0e, 0x26, 0x3e, 0xc9, 0x94, 0x53,
-	0xbc, 0x5a, 0xce, 0xb6, 0xc0, 0x5d, 0x21, 0x67, 0x1a, 0xb1, 0xe9, 0x2c, 0xe1, 0x2c, 0x2c, 0xdb,
-	0xac, 0xbc, 0x3d, 0x32, 0x72, 0x1f, 0x8e, 0x8c, 0x9c, 0xf9, 0xbe, 0x04, 0xe0, 0x65, 0xd7, 0x21,
-	0x04, 0x85, 0x10, 0x05, 0xe9, 0xc1, 0x8b, 0x31, 0x7c, 0x08, 0x6a, 0x4a, 0x9a, 0x1b, 0x51, 0xea,
-	0xbb, 0x08, 0xe3, 0x98, 0x30, 0x26, 0x2f, 0x40, 0xdb, 0x18, 0x0d, 0x8d, 0x15, 0xa9, 0x27, 0xab,
-	0xcb, 0x74, 0xa0, 0x9a, 0xee, 0x50, 0xea, 0x6f, 0xc9, 0x49, 0xf8, 0x00, 0x2c, 0x70, 0x71, 0x83,
-	0x51, 0x72, 0x6f, 0xc6, 0x8c, 0x79, 0xc1, 0xa8, 0x8f, 0x86, 0x46, 0x43, 0x32, 0x66, 0x34, 0x99,
-	0x0e, 0x9c, 0x98, 0x4d, 0x09, 0x3f, 0x6a, 0xa0, 0xc6, 0x38, 0xda, 0x4f, 0xb6, 0x4f, 0xa2, 0xe2,
-	0xbe, 0x24, 0x5e, 0x7f, 0x8f, 0xa7, 0x47, 0xbe, 0x9a, 0x7a, 0x9c, 0x64, 0x6a, 0xc2, 0xe0, 0xde,
-	0x3d, 0xea, 0x85, 0x6d, 0xe7, 0x64, 0x68, 0xe4, 0xbe, 0xbf, 0x46, 0x16, 0x8f, 0x79, 0x7c, 0x66,
-	0xfc, 0xdf, 0xf7, 0xf8, 0xde, 0xa0, 0x6b, 0xf5, 0x68, 0xa0, 0x02, 0xab, 0x1e, 0x6b, 0x0c, 0xef,
-	0xdb, 0xfc, 0x20, 0x22, 0x2c, 0xa5, 0x64, 0x0e, 0x54, 0x2c, 0x49, 0xf5, 0x44, 0x72, 0xc0, 0xa7,
-	0x00, 0x30, 0x8e, 0x62, 0xee, 0x26, 0x31, 0xac, 0x17, 0x9b, 0x5a, 0xab, 0xba, 0xd1, 0xb0, 0x64,
-	0x46, 0xad, 0x34, 0xa3, 0xd6, 0xa3, 0x34, 0xa3, 0xed, 0xbf, 0x94, 0xae, 0x3f, 0xc6, 0xba, 0x14,
-	0xd6, 0x3c, 0x3c, 0x33, 0x34, 0x67, 0x5e, 0x4c, 0x24, 0xed, 0xd0, 0x01, 0x15, 0x12, 0x62, 0xc9,
-	0x5b, 0xfa, 0x29, 0xef, 0x8a, 0xe2, 0xfd, 0x5d, 0xf2, 0xa6, 0x48, 0xc9, 0x5a, 0x26, 0x21, 0x16,
-	0x9c, 0x6f, 0x34, 0xf0, 0x1b, 0x89, 0x68, 0x6f, 0xcf, 0x45, 0x01, 0x1d, 0x84, 0xbc, 0x5e, 0x16,
-	0x56, 0x2e, 0x67, 0x5a, 0x29, 0x7c, 0xdc, 0x51, 0xbc, 0x0b, 0x8a, 0x77, 0x02, 0x9c, 0xf8, 0xd7,
-	0x9a, 0xc1, 0x3f, 0x69, 0x5e, 0x55, 0x40, 0xb7, 0x04, 0x12, 0x12, 0x20, 0x4b, 0x37, 0x4e, 0x4e,
-	0xbc, 0x5e, 0x11, 0x77, 0x64, 0x3b, 0xd9, 0xea, 0xf3, 0xd0, 0xf8, 0x67, 0xb6, 0x33, 0x19, 0x0d,
-	0x0d, 0x38, 0x29, 0x4a, 0x50, 0x99, 0x0e, 0x10, 0x95, 0x23, 0x8a, 0xe3, 0x12, 0x58, 0xcc, 0xfc,
-	0x01, 0x80, 0x7f, 0x82, 0x72, 0xe4, 0xa3, 0xd0, 0xf5, 0xb0, 0x88, 0x45, 0xc1, 0x29, 0x25, 0xe5,
-	0x7d, 0x3c, 0x0e, 0xcb, 0xdc, 0x0c, 0x61, 0xc9, 0xdf, 0x78, 0x58, 0x0a, 0x37, 0x1f, 0x96, 0xe2,
-	0xad, 0x0d, 0x4b, 0x69, 0xa6, 0xb0, 0x68, 0xd7, 0x0e, 0x4b, 0x79, 0xa6, 0xb0, 0x68, 0xd7, 0x0f,
-	0x4b, 0xe5, 0x56, 0x84, 0x65, 0xfe, 0x17, 0x85, 0xe5, 0x0e, 0x58, 0xcc, 0xfc, 0x77, 0x9a, 0x9a,
-	0x95, 0xf6, 0xce, 0xc9, 0xb9, 0xae, 0x9d, 0x9e, 0xeb, 0xda, 0x97, 0x73, 0x5d, 0x3b, 0xbc, 0xd0,
-	0x73, 0xa7, 0x17, 0x7a, 0xee, 0xd3, 0x85, 0x9e, 0x7b, 0xb6, 0x36, 0xa1, 0x2a, 0xe3, 0xcb, 0xe6,
-	0xd5, 0x78, 0x24, 0x04, 0x76, 0x4b, 0xe2, 0x8c, 0xee, 0x7e, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x88,
-	0x08, 0x21, 0xe4, 0x9a, 0x09, 0x00, 0x00,
+	// 897 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x3b, 0x6f, 0x1b, 0x47,
+	0x10, 0xe6, 0x99, 0x92, 0x48, 0x2e, 0x1d, 0x04, 0x5e, 0xbd, 0x4e, 0xb2, 0x73, 0x47, 0x6c, 0x80,
+	0x80, 0x71, 0xa0, 0x63, 0xec, 0x74, 0xea, 0x44, 0x2b, 0x30, 0x52, 0x45, 0x59, 0xe4, 0x85, 0x34,
+	0x87, 0xe5, 0xed, 0x9a, 0x3a, 0xf8, 0x78, 0x7b, 0xb9, 0x5d, 0xda, 0x51, 0x11, 0x20, 0x4d, 0x80,
+	0x14, 0x29, 0x5c, 0xba, 0x34, 0x52, 0x06, 0xc8, 0xff, 0x30, 0x52, 0xb9, 0x0c, 0x52, 0xd0, 0x81,
+	0xf4, 0x0f, 0xd8, 0xa4, 0x0d, 0xf6, 0x45, 0x13, 0xd6, 0x51, 0xa1, 0x00, 0xc3, 0x50, 0xc5, 0x9b,
+	0x9d, 0x99, 0x6f, 0xbe, 0x9d, 0xd9, 0xf9, 0x40, 0xf0, 0xa1, 0x64, 0x39, 0x65, 0xe5, 0x28, 0xcd,
+	0x65, 0xef, 0x01, 0x51, 0xbf, 0xc3, 0xde, 0xa3, 0x3b, 0x03, 0x26, 0xc9, 0x9d, 0x5e, 0x51, 0xf2,
+	0x82, 0x0b, 0x92, 0x45, 0x45, 0xc9, 0x25, 0x87, 0x5b, 0x09, 0x17, 0x23, 0x2e, 0x22, 0x1b, 0x16,
+	0xd9, 0xb0, 0xdd, 0x8d, 0x21, 0x1f, 0x72, 0x1d, 0xd2, 0x53, 0x5f, 0x26, 0x7a, 0x77, 0xc7, 0x44,
+	0xc7, 0xc6, 0x61, 0x53, 0x8d, 0x2b, 0x30, 0x56, 0x6f, 0x40, 0x04, 0x9b, 0x15, 0x4b, 0x78, 0x9a,
+	0x5b, 0x7f, 0xf7, 0x02, 0x4e, 0xae, 0xb8, 0x89, 0x0c, 0x87, 0x9c, 0x0f, 0x33, 0xd6, 0xd3, 0xd6,
+	0x60, 0xfc, 0xa0, 0x27, 0xd3, 0x11, 0x13, 0x92, 0x8c, 0x0a, 0x57, 0xea, 0xf5, 0x00, 0x3a, 0x2e,
+	0x89, 0x4c, 0xb9, 0x2d, 0x85, 0xfe, 0xad, 0x03, 0x78, 0x34, 0x1e, 0x64, 0x69, 0x72, 0x94, 0x91,
+	0xfc, 0xc8, 0x5e, 0x18, 0x6e, 0x80, 0x55, 0x99, 0xca, 0x8c, 0xf9, 0x5e, 0xc7, 0xeb, 0xb6, 0xb0,
+	0x31, 0x60, 0x07, 0xb4, 0x29, 0x13, 0x49, 0x99, 0x16, 0x0a, 0xc1, 0xbf, 0xa6, 0x7d, 0xf3, 0x47,
+	0xf0, 0x27, 0x0f, 0x6c, 0x12, 0x4a, 0xe3, 0x92, 0x7d, 0x3f, 0x66, 0x42, 0xc6, 0xae, 0x83, 0xc2,
+	0xaf, 0x77, 0xea, 0xdd, 0xf6, 0xdd, 0xdb, 0x51, 0x75, 0x0f, 0xa3, 0x03, 0x4a, 0xb1, 0xc9, 0x71,
+	0x1c, 0xfa, 0x9d, 0xe9, 0x24, 0xbc, 0x75, 0x42, 0x46, 0xd9, 0x3e, 0xaa, 0x84, 0x44, 0x78, 0x9d,
+	0x9c, 0xcb, 0x12, 0xf0, 0x57, 0x0f, 0xf8, 0xe3, 0x82, 0x12, 0xc9, 0x2a, 0x58, 0xac, 0x68, 0x16,
+	0x7b, 0x8b, 0x58, 0x7c, 0xa5, 0xf3, 0x5e, 0x27, 0xf2, 0xfe, 0x74, 0x12, 0x86, 0x86, 0xc8, 0x22,
+	0x60, 0x84, 0xb7, 0xc6, 0x55, 0xb9, 0x86, 0x0e, 0x65, 0x19, 0xab, 0xa4, 0xb3, 0x7a, 0x31, 0x9d,
+	0x43, 0x9d, 0x77, 0x01, 0x9d, 0x45, 0xc0, 0x08, 0x6f, 0xd1, 0xaa, 0x5c, 0xb1, 0xdf, 0xfc, 0xe5,
+	0x59, 0x58, 0x7b, 0xfa, 0x2c, 0xac, 0xa1, 0x3f, 0x9a, 0x00, 0x9e, 0xef, 0x3a, 0x84, 0x60, 0x25,
+	0x27, 0x23, 0x37, 0x78, 0xfd, 0x0d, 0xbf, 0x00, 0x1b, 0x96, 0x5a, 0x5c, 0x70, 0x9e, 0xc5, 0x84,
+	0xd2, 0x92, 0x09, 0x61, 0x1e, 0x40, 0x3f, 0x9c, 0x4e, 0xc2, 0x9b, 0x86, 0x4f, 0x55, 0x14, 0xc2,
+	0xd0, 0x1e, 0x1f, 0x71, 0x9e, 0x1d, 0x98, 0x43, 0xf8, 0x39, 0x58, 0x97, 0xfa, 0x85, 0xeb, 0xc7,
+	0x38, 0x43, 0xac, 0x6b, 0xc4, 0x60, 0x3a, 0x09, 0x77, 0x0d, 0x62, 0x45, 0x10, 0xc2, 0x70, 0xee,
+	0xd4, 0x01, 0xfe, 0xe6, 0x81, 0x0d, 0x21, 0xc9, 0x43, 0x55, 0x5e, 0xad, 0x52, 0xfc, 0x98, 0xa5,
+	0xc3, 0x63, 0xe9, 0x46, 0x7e, 0xcb, 0xf5, 0x58, 0xed, 0xdc, 0x5c, 0x83, 0x93, 0x7b, 0x3c, 0xcd,
+	0xfb, 0xf8, 0xf9, 0x24, 0xac, 0xbd, 0xba, 0x46, 0x15, 0x0e, 0xfa, 0xfd, 0x65, 0xf8, 0xd1, 0x30,
+	0x95, 0xc7, 0xe3, 0x41, 0x94, 0xf0, 0x91, 0x5d, 0x68, 0xfb, 0xb3, 0x27, 0xe8, 0xc3, 0x9e, 0x3c,
+	0x29, 0x98, 0x70, 0x90, 0x02, 0x43, 0x8b, 0xa2, 0xac, 0x6f, 0x0c, 0x06, 0xfc, 0x16, 0x00, 0x21,
+	0x49, 0x29, 0x63, 0xb5, 0xa6, 0xfe, 0x6a, 0xc7, 0xeb, 0xb6, 0xef, 0xee, 0x46, 0x66, 0x45, 0x23,
+	0xb7, 0xa2, 0xd1, 0x97, 0x6e, 0x87, 0xfb, 0xef, 0x59, 0x5e, 0x37, 0x66, 0xbc, 0x6c, 0x2e, 0x7a,
+	0xf2, 0x32, 0xf4, 0x70, 0x4b, 0x1f, 0xa8, 0x70, 0x88, 0x41, 0x93, 0xe5, 0xd4, 0xe0, 0xae, 0xfd,
+	0x2f, 0xee, 0x4d, 0x8b, 0xfb, 0xae, 0xc1, 0x75, 0x99, 0x06, 0xb5, 0xc1, 0x72, 0xaa, 0x31, 0x7f,
+	0xf6, 0xc0, 0x75, 0x56, 0xf0, 0xe4, 0x38, 0x26, 0x23, 0x3e, 0xce, 0xa5, 0xdf, 0xd0, 0xad, 0xdc,
+	0xa9, 0x6c, 0xa5, 0xee, 0xe3, 0x7d, 0x8b, 0xbb, 0x6e, 0x71, 0xe7, 0x92, 0x55, 0xff, 0xba, 0x4b,
+	0xf4, 0xcf, 0x34, 0xaf, 0xad, 0x53, 0x0f, 0x74, 0x26, 0x64, 0xc0, 0x98, 0xb1, 0x56, 0x2e, 0xbf,
+	0xa9, 0xdf, 0xc8, 0xa1, 0x2a, 0xf5, 0xf7, 0x24, 0xfc, 0x60, 0xb9, 0x99, 0x4c, 0x27, 0x21, 0x9c,
+	0x27, 0xa5, 0xa1, 0x10, 0x06, 0xda, 0xc2, 0xca, 0x80, 0x03, 0x00, 0x28, 0x4b, 0xc8, 0x89, 0xf2,
+	0x31, 0xbf, 0xa5, 0xab, 0xdc, 0xbb, 0x74, 0x95, 0x1b, 0x6e, 0x33, 0x1d, 0x12, 0xc2, 0x2d, 0x6d,
+	0x60, 0x22, 0x19, 0xdc, 0x07, 0xd7, 0x8d, 0x47, 0xd7, 0x15, 0x3e, 0xe8, 0x78, 0xdd, 0x77, 0xfa,
+	0xdb, 0xaf, 0x5a, 0x36, 0xef, 0x45, 0x4a, 0x5b, 0x13, 0x72, 0xf2, 0xa9, 0xb6, 0xe0, 0x8f, 0x60,
+	0xbb, 0x64, 0x8f, 0x49, 0x49, 0xe3, 0x47, 0x4c, 0x48, 0xf5, 0x3e, 0x9d, 0x96, 0xfb, 0x6d, 0x3d,
+	0xf1, 0x9d, 0x73, 0x13, 0x3f, 0xb4, 0x01, 0xfd, 0xdb, 0x76, 0x30, 0x81, 0xa9, 0xb2, 0x00, 0x07,
+	0x3d, 0x55, 0xf3, 0xdf, 0x34, 0xde, 0xaf, 0x8d, 0xd3, 0x41, 0xa0, 0x3f, 0x1b, 0x60, 0xb3, 0x52,
+	0x1f, 0xe1, 0x36, 0x68, 0x14, 0x19, 0xc9, 0xe3, 0x94, 0x6a, 0xd5, 0x58, 0xc1, 0x6b, 0xca, 0xfc,
+	0x8c, 0xce, 0xb4, 0xe4, 0xda, 0x12, 0x5a, 0x52, 0x7f, 0xe3, 0x5a, 0xb2, 0xf2, 0xe6, 0xb5, 0x64,
+	0xf5, 0xca, 0x6a, 0xc9, 0xda, 0x52, 0x5a, 0xe2, 0x5d, 0x5a, 0x4b, 0x1a, 0x4b, 0x69, 0x89, 0x77,
+	0x79, 0x2d, 0x69, 0x5e, 0x09, 0x2d, 0x69, 0xbd, 0x15, 0x2d, 0x01, 0x6f, 0x45, 0x4b, 0xda, 0xcb,
+	0x6b, 0x09, 0xfa, 0x18, 0x6c, 0x56, 0xfe, 0xb9, 0x58, 0xb8, 0xcb, 0xfd, 0xfb, 0xcf, 0x4f, 0x03,
+	0xef, 0xc5, 0x69, 0xe0, 0xfd, 0x73, 0x1a, 0x78, 0x4f, 0xce, 0x82, 0xda, 0x8b, 0xb3, 0xa0, 0xf6,
+	0xd7, 0x59, 0x50, 0xfb, 0x6e, 0x6f, 0xee, 0x3e, 0x15, 0x7f, 0x5c, 0x7f, 0x98, 0x7d, 0xe9, 0xab,
+	0x0d, 0xd6, 0xf4, 0x1b, 0xfa, 0xe4, 0xbf, 0x01, 0x00, 0xd0, 0x2d, 0xb7, 0xac, 0x79, 0x0b, 0x00,
+	0x00,
 }
 
 func (m *PublicPlanProposal) Marshal() (dAtA []byte, err error) {
@@ -505,6 +548,29 @@ func (m *AddRequestProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardVestingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardVestingDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintProposal(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x5a
+	if m.DecayEpochs != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.DecayEpochs))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.DecayRate.Size()
+		i -= size
+		if _, err := m.DecayRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.EpochRatio.Size()
 		i -= size
@@ -529,21 +595,21 @@ func (m *AddRequestProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x3a
 		}
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintProposal(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x32
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintProposal(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x32
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintProposal(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x2a
 	if len(m.StakingCoinWeights) > 0 {
 		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
@@ -603,6 +669,21 @@ func (m *UpdateRequestProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DecayEpochs != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.DecayEpochs))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.DecayRate.Size()
+		i -= size
+		if _, err := m.DecayRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	{
 		size := m.EpochRatio.Size()
 		i -= size
@@ -628,22 +709,22 @@ func (m *UpdateRequestProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.EndTime != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
-		if err3 != nil {
-			return 0, err3
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n3
-		i = encodeVarintProposal(dAtA, i, uint64(n3))
+		i -= n4
+		i = encodeVarintProposal(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x3a
 	}
 	if m.StartTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintProposal(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintProposal(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -800,6 +881,13 @@ func (m *AddRequestProposal) Size() (n int) {
 	}
 	l = m.EpochRatio.Size()
 	n += 1 + l + sovProposal(uint64(l))
+	l = m.DecayRate.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	if m.DecayEpochs != 0 {
+		n += 1 + sovProposal(uint64(m.DecayEpochs))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardVestingDuration)
+	n += 1 + l + sovProposal(uint64(l))
 	return n
 }
 
@@ -846,6 +934,11 @@ func (m *UpdateRequestProposal) Size() (n int) {
 	}
 	l = m.EpochRatio.Size()
 	n += 1 + l + sovProposal(uint64(l))
+	l = m.DecayRate.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	if m.DecayEpochs != 0 {
+		n += 1 + sovProposal(uint64(m.DecayEpochs))
+	}
 	return n
 }
 
@@ -1376,6 +1469,92 @@ func (m *AddRequestProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayEpochs", wireType)
+			}
+			m.DecayEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardVestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -1715,6 +1894,59 @@ func (m *UpdateRequestProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayEpochs", wireType)
+			}
+			m.DecayEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -13,20 +13,25 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -344,6 +349,112 @@ func (m *QueryPlanResponse) GetPlan() *types.Any {
 	return nil
 }
 
+// QueryPlanFarmersRequest is the request type for the Query/PlanFarmers RPC method.
+type QueryPlanFarmersRequest struct {
+	PlanId     uint64             `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPlanFarmersRequest) Reset()         { *m = QueryPlanFarmersRequest{} }
+func (m *QueryPlanFarmersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPlanFarmersRequest) ProtoMessage()    {}
+func (*QueryPlanFarmersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{6}
+}
+func (m *QueryPlanFarmersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlanFarmersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlanFarmersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlanFarmersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlanFarmersRequest.Merge(m, src)
+}
+func (m *QueryPlanFarmersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlanFarmersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlanFarmersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlanFarmersRequest proto.InternalMessageInfo
+
+func (m *QueryPlanFarmersRequest) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
+	}
+	return 0
+}
+
+func (m *QueryPlanFarmersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPlanFarmersResponse is the response type for the Query/PlanFarmers RPC method.
+type QueryPlanFarmersResponse struct {
+	Farmers    []string            `protobuf:"bytes,1,rep,name=farmers,proto3" json:"farmers,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPlanFarmersResponse) Reset()         { *m = QueryPlanFarmersResponse{} }
+func (m *QueryPlanFarmersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPlanFarmersResponse) ProtoMessage()    {}
+func (*QueryPlanFarmersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{7}
+}
+func (m *QueryPlanFarmersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlanFarmersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlanFarmersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlanFarmersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlanFarmersResponse.Merge(m, src)
+}
+func (m *QueryPlanFarmersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlanFarmersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlanFarmersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlanFarmersResponse proto.InternalMessageInfo
+
+func (m *QueryPlanFarmersResponse) GetFarmers() []string {
+	if m != nil {
+		return m.Farmers
+	}
+	return nil
+}
+
+func (m *QueryPlanFarmersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryStakingsRequest struct {
 	Farmer           string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
 	StakingCoinDenom string `protobuf:"bytes,2,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
@@ -353,7 +464,7 @@ func (m *QueryStakingsRequest) Reset()         { *m = QueryStakingsRequest{} }
 func (m *QueryStakingsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryStakingsRequest) ProtoMessage()    {}
 func (*QueryStakingsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00c8db58c274b111, []int{6}
+	return fileDescriptor_00c8db58c274b111, []int{8}
 }
 func (m *QueryStakingsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,7 +516,7 @@ func (m *QueryStakingsResponse) Reset()         { *m = QueryStakingsResponse{} }
 func (m *QueryStakingsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryStakingsResponse) ProtoMessage()    {}
 func (*QueryStakingsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00c8db58c274b111, []int{7}
+	return fileDescriptor_00c8db58c274b111, []int{9}
 }
 func (m *QueryStakingsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +567,7 @@ func (m *QueryTotalStakingsRequest) Reset()         { *m = QueryTotalStakingsReq
 func (m *QueryTotalStakingsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalStakingsRequest) ProtoMessage()    {}
 func (*QueryTotalStakingsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00c8db58c274b111, []int{8}
+	return fileDescriptor_00c8db58c274b111, []int{10}
 }
 func (m *QueryTotalStakingsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -500,7 +611,7 @@ func (m *QueryTotalStakingsResponse) Reset()         { *m = QueryTotalStakingsRe
 func (m *QueryTotalStakingsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalStakingsResponse) ProtoMessage()    {}
 func (*QueryTotalStakingsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00c8db58c274b111, []int{9}
+	return fileDescriptor_00c8db58c274b111, []int{11}
 }
 func (m *QueryTotalStakingsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -538,7 +649,7 @@ func (m *QueryRewardsRequest) Reset()         { *m = QueryRewardsRequest{} }
 func (m *QueryRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRewardsRequest) ProtoMessage()    {}
 func (*QueryRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00c8db58c274b111, []int{10}
+	return fileDescriptor_00c8db58c274b111, []int{12}
 }
 func (m *QueryRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,13 +694,15 @@ func (m *QueryRewardsRequest) GetStakingCoinDenom() string {
 
 type QueryRewardsResponse struct {
 	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// plan_rewards specifies the rewards broken down by the plans which allocated them
+	PlanRewards []PlanRewards `protobuf:"bytes,2,rep,name=plan_rewards,json=planRewards,proto3" json:"plan_rewards"`
 }
 
 func (m *QueryRewardsResponse) Reset()         { *m = QueryRewardsResponse{} }
 func (m *QueryRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRewardsResponse) ProtoMessage()    {}
 func (*QueryRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00c8db58c274b111, []int{11}
+	return fileDescriptor_00c8db58c274b111, []int{13}
 }
 func (m *QueryRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,22 +738,31 @@ func (m *QueryRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.C
 	return nil
 }
 
-// QueryCurrentEpochDaysRequest is the request type for the Query/CurrentEpochDays RPC method.
-type QueryCurrentEpochDaysRequest struct {
+func (m *QueryRewardsResponse) GetPlanRewards() []PlanRewards {
+	if m != nil {
+		return m.PlanRewards
+	}
+	return nil
 }
 
-func (m *QueryCurrentEpochDaysRequest) Reset()         { *m = QueryCurrentEpochDaysRequest{} }
-func (m *QueryCurrentEpochDaysRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCurrentEpochDaysRequest) ProtoMessage()    {}
-func (*QueryCurrentEpochDaysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00c8db58c274b111, []int{12}
+// PlanRewards defines the rewards of a farmer allocated by a plan.
+type PlanRewards struct {
+	PlanId  uint64                                   `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
-func (m *QueryCurrentEpochDaysRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *PlanRewards) Reset()         { *m = PlanRewards{} }
+func (m *PlanRewards) String() string { return proto.CompactTextString(m) }
+func (*PlanRewards) ProtoMessage()    {}
+func (*PlanRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{14}
+}
+func (m *PlanRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCurrentEpochDaysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PlanRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCurrentEpochDaysRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PlanRewards.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -650,35 +772,51 @@ func (m *QueryCurrentEpochDaysRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryCurrentEpochDaysRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCurrentEpochDaysRequest.Merge(m, src)
+func (m *PlanRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanRewards.Merge(m, src)
 }
-func (m *QueryCurrentEpochDaysRequest) XXX_Size() int {
+func (m *PlanRewards) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCurrentEpochDaysRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCurrentEpochDaysRequest.DiscardUnknown(m)
+func (m *PlanRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanRewards.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCurrentEpochDaysRequest proto.InternalMessageInfo
+var xxx_messageInfo_PlanRewards proto.InternalMessageInfo
 
-// QuerCurrentEpochDaysResponse is the response type for the Query/CurrentEpochDays RPC method.
-type QueryCurrentEpochDaysResponse struct {
-	CurrentEpochDays uint32 `protobuf:"varint,1,opt,name=current_epoch_days,json=currentEpochDays,proto3" json:"current_epoch_days,omitempty"`
+func (m *PlanRewards) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
+	}
+	return 0
 }
 
-func (m *QueryCurrentEpochDaysResponse) Reset()         { *m = QueryCurrentEpochDaysResponse{} }
-func (m *QueryCurrentEpochDaysResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCurrentEpochDaysResponse) ProtoMessage()    {}
-func (*QueryCurrentEpochDaysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00c8db58c274b111, []int{13}
+func (m *PlanRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
 }
-func (m *QueryCurrentEpochDaysResponse) XXX_Unmarshal(b []byte) error {
+
+// QueryPlanRewardsRequest is the request type for the Query/PlanRewards RPC method.
+type QueryPlanRewardsRequest struct {
+	Farmer           string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	PlanId           uint64 `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	StakingCoinDenom string `protobuf:"bytes,3,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+}
+
+func (m *QueryPlanRewardsRequest) Reset()         { *m = QueryPlanRewardsRequest{} }
+func (m *QueryPlanRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPlanRewardsRequest) ProtoMessage()    {}
+func (*QueryPlanRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{15}
+}
+func (m *QueryPlanRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCurrentEpochDaysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPlanRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCurrentEpochDaysResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPlanRewardsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -688,1189 +826,5505 @@ func (m *QueryCurrentEpochDaysResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryCurrentEpochDaysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCurrentEpochDaysResponse.Merge(m, src)
+func (m *QueryPlanRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlanRewardsRequest.Merge(m, src)
 }
-func (m *QueryCurrentEpochDaysResponse) XXX_Size() int {
+func (m *QueryPlanRewardsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCurrentEpochDaysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCurrentEpochDaysResponse.DiscardUnknown(m)
+func (m *QueryPlanRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlanRewardsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCurrentEpochDaysResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPlanRewardsRequest proto.InternalMessageInfo
 
-func (m *QueryCurrentEpochDaysResponse) GetCurrentEpochDays() uint32 {
+func (m *QueryPlanRewardsRequest) GetFarmer() string {
 	if m != nil {
-		return m.CurrentEpochDays
+		return m.Farmer
 	}
-	return 0
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.farming.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.farming.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryPlansRequest)(nil), "cosmos.farming.v1beta1.QueryPlansRequest")
-	proto.RegisterType((*QueryPlansResponse)(nil), "cosmos.farming.v1beta1.QueryPlansResponse")
-	proto.RegisterType((*QueryPlanRequest)(nil), "cosmos.farming.v1beta1.QueryPlanRequest")
-	proto.RegisterType((*QueryPlanResponse)(nil), "cosmos.farming.v1beta1.QueryPlanResponse")
-	proto.RegisterType((*QueryStakingsRequest)(nil), "cosmos.farming.v1beta1.QueryStakingsRequest")
-	proto.RegisterType((*QueryStakingsResponse)(nil), "cosmos.farming.v1beta1.QueryStakingsResponse")
-	proto.RegisterType((*QueryTotalStakingsRequest)(nil), "cosmos.farming.v1beta1.QueryTotalStakingsRequest")
-	proto.RegisterType((*QueryTotalStakingsResponse)(nil), "cosmos.farming.v1beta1.QueryTotalStakingsResponse")
-	proto.RegisterType((*QueryRewardsRequest)(nil), "cosmos.farming.v1beta1.QueryRewardsRequest")
-	proto.RegisterType((*QueryRewardsResponse)(nil), "cosmos.farming.v1beta1.QueryRewardsResponse")
-	proto.RegisterType((*QueryCurrentEpochDaysRequest)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochDaysRequest")
-	proto.RegisterType((*QueryCurrentEpochDaysResponse)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochDaysResponse")
+func (m *QueryPlanRewardsRequest) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("tendermint/farming/v1beta1/query.proto", fileDescriptor_00c8db58c274b111)
+func (m *QueryPlanRewardsRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
+	}
+	return ""
 }
 
-var fileDescriptor_00c8db58c274b111 = []byte{
-	// 997 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xba, 0x8e, 0xd3, 0xbe, 0x50, 0x29, 0x4c, 0x4d, 0x71, 0x56, 0xed, 0x26, 0x5a, 0x89,
-	0xd4, 0x71, 0x9c, 0xdd, 0xc4, 0xa5, 0x27, 0xe0, 0x50, 0xa7, 0xb4, 0xe4, 0x80, 0x54, 0xb6, 0x9c,
-	0x00, 0xc9, 0x1a, 0x7b, 0xa7, 0xae, 0x55, 0x7b, 0xc7, 0xd9, 0x5d, 0x03, 0x56, 0x95, 0x0b, 0x12,
-	0x07, 0x24, 0x0e, 0x48, 0x20, 0x0e, 0x9c, 0x38, 0x73, 0x85, 0x1b, 0xff, 0x40, 0xc5, 0xa9, 0x12,
-	0x1c, 0x10, 0x87, 0x82, 0x12, 0xfe, 0x07, 0xae, 0x68, 0xde, 0xbc, 0xdd, 0xd8, 0x8e, 0xd7, 0x71,
-	0xa5, 0xe6, 0xe4, 0x9d, 0x79, 0xef, 0x7d, 0xef, 0xdb, 0xef, 0xfd, 0x58, 0xc3, 0x46, 0x2c, 0x02,
-	0x5f, 0x84, 0xbd, 0x4e, 0x10, 0xbb, 0x0f, 0xb9, 0xfa, 0x6d, 0xbb, 0x9f, 0xee, 0x36, 0x45, 0xcc,
-	0x77, 0xdd, 0x83, 0x81, 0x08, 0x87, 0x4e, 0x3f, 0x94, 0xb1, 0x64, 0x57, 0x5b, 0x32, 0xea, 0xc9,
-	0xc8, 0x21, 0x1f, 0x87, 0x7c, 0xcc, 0xf2, 0x8c, 0xf8, 0xc4, 0x17, 0x11, 0xcc, 0x8a, 0x46, 0x70,
-	0x9b, 0x3c, 0x12, 0x1a, 0x3a, 0x75, 0xec, 0xf3, 0x76, 0x27, 0xe0, 0x71, 0x47, 0x06, 0xe4, 0x5b,
-	0x6c, 0xcb, 0xb6, 0xc4, 0x47, 0x57, 0x3d, 0xd1, 0xed, 0x6a, 0x5b, 0xca, 0x76, 0x57, 0xb8, 0x78,
-	0x6a, 0x0e, 0x1e, 0xba, 0x3c, 0x20, 0x7a, 0xe6, 0x35, 0x32, 0xf1, 0x7e, 0xc7, 0xe5, 0x41, 0x20,
-	0x63, 0x44, 0x8b, 0x92, 0x40, 0x9d, 0xba, 0xa1, 0x11, 0xe9, 0x4d, 0xb4, 0xc9, 0x1a, 0x65, 0x95,
-	0xf0, 0x69, 0xc9, 0x0e, 0x31, 0xb1, 0x8b, 0xc0, 0x3e, 0x50, 0x5c, 0xef, 0xf3, 0x90, 0xf7, 0x22,
-	0x4f, 0x1c, 0x0c, 0x44, 0x14, 0xdb, 0x0f, 0xe0, 0xca, 0xd8, 0x6d, 0xd4, 0x97, 0x41, 0x24, 0xd8,
-	0xdb, 0x50, 0xe8, 0xe3, 0x4d, 0xc9, 0x58, 0x37, 0xca, 0xcb, 0x35, 0xcb, 0x99, 0xae, 0x9a, 0xa3,
-	0xe3, 0xea, 0xf9, 0xa7, 0xcf, 0xd7, 0x16, 0x3c, 0x8a, 0xb1, 0x7f, 0xcc, 0xc1, 0xab, 0x1a, 0xb5,
-	0xcb, 0x83, 0x24, 0x15, 0x63, 0x90, 0x8f, 0x87, 0x7d, 0x81, 0x88, 0x97, 0x3c, 0x7c, 0x66, 0x3b,
-	0x50, 0x24, 0xc4, 0x46, 0x5f, 0xca, 0x6e, 0x83, 0xfb, 0x7e, 0x28, 0xa2, 0xa8, 0x94, 0x43, 0x1f,
-	0x46, 0xb6, 0xfb, 0x52, 0x76, 0x6f, 0x6b, 0x0b, 0x73, 0xe1, 0x4a, 0x8c, 0x55, 0x42, 0x5d, 0xd2,
-	0x80, 0x0b, 0x3a, 0x60, 0xc4, 0x94, 0x04, 0x54, 0x81, 0x45, 0x31, 0x7f, 0xac, 0x52, 0x28, 0x35,
-	0x1a, 0xbe, 0x08, 0x64, 0xaf, 0x94, 0x47, 0xff, 0x15, 0xb2, 0xec, 0xc9, 0x4e, 0x70, 0x47, 0xdd,
-	0x33, 0x0b, 0x20, 0xc1, 0x10, 0x7e, 0x69, 0x11, 0xbd, 0x46, 0x6e, 0xd8, 0x5d, 0x80, 0x93, 0x1a,
-	0x97, 0x0a, 0x28, 0xce, 0x46, 0x22, 0x8e, 0x92, 0xde, 0xd1, 0xbd, 0x76, 0xa2, 0x4f, 0x5b, 0x90,
-	0x00, 0xde, 0x48, 0xa4, 0xfd, 0x9d, 0x91, 0x94, 0x43, 0x4b, 0x44, 0xba, 0xdf, 0x82, 0xc5, 0xbe,
-	0xba, 0x28, 0x19, 0xeb, 0x17, 0xca, 0xcb, 0xb5, 0xa2, 0xa3, 0xbb, 0xc1, 0x49, 0x1a, 0xc5, 0xb9,
-	0x1d, 0x0c, 0xeb, 0x97, 0x7e, 0xfb, 0x65, 0x7b, 0x51, 0xc5, 0xed, 0x7b, 0xda, 0x9b, 0xdd, 0x1b,
-	0x63, 0x95, 0x43, 0x56, 0x37, 0xce, 0x64, 0xa5, 0x73, 0x8e, 0xd1, 0xda, 0x82, 0x95, 0x94, 0x55,
-	0x52, 0xb7, 0xd7, 0x61, 0x49, 0x65, 0x69, 0x74, 0x7c, 0x2c, 0x5d, 0xde, 0x2b, 0xa8, 0xe3, 0xbe,
-	0x6f, 0xbf, 0x37, 0x52, 0xe5, 0xf4, 0x0d, 0x6e, 0x42, 0x5e, 0x99, 0xa9, 0x6f, 0xce, 0x7c, 0x01,
-	0x74, 0xb6, 0x3f, 0x81, 0x22, 0x22, 0x3d, 0xd0, 0xe5, 0x48, 0x5b, 0xe6, 0x2a, 0x14, 0x54, 0x0b,
-	0x88, 0x90, 0x9a, 0x86, 0x4e, 0x19, 0x35, 0xcd, 0x4d, 0xaf, 0xa9, 0xfd, 0x9f, 0x01, 0xaf, 0x4d,
-	0xc0, 0x13, 0xd9, 0x00, 0x5e, 0x51, 0xde, 0xc2, 0x47, 0x98, 0x44, 0xf5, 0xd5, 0x31, 0xe5, 0x12,
-	0xcd, 0x14, 0x5e, 0x7d, 0x47, 0xf5, 0xf9, 0x4f, 0x7f, 0xaf, 0x95, 0xdb, 0x9d, 0xf8, 0xd1, 0xa0,
-	0xe9, 0xb4, 0x64, 0x8f, 0xa6, 0x90, 0x7e, 0xb6, 0x23, 0xff, 0xb1, 0xab, 0x5a, 0x3b, 0xc2, 0x80,
-	0xc8, 0x5b, 0xd6, 0x09, 0xf0, 0xa0, 0xf2, 0x1d, 0x0c, 0xc4, 0x20, 0xcd, 0x97, 0x3b, 0x87, 0x7c,
-	0x3a, 0x01, 0x1e, 0xec, 0x7d, 0x58, 0xc5, 0x17, 0xff, 0x50, 0xc6, 0xbc, 0x3b, 0x29, 0xee, 0x74,
-	0x11, 0x8d, 0x0c, 0x11, 0x7d, 0x30, 0xa7, 0x41, 0x91, 0x90, 0x77, 0xa1, 0xc0, 0x7b, 0x72, 0x10,
-	0xc4, 0x3a, 0xbe, 0xee, 0x28, 0xde, 0x7f, 0x3d, 0x5f, 0xdb, 0x98, 0x83, 0xf7, 0x7e, 0x10, 0x7b,
-	0x14, 0x6d, 0x7f, 0x4c, 0xeb, 0xc8, 0x13, 0x9f, 0xf1, 0xd0, 0x7f, 0xc9, 0x7d, 0x70, 0x48, 0x5d,
-	0x96, 0x82, 0x13, 0x79, 0x01, 0x4b, 0xa1, 0xbe, 0x3a, 0x8f, 0x06, 0x48, 0xb0, 0x6d, 0x0b, 0xae,
-	0x61, 0xfa, 0xbd, 0x41, 0x18, 0x8a, 0x20, 0x7e, 0xb7, 0x2f, 0x5b, 0x8f, 0xee, 0xf0, 0x61, 0xba,
-	0x8a, 0xdf, 0x87, 0xeb, 0x19, 0x76, 0xe2, 0x59, 0x05, 0xd6, 0xd2, 0xb6, 0x86, 0x50, 0xc6, 0x86,
-	0xcf, 0x87, 0x7a, 0x41, 0x5f, 0xf6, 0x56, 0x5a, 0x13, 0x51, 0xb5, 0x3f, 0x2e, 0xc2, 0x22, 0xe2,
-	0xb1, 0xaf, 0x0c, 0x28, 0xe8, 0x3d, 0xcd, 0x2a, 0x59, 0x7b, 0xfc, 0xf4, 0xa7, 0xc1, 0xdc, 0x9a,
-	0xcb, 0x57, 0x73, 0xb3, 0x37, 0xbe, 0xf8, 0xfd, 0xdf, 0x6f, 0x73, 0xeb, 0xcc, 0x4a, 0xd4, 0x98,
-	0xfc, 0x84, 0xea, 0x4f, 0x03, 0xfb, 0xd2, 0x00, 0x9c, 0xfc, 0x88, 0x6d, 0xce, 0x86, 0x1f, 0xf9,
-	0x72, 0x98, 0x95, 0x79, 0x5c, 0x89, 0xc8, 0x1b, 0x48, 0x64, 0x8d, 0x5d, 0xcf, 0x24, 0x82, 0xd9,
-	0xbf, 0x36, 0x20, 0xaf, 0x02, 0x59, 0xf9, 0x4c, 0xec, 0x84, 0xc5, 0xe6, 0x1c, 0x9e, 0x44, 0xc2,
-	0x45, 0x12, 0x9b, 0xec, 0xc6, 0x4c, 0x12, 0xee, 0x13, 0xda, 0xab, 0x87, 0xec, 0x07, 0x03, 0x2e,
-	0x26, 0x43, 0xc5, 0xaa, 0x33, 0x13, 0x4d, 0x8c, 0xb1, 0xb9, 0x3d, 0xa7, 0x37, 0x51, 0xdb, 0x45,
-	0x6a, 0x5b, 0x6c, 0x33, 0x8b, 0x1a, 0x8d, 0x4d, 0xe4, 0x3e, 0xd1, 0x43, 0x76, 0xc8, 0x7e, 0x35,
-	0xe0, 0xf2, 0xd8, 0xd8, 0xb3, 0xdd, 0x99, 0x39, 0xa7, 0x6d, 0x1b, 0xb3, 0xf6, 0x22, 0x21, 0xc4,
-	0x75, 0x0f, 0xb9, 0xbe, 0xc3, 0xde, 0xca, 0xe2, 0x1a, 0xab, 0xb0, 0xc6, 0x09, 0xe3, 0xd3, 0xcb,
-	0xe0, 0x90, 0x7d, 0x6f, 0xc0, 0x12, 0x4d, 0x3c, 0x9b, 0xdd, 0xd2, 0xe3, 0x4b, 0xc7, 0xac, 0xce,
-	0xe7, 0x4c, 0x5c, 0x77, 0x90, 0x6b, 0x85, 0x95, 0xb3, 0xb8, 0xd2, 0x1a, 0x38, 0x91, 0xf5, 0x67,
-	0x03, 0x56, 0x26, 0x67, 0x9d, 0xbd, 0x39, 0x33, 0x69, 0xc6, 0xea, 0x30, 0x6f, 0xbd, 0x60, 0x14,
-	0x71, 0xae, 0x21, 0xe7, 0x2a, 0xab, 0x64, 0x71, 0x3e, 0xbd, 0x6e, 0xea, 0xf7, 0x9e, 0x1e, 0x59,
-	0xc6, 0xb3, 0x23, 0xcb, 0xf8, 0xe7, 0xc8, 0x32, 0xbe, 0x39, 0xb6, 0x16, 0x9e, 0x1d, 0x5b, 0x0b,
-	0x7f, 0x1e, 0x5b, 0x0b, 0x1f, 0x6d, 0x8f, 0xac, 0xc4, 0x29, 0xff, 0xa5, 0x3f, 0x4f, 0x9f, 0x70,
-	0x3b, 0x36, 0x0b, 0xf8, 0x97, 0xe0, 0xe6, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa4, 0xaf, 0xda,
-	0x04, 0xb8, 0x0b, 0x00, 0x00,
+// QueryPlanRewardsResponse is the response type for the Query/PlanRewards RPC method.
+type QueryPlanRewardsResponse struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryPlanRewardsResponse) Reset()         { *m = QueryPlanRewardsResponse{} }
+func (m *QueryPlanRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPlanRewardsResponse) ProtoMessage()    {}
+func (*QueryPlanRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{16}
+}
+func (m *QueryPlanRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlanRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlanRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlanRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlanRewardsResponse.Merge(m, src)
+}
+func (m *QueryPlanRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlanRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlanRewardsResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPlanRewardsResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns parameters of the farming module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Plans returns all plans.
-	Plans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error)
-	// Plan returns a specific plan.
-	Plan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error)
-	Stakings(ctx context.Context, in *QueryStakingsRequest, opts ...grpc.CallOption) (*QueryStakingsResponse, error)
-	TotalStakings(ctx context.Context, in *QueryTotalStakingsRequest, opts ...grpc.CallOption) (*QueryTotalStakingsResponse, error)
-	Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error)
-	// CurrentEpochDays returns current epoch days.
-	CurrentEpochDays(ctx context.Context, in *QueryCurrentEpochDaysRequest, opts ...grpc.CallOption) (*QueryCurrentEpochDaysResponse, error)
+func (m *QueryPlanRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryAprRequest is the request type for the Query/Apr RPC method.
+type QueryAprRequest struct {
+	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	// additional_staking_amount specifies a hypothetical amount staked on top of
+	// the current total stakings, to show the dilution of the rewards
+	AdditionalStakingAmount string `protobuf:"bytes,2,opt,name=additional_staking_amount,json=additionalStakingAmount,proto3" json:"additional_staking_amount,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAprRequest) Reset()         { *m = QueryAprRequest{} }
+func (m *QueryAprRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAprRequest) ProtoMessage()    {}
+func (*QueryAprRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{17}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAprRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAprRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAprRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAprRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAprRequest.Merge(m, src)
+}
+func (m *QueryAprRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAprRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAprRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Plans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error) {
-	out := new(QueryPlansResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Plans", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAprRequest proto.InternalMessageInfo
+
+func (m *QueryAprRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Plan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error) {
-	out := new(QueryPlanResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Plan", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAprRequest) GetAdditionalStakingAmount() string {
+	if m != nil {
+		return m.AdditionalStakingAmount
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Stakings(ctx context.Context, in *QueryStakingsRequest, opts ...grpc.CallOption) (*QueryStakingsResponse, error) {
-	out := new(QueryStakingsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Stakings", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryAprResponse is the response type for the Query/Apr RPC method.
+type QueryAprResponse struct {
+	// total_stakings specifies the total stakings the projection is based on,
+	// including the additional staking amount
+	TotalStakings github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=total_stakings,json=totalStakings,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_stakings"`
+	// epoch_rewards specifies the rewards allocated to the staking coin denom per epoch
+	EpochRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=epoch_rewards,json=epochRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_rewards"`
+	// epoch_unit_rewards specifies the projected rewards per staked unit per epoch
+	EpochUnitRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=epoch_unit_rewards,json=epochUnitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"epoch_unit_rewards"`
+	// annual_unit_rewards specifies the projected rewards per staked unit per year
+	AnnualUnitRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=annual_unit_rewards,json=annualUnitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"annual_unit_rewards"`
 }
 
-func (c *queryClient) TotalStakings(ctx context.Context, in *QueryTotalStakingsRequest, opts ...grpc.CallOption) (*QueryTotalStakingsResponse, error) {
-	out := new(QueryTotalStakingsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/TotalStakings", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAprResponse) Reset()         { *m = QueryAprResponse{} }
+func (m *QueryAprResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAprResponse) ProtoMessage()    {}
+func (*QueryAprResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{18}
+}
+func (m *QueryAprResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAprResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAprResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAprResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAprResponse.Merge(m, src)
+}
+func (m *QueryAprResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAprResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAprResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error) {
-	out := new(QueryRewardsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Rewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAprResponse proto.InternalMessageInfo
+
+func (m *QueryAprResponse) GetEpochRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EpochRewards
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) CurrentEpochDays(ctx context.Context, in *QueryCurrentEpochDaysRequest, opts ...grpc.CallOption) (*QueryCurrentEpochDaysResponse, error) {
-	out := new(QueryCurrentEpochDaysResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/CurrentEpochDays", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAprResponse) GetEpochUnitRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.EpochUnitRewards
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns parameters of the farming module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Plans returns all plans.
-	Plans(context.Context, *QueryPlansRequest) (*QueryPlansResponse, error)
-	// Plan returns a specific plan.
-	Plan(context.Context, *QueryPlanRequest) (*QueryPlanResponse, error)
-	Stakings(context.Context, *QueryStakingsRequest) (*QueryStakingsResponse, error)
-	TotalStakings(context.Context, *QueryTotalStakingsRequest) (*QueryTotalStakingsResponse, error)
-	Rewards(context.Context, *QueryRewardsRequest) (*QueryRewardsResponse, error)
-	// CurrentEpochDays returns current epoch days.
-	CurrentEpochDays(context.Context, *QueryCurrentEpochDaysRequest) (*QueryCurrentEpochDaysResponse, error)
+func (m *QueryAprResponse) GetAnnualUnitRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.AnnualUnitRewards
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryVestingRewardsRequest is the request type for the Query/VestingRewards RPC method.
+type QueryVestingRewardsRequest struct {
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryVestingRewardsRequest) Reset()         { *m = QueryVestingRewardsRequest{} }
+func (m *QueryVestingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingRewardsRequest) ProtoMessage()    {}
+func (*QueryVestingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{19}
 }
-func (*UnimplementedQueryServer) Plans(ctx context.Context, req *QueryPlansRequest) (*QueryPlansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Plans not implemented")
+func (m *QueryVestingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Plan(ctx context.Context, req *QueryPlanRequest) (*QueryPlanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Plan not implemented")
+func (m *QueryVestingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Stakings(ctx context.Context, req *QueryStakingsRequest) (*QueryStakingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Stakings not implemented")
+func (m *QueryVestingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingRewardsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TotalStakings(ctx context.Context, req *QueryTotalStakingsRequest) (*QueryTotalStakingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalStakings not implemented")
+func (m *QueryVestingRewardsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Rewards(ctx context.Context, req *QueryRewardsRequest) (*QueryRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Rewards not implemented")
+func (m *QueryVestingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingRewardsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) CurrentEpochDays(ctx context.Context, req *QueryCurrentEpochDaysRequest) (*QueryCurrentEpochDaysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpochDays not implemented")
+
+var xxx_messageInfo_QueryVestingRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryVestingRewardsRequest) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryVestingRewardsResponse is the response type for the Query/VestingRewards RPC method.
+type QueryVestingRewardsResponse struct {
+	// locked_rewards specifies the vesting rewards that are not unlocked yet
+	LockedRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=locked_rewards,json=lockedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked_rewards"`
+	// unlocked_rewards specifies the vesting rewards that are unlocked and claimable
+	UnlockedRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unlocked_rewards,json=unlockedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocked_rewards"`
+	RewardVestings  []RewardVesting                          `protobuf:"bytes,3,rep,name=reward_vestings,json=rewardVestings,proto3" json:"reward_vestings"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryVestingRewardsResponse) Reset()         { *m = QueryVestingRewardsResponse{} }
+func (m *QueryVestingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingRewardsResponse) ProtoMessage()    {}
+func (*QueryVestingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{20}
+}
+func (m *QueryVestingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryVestingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingRewardsResponse.Merge(m, src)
+}
+func (m *QueryVestingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingRewardsResponse.DiscardUnknown(m)
 }
 
-func _Query_Plans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPlansRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Plans(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/Plans",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Plans(ctx, req.(*QueryPlansRequest))
+var xxx_messageInfo_QueryVestingRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryVestingRewardsResponse) GetLockedRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LockedRewards
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Plan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPlanRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Plan(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/Plan",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Plan(ctx, req.(*QueryPlanRequest))
+func (m *QueryVestingRewardsResponse) GetUnlockedRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UnlockedRewards
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Stakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Stakings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/Stakings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Stakings(ctx, req.(*QueryStakingsRequest))
+func (m *QueryVestingRewardsResponse) GetRewardVestings() []RewardVesting {
+	if m != nil {
+		return m.RewardVestings
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TotalStakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalStakingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalStakings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/TotalStakings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalStakings(ctx, req.(*QueryTotalStakingsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryUnbondingStakingsRequest is the request type for the Query/UnbondingStakings RPC method.
+type QueryUnbondingStakingsRequest struct {
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
 }
 
-func _Query_Rewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Rewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/Rewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Rewards(ctx, req.(*QueryRewardsRequest))
+func (m *QueryUnbondingStakingsRequest) Reset()         { *m = QueryUnbondingStakingsRequest{} }
+func (m *QueryUnbondingStakingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingStakingsRequest) ProtoMessage()    {}
+func (*QueryUnbondingStakingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{21}
+}
+func (m *QueryUnbondingStakingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingStakingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingStakingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryUnbondingStakingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingStakingsRequest.Merge(m, src)
+}
+func (m *QueryUnbondingStakingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingStakingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingStakingsRequest.DiscardUnknown(m)
 }
 
-func _Query_CurrentEpochDays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCurrentEpochDaysRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CurrentEpochDays(ctx, in)
+var xxx_messageInfo_QueryUnbondingStakingsRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingStakingsRequest) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/CurrentEpochDays",
+	return ""
+}
+
+// QueryUnbondingStakingsResponse is the response type for the Query/UnbondingStakings RPC method.
+type QueryUnbondingStakingsResponse struct {
+	Entries []UnbondingStakingEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *QueryUnbondingStakingsResponse) Reset()         { *m = QueryUnbondingStakingsResponse{} }
+func (m *QueryUnbondingStakingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingStakingsResponse) ProtoMessage()    {}
+func (*QueryUnbondingStakingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{22}
+}
+func (m *QueryUnbondingStakingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingStakingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingStakingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CurrentEpochDays(ctx, req.(*QueryCurrentEpochDaysRequest))
+}
+func (m *QueryUnbondingStakingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingStakingsResponse.Merge(m, src)
+}
+func (m *QueryUnbondingStakingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingStakingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingStakingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingStakingsResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingStakingsResponse) GetEntries() []UnbondingStakingEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.farming.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Plans",
-			Handler:    _Query_Plans_Handler,
-		},
-		{
-			MethodName: "Plan",
-			Handler:    _Query_Plan_Handler,
-		},
-		{
-			MethodName: "Stakings",
-			Handler:    _Query_Stakings_Handler,
-		},
-		{
-			MethodName: "TotalStakings",
-			Handler:    _Query_TotalStakings_Handler,
-		},
-		{
-			MethodName: "Rewards",
-			Handler:    _Query_Rewards_Handler,
-		},
-		{
-			MethodName: "CurrentEpochDays",
-			Handler:    _Query_CurrentEpochDays_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tendermint/farming/v1beta1/query.proto",
+// QueryRewardWithdrawAddressRequest is the request type for the Query/RewardWithdrawAddress RPC method.
+type QueryRewardWithdrawAddressRequest struct {
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardWithdrawAddressRequest) Reset()         { *m = QueryRewardWithdrawAddressRequest{} }
+func (m *QueryRewardWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardWithdrawAddressRequest) ProtoMessage()    {}
+func (*QueryRewardWithdrawAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{23}
+}
+func (m *QueryRewardWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardWithdrawAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardWithdrawAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRewardWithdrawAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardWithdrawAddressRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRewardWithdrawAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardWithdrawAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardWithdrawAddressRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardWithdrawAddressRequest proto.InternalMessageInfo
+
+func (m *QueryRewardWithdrawAddressRequest) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardWithdrawAddressResponse is the response type for the Query/RewardWithdrawAddress RPC method.
+type QueryRewardWithdrawAddressResponse struct {
+	WithdrawAddress string `protobuf:"bytes,1,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRewardWithdrawAddressResponse) Reset()         { *m = QueryRewardWithdrawAddressResponse{} }
+func (m *QueryRewardWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardWithdrawAddressResponse) ProtoMessage()    {}
+func (*QueryRewardWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{24}
+}
+func (m *QueryRewardWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardWithdrawAddressResponse.Merge(m, src)
+}
+func (m *QueryRewardWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardWithdrawAddressResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPlansRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardWithdrawAddressResponse proto.InternalMessageInfo
+
+func (m *QueryRewardWithdrawAddressResponse) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPlansRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAutoCompoundFarmersRequest is the request type for the Query/AutoCompoundFarmers RPC method.
+type QueryAutoCompoundFarmersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAutoCompoundFarmersRequest) Reset()         { *m = QueryAutoCompoundFarmersRequest{} }
+func (m *QueryAutoCompoundFarmersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundFarmersRequest) ProtoMessage()    {}
+func (*QueryAutoCompoundFarmersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{25}
+}
+func (m *QueryAutoCompoundFarmersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundFarmersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundFarmersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Terminated) > 0 {
-		i -= len(m.Terminated)
-		copy(dAtA[i:], m.Terminated)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Terminated)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.StakingCoinDenom) > 0 {
-		i -= len(m.StakingCoinDenom)
-		copy(dAtA[i:], m.StakingCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TerminationAddress) > 0 {
-		i -= len(m.TerminationAddress)
-		copy(dAtA[i:], m.TerminationAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TerminationAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.FarmingPoolAddress) > 0 {
-		i -= len(m.FarmingPoolAddress)
-		copy(dAtA[i:], m.FarmingPoolAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FarmingPoolAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAutoCompoundFarmersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundFarmersRequest.Merge(m, src)
+}
+func (m *QueryAutoCompoundFarmersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundFarmersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundFarmersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPlansResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAutoCompoundFarmersRequest proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundFarmersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPlansResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAutoCompoundFarmersResponse is the response type for the Query/AutoCompoundFarmers RPC method.
+type QueryAutoCompoundFarmersResponse struct {
+	Farmers    []string            `protobuf:"bytes,1,rep,name=farmers,proto3" json:"farmers,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Plans) > 0 {
-		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAutoCompoundFarmersResponse) Reset()         { *m = QueryAutoCompoundFarmersResponse{} }
+func (m *QueryAutoCompoundFarmersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundFarmersResponse) ProtoMessage()    {}
+func (*QueryAutoCompoundFarmersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{26}
+}
+func (m *QueryAutoCompoundFarmersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundFarmersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundFarmersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAutoCompoundFarmersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundFarmersResponse.Merge(m, src)
+}
+func (m *QueryAutoCompoundFarmersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundFarmersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundFarmersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPlanRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAutoCompoundFarmersResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundFarmersResponse) GetFarmers() []string {
+	if m != nil {
+		return m.Farmers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPlanRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAutoCompoundFarmersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PlanId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// QueryRewardsDustRequest is the request type for the Query/RewardsDust RPC method.
+type QueryRewardsDustRequest struct {
 }
 
-func (m *QueryPlanResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardsDustRequest) Reset()         { *m = QueryRewardsDustRequest{} }
+func (m *QueryRewardsDustRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsDustRequest) ProtoMessage()    {}
+func (*QueryRewardsDustRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{27}
+}
+func (m *QueryRewardsDustRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsDustRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsDustRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryRewardsDustRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsDustRequest.Merge(m, src)
+}
+func (m *QueryRewardsDustRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsDustRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsDustRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPlanResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryRewardsDustRequest proto.InternalMessageInfo
+
+// QueryRewardsDustResponse is the response type for the Query/RewardsDust RPC method.
+type QueryRewardsDustResponse struct {
+	RewardsDust github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards_dust,json=rewardsDust,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards_dust"`
 }
 
-func (m *QueryPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Plan != nil {
-		{
-			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRewardsDustResponse) Reset()         { *m = QueryRewardsDustResponse{} }
+func (m *QueryRewardsDustResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsDustResponse) ProtoMessage()    {}
+func (*QueryRewardsDustResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{28}
+}
+func (m *QueryRewardsDustResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsDustResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsDustResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardsDustResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsDustResponse.Merge(m, src)
+}
+func (m *QueryRewardsDustResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsDustResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsDustResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStakingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardsDustResponse proto.InternalMessageInfo
+
+func (m *QueryRewardsDustResponse) GetRewardsDust() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardsDust
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStakingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEpochInfoRequest is the request type for the Query/EpochInfo RPC method.
+type QueryEpochInfoRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStakingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingCoinDenom) > 0 {
-		i -= len(m.StakingCoinDenom)
-		copy(dAtA[i:], m.StakingCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Farmer) > 0 {
-		i -= len(m.Farmer)
-		copy(dAtA[i:], m.Farmer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryEpochInfoRequest) Reset()         { *m = QueryEpochInfoRequest{} }
+func (m *QueryEpochInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochInfoRequest) ProtoMessage()    {}
+func (*QueryEpochInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{29}
+}
+func (m *QueryEpochInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryEpochInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochInfoRequest.Merge(m, src)
+}
+func (m *QueryEpochInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochInfoRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStakingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEpochInfoRequest proto.InternalMessageInfo
+
+func (m *QueryEpochInfoRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStakingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEpochInfoResponse is the response type for the Query/EpochInfo RPC method.
+type QueryEpochInfoResponse struct {
+	// last_epoch_time is the time when the last epoch was advanced;
+	// it is empty before the first block is processed
+	LastEpochTime *time.Time `protobuf:"bytes,1,opt,name=last_epoch_time,json=lastEpochTime,proto3,stdtime" json:"last_epoch_time,omitempty"`
+	// current_epoch_duration is the length of the current epoch
+	CurrentEpochDuration time.Duration `protobuf:"bytes,2,opt,name=current_epoch_duration,json=currentEpochDuration,proto3,stdduration" json:"current_epoch_duration"`
+	// next_epoch_time is the time from which the current epoch is advanced in the end blocker;
+	// it is empty before the first block is processed
+	NextEpochTime *time.Time          `protobuf:"bytes,3,opt,name=next_epoch_time,json=nextEpochTime,proto3,stdtime" json:"next_epoch_time,omitempty"`
+	CurrentEpochs []CurrentEpoch      `protobuf:"bytes,4,rep,name=current_epochs,json=currentEpochs,proto3" json:"current_epochs"`
+	Pagination    *query.PageResponse `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStakingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QueuedCoins) > 0 {
-		for iNdEx := len(m.QueuedCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.QueuedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.StakedCoins) > 0 {
-		for iNdEx := len(m.StakedCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StakedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryEpochInfoResponse) Reset()         { *m = QueryEpochInfoResponse{} }
+func (m *QueryEpochInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochInfoResponse) ProtoMessage()    {}
+func (*QueryEpochInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{30}
+}
+func (m *QueryEpochInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTotalStakingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEpochInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochInfoResponse.Merge(m, src)
 }
-
-func (m *QueryTotalStakingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEpochInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochInfoResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTotalStakingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingCoinDenom) > 0 {
-		i -= len(m.StakingCoinDenom)
-		copy(dAtA[i:], m.StakingCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryEpochInfoResponse proto.InternalMessageInfo
+
+func (m *QueryEpochInfoResponse) GetLastEpochTime() *time.Time {
+	if m != nil {
+		return m.LastEpochTime
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryTotalStakingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEpochInfoResponse) GetCurrentEpochDuration() time.Duration {
+	if m != nil {
+		return m.CurrentEpochDuration
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryTotalStakingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEpochInfoResponse) GetNextEpochTime() *time.Time {
+	if m != nil {
+		return m.NextEpochTime
+	}
+	return nil
 }
 
-func (m *QueryTotalStakingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryEpochInfoResponse) GetCurrentEpochs() []CurrentEpoch {
+	if m != nil {
+		return m.CurrentEpochs
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEpochInfoResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CurrentEpoch defines the current epoch of the rewards of a staking coin denom.
+type CurrentEpoch struct {
+	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	CurrentEpoch     uint64 `protobuf:"varint,2,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
 }
 
-func (m *QueryRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingCoinDenom) > 0 {
-		i -= len(m.StakingCoinDenom)
-		copy(dAtA[i:], m.StakingCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Farmer) > 0 {
-		i -= len(m.Farmer)
-		copy(dAtA[i:], m.Farmer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *CurrentEpoch) Reset()         { *m = CurrentEpoch{} }
+func (m *CurrentEpoch) String() string { return proto.CompactTextString(m) }
+func (*CurrentEpoch) ProtoMessage()    {}
+func (*CurrentEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{31}
 }
-
-func (m *QueryRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CurrentEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurrentEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurrentEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CurrentEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrentEpoch.Merge(m, src)
+}
+func (m *CurrentEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurrentEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrentEpoch.DiscardUnknown(m)
 }
 
-func (m *QueryRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_CurrentEpoch proto.InternalMessageInfo
+
+func (m *CurrentEpoch) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryCurrentEpochDaysRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CurrentEpoch) GetCurrentEpoch() uint64 {
+	if m != nil {
+		return m.CurrentEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryCurrentEpochDaysRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCurrentEpochDaysRequest is the request type for the Query/CurrentEpochDays RPC method.
+type QueryCurrentEpochDaysRequest struct {
 }
 
-func (m *QueryCurrentEpochDaysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCurrentEpochDaysRequest) Reset()         { *m = QueryCurrentEpochDaysRequest{} }
+func (m *QueryCurrentEpochDaysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentEpochDaysRequest) ProtoMessage()    {}
+func (*QueryCurrentEpochDaysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{32}
 }
-
-func (m *QueryCurrentEpochDaysResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCurrentEpochDaysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentEpochDaysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentEpochDaysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryCurrentEpochDaysResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCurrentEpochDaysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentEpochDaysRequest.Merge(m, src)
+}
+func (m *QueryCurrentEpochDaysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentEpochDaysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentEpochDaysRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCurrentEpochDaysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CurrentEpochDays != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpochDays))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryCurrentEpochDaysRequest proto.InternalMessageInfo
+
+// QuerCurrentEpochDaysResponse is the response type for the Query/CurrentEpochDays RPC method.
+type QueryCurrentEpochDaysResponse struct {
+	// current_epoch_days is the current epoch duration in whole days, kept for backward compatibility;
+	// it is zero if the current epoch is shorter than a day
+	CurrentEpochDays uint32 `protobuf:"varint,1,opt,name=current_epoch_days,json=currentEpochDays,proto3" json:"current_epoch_days,omitempty"`
+	// current_epoch_duration is the current epoch duration
+	CurrentEpochDuration time.Duration `protobuf:"bytes,2,opt,name=current_epoch_duration,json=currentEpochDuration,proto3,stdduration" json:"current_epoch_duration"`
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryCurrentEpochDaysResponse) Reset()         { *m = QueryCurrentEpochDaysResponse{} }
+func (m *QueryCurrentEpochDaysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentEpochDaysResponse) ProtoMessage()    {}
+func (*QueryCurrentEpochDaysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{33}
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryCurrentEpochDaysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCurrentEpochDaysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentEpochDaysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
 }
-
-func (m *QueryPlansRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.FarmingPoolAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TerminationAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Terminated)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryCurrentEpochDaysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentEpochDaysResponse.Merge(m, src)
+}
+func (m *QueryCurrentEpochDaysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentEpochDaysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentEpochDaysResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPlansResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Plans) > 0 {
-		for _, e := range m.Plans {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryCurrentEpochDaysResponse proto.InternalMessageInfo
+
+func (m *QueryCurrentEpochDaysResponse) GetCurrentEpochDays() uint32 {
+	if m != nil {
+		return m.CurrentEpochDays
 	}
-	return n
+	return 0
 }
 
-func (m *QueryPlanRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PlanId != 0 {
-		n += 1 + sovQuery(uint64(m.PlanId))
+func (m *QueryCurrentEpochDaysResponse) GetCurrentEpochDuration() time.Duration {
+	if m != nil {
+		return m.CurrentEpochDuration
 	}
-	return n
+	return 0
 }
 
-func (m *QueryPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Plan != nil {
-		l = m.Plan.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.farming.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.farming.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryPlansRequest)(nil), "cosmos.farming.v1beta1.QueryPlansRequest")
+	proto.RegisterType((*QueryPlansResponse)(nil), "cosmos.farming.v1beta1.QueryPlansResponse")
+	proto.RegisterType((*QueryPlanRequest)(nil), "cosmos.farming.v1beta1.QueryPlanRequest")
+	proto.RegisterType((*QueryPlanResponse)(nil), "cosmos.farming.v1beta1.QueryPlanResponse")
+	proto.RegisterType((*QueryPlanFarmersRequest)(nil), "cosmos.farming.v1beta1.QueryPlanFarmersRequest")
+	proto.RegisterType((*QueryPlanFarmersResponse)(nil), "cosmos.farming.v1beta1.QueryPlanFarmersResponse")
+	proto.RegisterType((*QueryStakingsRequest)(nil), "cosmos.farming.v1beta1.QueryStakingsRequest")
+	proto.RegisterType((*QueryStakingsResponse)(nil), "cosmos.farming.v1beta1.QueryStakingsResponse")
+	proto.RegisterType((*QueryTotalStakingsRequest)(nil), "cosmos.farming.v1beta1.QueryTotalStakingsRequest")
+	proto.RegisterType((*QueryTotalStakingsResponse)(nil), "cosmos.farming.v1beta1.QueryTotalStakingsResponse")
+	proto.RegisterType((*QueryRewardsRequest)(nil), "cosmos.farming.v1beta1.QueryRewardsRequest")
+	proto.RegisterType((*QueryRewardsResponse)(nil), "cosmos.farming.v1beta1.QueryRewardsResponse")
+	proto.RegisterType((*PlanRewards)(nil), "cosmos.farming.v1beta1.PlanRewards")
+	proto.RegisterType((*QueryPlanRewardsRequest)(nil), "cosmos.farming.v1beta1.QueryPlanRewardsRequest")
+	proto.RegisterType((*QueryPlanRewardsResponse)(nil), "cosmos.farming.v1beta1.QueryPlanRewardsResponse")
+	proto.RegisterType((*QueryAprRequest)(nil), "cosmos.farming.v1beta1.QueryAprRequest")
+	proto.RegisterType((*QueryAprResponse)(nil), "cosmos.farming.v1beta1.QueryAprResponse")
+	proto.RegisterType((*QueryVestingRewardsRequest)(nil), "cosmos.farming.v1beta1.QueryVestingRewardsRequest")
+	proto.RegisterType((*QueryVestingRewardsResponse)(nil), "cosmos.farming.v1beta1.QueryVestingRewardsResponse")
+	proto.RegisterType((*QueryUnbondingStakingsRequest)(nil), "cosmos.farming.v1beta1.QueryUnbondingStakingsRequest")
+	proto.RegisterType((*QueryUnbondingStakingsResponse)(nil), "cosmos.farming.v1beta1.QueryUnbondingStakingsResponse")
+	proto.RegisterType((*QueryRewardWithdrawAddressRequest)(nil), "cosmos.farming.v1beta1.QueryRewardWithdrawAddressRequest")
+	proto.RegisterType((*QueryRewardWithdrawAddressResponse)(nil), "cosmos.farming.v1beta1.QueryRewardWithdrawAddressResponse")
+	proto.RegisterType((*QueryAutoCompoundFarmersRequest)(nil), "cosmos.farming.v1beta1.QueryAutoCompoundFarmersRequest")
+	proto.RegisterType((*QueryAutoCompoundFarmersResponse)(nil), "cosmos.farming.v1beta1.QueryAutoCompoundFarmersResponse")
+	proto.RegisterType((*QueryRewardsDustRequest)(nil), "cosmos.farming.v1beta1.QueryRewardsDustRequest")
+	proto.RegisterType((*QueryRewardsDustResponse)(nil), "cosmos.farming.v1beta1.QueryRewardsDustResponse")
+	proto.RegisterType((*QueryEpochInfoRequest)(nil), "cosmos.farming.v1beta1.QueryEpochInfoRequest")
+	proto.RegisterType((*QueryEpochInfoResponse)(nil), "cosmos.farming.v1beta1.QueryEpochInfoResponse")
+	proto.RegisterType((*CurrentEpoch)(nil), "cosmos.farming.v1beta1.CurrentEpoch")
+	proto.RegisterType((*QueryCurrentEpochDaysRequest)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochDaysRequest")
+	proto.RegisterType((*QueryCurrentEpochDaysResponse)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochDaysResponse")
 }
 
-func (m *QueryStakingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func init() {
+	proto.RegisterFile("tendermint/farming/v1beta1/query.proto", fileDescriptor_00c8db58c274b111)
+}
+
+var fileDescriptor_00c8db58c274b111 = []byte{
+	// 1892 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcb, 0x6f, 0xdb, 0xc8,
+	0x19, 0x37, 0x25, 0xd9, 0x5e, 0x7f, 0xb6, 0x6c, 0x65, 0xec, 0xd8, 0x32, 0x9b, 0xc8, 0x2e, 0x37,
+	0xeb, 0xf8, 0x29, 0xfa, 0xb1, 0x6e, 0xd2, 0xa4, 0x05, 0xea, 0xc7, 0x7a, 0xd7, 0x40, 0x8b, 0xee,
+	0x6a, 0x93, 0x16, 0x7d, 0x00, 0x04, 0x2d, 0xd2, 0x0a, 0xb1, 0x12, 0xc9, 0xf0, 0x11, 0xc7, 0x35,
+	0x8c, 0x62, 0x0b, 0xec, 0xa1, 0x40, 0x0b, 0xa4, 0x0f, 0x14, 0x45, 0x0f, 0x45, 0x81, 0x5e, 0xda,
+	0x1e, 0xbb, 0xbd, 0xf5, 0xd0, 0xeb, 0xa2, 0xbd, 0x04, 0xe8, 0xa5, 0xe8, 0x61, 0x13, 0x24, 0xfd,
+	0x1f, 0x7a, 0x5d, 0x70, 0xe6, 0x1b, 0x89, 0xa4, 0x44, 0x89, 0x36, 0xa2, 0x9c, 0x2c, 0xce, 0x7c,
+	0xdf, 0xf7, 0xfb, 0xcd, 0xf7, 0x98, 0x99, 0x6f, 0x0c, 0x0b, 0x9e, 0x6e, 0x6a, 0xba, 0xd3, 0x30,
+	0x4c, 0x4f, 0x3e, 0x56, 0x83, 0xbf, 0x35, 0xf9, 0xd1, 0xc6, 0x91, 0xee, 0xa9, 0x1b, 0xf2, 0x43,
+	0x5f, 0x77, 0x4e, 0xcb, 0xb6, 0x63, 0x79, 0x16, 0x99, 0xae, 0x5a, 0x6e, 0xc3, 0x72, 0xcb, 0x28,
+	0x53, 0x46, 0x19, 0x71, 0xb1, 0x8b, 0x3e, 0x97, 0xa5, 0x16, 0xc4, 0x65, 0x66, 0x41, 0x3e, 0x52,
+	0x5d, 0x9d, 0x99, 0x6e, 0x0a, 0xda, 0x6a, 0xcd, 0x30, 0x55, 0xcf, 0xb0, 0x4c, 0x94, 0x9d, 0xaa,
+	0x59, 0x35, 0x8b, 0xfe, 0x94, 0x83, 0x5f, 0x38, 0x3a, 0x5b, 0xb3, 0xac, 0x5a, 0x5d, 0x97, 0xe9,
+	0xd7, 0x91, 0x7f, 0x2c, 0xab, 0x26, 0xd2, 0x13, 0xaf, 0xe1, 0x94, 0x6a, 0x1b, 0xb2, 0x6a, 0x9a,
+	0x96, 0x47, 0xad, 0xb9, 0x38, 0x5b, 0x8a, 0x2b, 0x6a, 0xbe, 0x13, 0x86, 0x9b, 0x8b, 0xcf, 0x7b,
+	0x46, 0x43, 0x77, 0x3d, 0xb5, 0x61, 0x73, 0x64, 0xc6, 0x5d, 0x61, 0x94, 0xd8, 0x07, 0xb7, 0x1d,
+	0x5e, 0x16, 0x5f, 0x50, 0xd5, 0x32, 0xd0, 0xb6, 0x34, 0x05, 0xe4, 0x83, 0x60, 0xb1, 0xef, 0xab,
+	0x8e, 0xda, 0x70, 0x2b, 0xfa, 0x43, 0x5f, 0x77, 0x3d, 0xe9, 0x43, 0x98, 0x8c, 0x8c, 0xba, 0xb6,
+	0x65, 0xba, 0x3a, 0xf9, 0x1a, 0x0c, 0xd9, 0x74, 0xa4, 0x28, 0xcc, 0x0b, 0x8b, 0xa3, 0x9b, 0xa5,
+	0x72, 0x67, 0xb7, 0x97, 0x99, 0xde, 0x6e, 0xee, 0xb3, 0xcf, 0xe7, 0x06, 0x2a, 0xa8, 0x23, 0xfd,
+	0x21, 0x03, 0x57, 0x98, 0xd5, 0xba, 0x6a, 0x72, 0x28, 0x42, 0x20, 0xe7, 0x9d, 0xda, 0x3a, 0xb5,
+	0x38, 0x52, 0xa1, 0xbf, 0xc9, 0x3a, 0x4c, 0xa1, 0x45, 0xc5, 0xb6, 0xac, 0xba, 0xa2, 0x6a, 0x9a,
+	0xa3, 0xbb, 0x6e, 0x31, 0x43, 0x65, 0x08, 0xce, 0xbd, 0x6f, 0x59, 0xf5, 0x1d, 0x36, 0x43, 0x64,
+	0x98, 0xf4, 0x68, 0x98, 0xa9, 0xdf, 0x9a, 0x0a, 0x59, 0xa6, 0x10, 0x9a, 0xe2, 0x0a, 0xab, 0x40,
+	0x5c, 0x4f, 0xfd, 0x28, 0x80, 0x08, 0xbc, 0xa1, 0x68, 0xba, 0x69, 0x35, 0x8a, 0x39, 0x2a, 0x5f,
+	0xc0, 0x99, 0x3d, 0xcb, 0x30, 0xf7, 0x83, 0x71, 0x52, 0x02, 0xe0, 0x36, 0x74, 0xad, 0x38, 0x48,
+	0xa5, 0x42, 0x23, 0xe4, 0x00, 0xa0, 0x95, 0x24, 0xc5, 0x21, 0xea, 0x9c, 0x05, 0xee, 0x9c, 0xc0,
+	0xf5, 0x65, 0x96, 0xac, 0x2d, 0xff, 0xd4, 0x74, 0x74, 0x40, 0x25, 0xa4, 0x29, 0xfd, 0x5a, 0x00,
+	0x12, 0x76, 0x11, 0xfa, 0x7d, 0x1b, 0x06, 0xed, 0x60, 0xa0, 0x28, 0xcc, 0x67, 0x17, 0x47, 0x37,
+	0xa7, 0xca, 0x2c, 0x21, 0xca, 0x3c, 0x21, 0xca, 0x3b, 0xe6, 0xe9, 0xee, 0xc8, 0x3f, 0xff, 0xb6,
+	0x36, 0x18, 0xe8, 0x1d, 0x56, 0x98, 0x34, 0x79, 0x37, 0xc2, 0x2a, 0x43, 0x59, 0xdd, 0xec, 0xc9,
+	0x8a, 0x61, 0x46, 0x68, 0xad, 0x40, 0xa1, 0xc9, 0x8a, 0xc7, 0x6d, 0x06, 0x86, 0x03, 0x14, 0xc5,
+	0xd0, 0x68, 0xe8, 0x72, 0x95, 0xa1, 0xe0, 0xf3, 0x50, 0x93, 0xde, 0x0b, 0x45, 0xb9, 0xb9, 0x82,
+	0x2d, 0xc8, 0x05, 0xd3, 0x98, 0x37, 0x3d, 0x17, 0x40, 0x85, 0xa5, 0x1f, 0xc1, 0x4c, 0xd3, 0xd2,
+	0x81, 0xea, 0x34, 0x74, 0xc7, 0xed, 0x85, 0x4e, 0x0e, 0x3a, 0xac, 0xf9, 0x32, 0x91, 0x38, 0x87,
+	0x62, 0x3b, 0x36, 0x2e, 0xa6, 0x08, 0xc3, 0xc7, 0x6c, 0x88, 0x06, 0x64, 0xa4, 0xc2, 0x3f, 0x5f,
+	0x9d, 0xc7, 0x7f, 0x08, 0x53, 0x14, 0xfe, 0x43, 0x96, 0x89, 0xcd, 0x75, 0x4f, 0xc3, 0x10, 0xc3,
+	0xc2, 0x7a, 0xc1, 0xaf, 0x84, 0x74, 0xce, 0x74, 0x4e, 0x67, 0xe9, 0xff, 0x02, 0x5c, 0x8d, 0x99,
+	0xc7, 0xa5, 0x99, 0x30, 0x16, 0x48, 0xeb, 0x1a, 0x35, 0xc3, 0x13, 0x6e, 0x36, 0xb2, 0x04, 0x4e,
+	0x3e, 0xb0, 0xb7, 0xbb, 0x1e, 0x94, 0xf8, 0x5f, 0x9e, 0xcd, 0x2d, 0xd6, 0x0c, 0xef, 0x81, 0x7f,
+	0x54, 0xae, 0x5a, 0x0d, 0xdc, 0x80, 0xf0, 0xcf, 0x9a, 0xab, 0x7d, 0x24, 0x07, 0x55, 0xed, 0x52,
+	0x05, 0xb7, 0x32, 0xca, 0x00, 0xe8, 0x47, 0x80, 0xf7, 0xd0, 0xd7, 0xfd, 0x26, 0x5e, 0xa6, 0x0f,
+	0x78, 0x0c, 0x80, 0x7e, 0x48, 0x87, 0x30, 0x4b, 0x17, 0x7e, 0xcf, 0xf2, 0xd4, 0x7a, 0xdc, 0xb9,
+	0x9d, 0x9d, 0x28, 0x24, 0x38, 0x51, 0x03, 0xb1, 0x93, 0x29, 0x74, 0xe4, 0x01, 0x0c, 0xa9, 0x0d,
+	0xcb, 0x37, 0x3d, 0xa6, 0xbf, 0x5b, 0x0e, 0x78, 0xff, 0xf7, 0xf3, 0xb9, 0x85, 0x14, 0xbc, 0x0f,
+	0x4d, 0xaf, 0x82, 0xda, 0xd2, 0x0f, 0x70, 0x27, 0xae, 0xe8, 0x27, 0xaa, 0xa3, 0xbd, 0xe2, 0x3c,
+	0xf8, 0x97, 0x00, 0x53, 0x51, 0xeb, 0xc8, 0x5e, 0x87, 0x61, 0x87, 0x0d, 0xf5, 0x23, 0x03, 0xb8,
+	0x6d, 0xf2, 0x4d, 0x18, 0xa3, 0x55, 0xcc, 0xb1, 0x58, 0xf4, 0xdf, 0x4c, 0x3c, 0x55, 0xe8, 0x8e,
+	0x42, 0x45, 0xf1, 0x68, 0x19, 0xb5, 0x5b, 0x43, 0xd2, 0xcf, 0x05, 0x18, 0x0d, 0x89, 0x24, 0xef,
+	0x11, 0xa1, 0xd5, 0x65, 0xfa, 0xb7, 0x3a, 0xe9, 0x71, 0x68, 0xfb, 0x4a, 0x19, 0xbe, 0x10, 0xe5,
+	0x4c, 0x84, 0x72, 0xe7, 0xb8, 0x66, 0x13, 0xe2, 0xfa, 0xb1, 0x00, 0xc5, 0x76, 0xe8, 0xd7, 0x1a,
+	0x5b, 0xe9, 0x0c, 0x26, 0x28, 0x85, 0x1d, 0xdb, 0xb9, 0x54, 0x7d, 0x91, 0x3b, 0x30, 0xab, 0x6a,
+	0x9a, 0x11, 0x6c, 0x87, 0x6a, 0x5d, 0xe1, 0x8a, 0x58, 0x54, 0x2c, 0xa3, 0x67, 0x5a, 0x02, 0x58,
+	0x80, 0x3b, 0xac, 0x6a, 0x9e, 0x67, 0xa1, 0xd0, 0x42, 0xc7, 0x85, 0xdf, 0x87, 0x71, 0xcf, 0xf2,
+	0x5a, 0xb6, 0xdc, 0x4b, 0x96, 0x66, 0xde, 0x0b, 0x57, 0x3c, 0xb1, 0x21, 0xaf, 0xdb, 0x56, 0xf5,
+	0x81, 0xd2, 0xc7, 0x9c, 0x1a, 0xa3, 0x08, 0x3c, 0xb1, 0x7f, 0x0c, 0x84, 0x21, 0xfa, 0xa6, 0xe1,
+	0x35, 0x61, 0xb3, 0x14, 0xf6, 0x5a, 0x47, 0xd8, 0x7d, 0xbd, 0x4a, 0x91, 0xb7, 0x10, 0x79, 0x25,
+	0x05, 0x32, 0xea, 0xb8, 0x95, 0x02, 0x05, 0xbb, 0x6f, 0x1a, 0x1e, 0x27, 0xf0, 0xb1, 0x00, 0x93,
+	0xaa, 0x69, 0xfa, 0x6a, 0x3d, 0x4a, 0x21, 0xd7, 0x2f, 0x0a, 0x57, 0x18, 0x5a, 0x88, 0x83, 0xf4,
+	0x36, 0x6e, 0xbf, 0xdf, 0xd1, 0x5d, 0xcf, 0x30, 0x6b, 0xe9, 0x0a, 0x4c, 0x7a, 0x96, 0x81, 0x2f,
+	0x75, 0x54, 0xc3, 0x1c, 0x71, 0x60, 0xbc, 0x6e, 0x55, 0x83, 0xf3, 0xaf, 0x8f, 0x35, 0x92, 0x67,
+	0x10, 0xdc, 0x9b, 0x8f, 0xa0, 0xe0, 0x9b, 0x31, 0xd4, 0x3e, 0xe4, 0xd0, 0x84, 0x6f, 0x46, 0x71,
+	0xef, 0xc1, 0x04, 0x83, 0x53, 0x1e, 0x31, 0x67, 0xf0, 0x1c, 0x7a, 0x2b, 0x69, 0x03, 0x66, 0x9a,
+	0xe8, 0x3a, 0xdc, 0x82, 0xc7, 0x9d, 0xf0, 0xa0, 0x2b, 0xdd, 0x82, 0xeb, 0xd4, 0xc1, 0xf7, 0xcd,
+	0x23, 0xcb, 0xd4, 0x0c, 0xb3, 0x96, 0xf2, 0x0a, 0x23, 0x59, 0x50, 0x4a, 0x52, 0xc4, 0xe0, 0x7c,
+	0x0b, 0x86, 0x75, 0xd3, 0x73, 0x0c, 0x9d, 0x47, 0x65, 0x2d, 0x89, 0x68, 0xdc, 0xc6, 0x3b, 0xa6,
+	0xe7, 0x9c, 0x22, 0x61, 0x6e, 0x43, 0xba, 0x0b, 0x5f, 0x0e, 0x1d, 0x7e, 0xdf, 0x35, 0xbc, 0x07,
+	0x9a, 0xa3, 0x9e, 0x60, 0x83, 0xd0, 0x8b, 0xed, 0xb7, 0x41, 0xea, 0xa6, 0x8c, 0x8c, 0x97, 0xa0,
+	0x70, 0x82, 0x53, 0xcd, 0x9e, 0x84, 0xd9, 0x99, 0x38, 0x89, 0xaa, 0x48, 0x06, 0xcc, 0xb1, 0x1d,
+	0xcb, 0xf7, 0xac, 0x3d, 0xab, 0x61, 0x5b, 0xbe, 0xa9, 0xc5, 0x2e, 0xbd, 0xd1, 0xbb, 0xad, 0x70,
+	0xe9, 0xbb, 0xed, 0x27, 0x02, 0xcc, 0x27, 0x63, 0xbd, 0xbe, 0x4b, 0xee, 0x2c, 0xcc, 0x84, 0x7c,
+	0xe8, 0xee, 0xfb, 0xae, 0xc7, 0x1b, 0xd0, 0x27, 0xfc, 0x04, 0x8b, 0xcc, 0x21, 0x35, 0x0f, 0xc6,
+	0xb0, 0x4e, 0x14, 0xcd, 0x77, 0xbd, 0xa2, 0xd0, 0xaf, 0x6d, 0x67, 0xd4, 0x69, 0xa1, 0x4b, 0x0a,
+	0xde, 0x99, 0xdf, 0x09, 0x76, 0xc3, 0x43, 0xf3, 0xd8, 0x7a, 0xd5, 0x61, 0xf9, 0x63, 0x16, 0xa6,
+	0xe3, 0x08, 0xb8, 0xe2, 0xf7, 0x60, 0xa2, 0xae, 0xba, 0x9e, 0xc2, 0xb6, 0xfd, 0xa0, 0xfd, 0x47,
+	0x1c, 0xb1, 0xad, 0x93, 0xba, 0xc7, 0xdf, 0x06, 0x76, 0x73, 0x4f, 0x9e, 0xcd, 0x09, 0x95, 0x7c,
+	0xa0, 0x48, 0x2d, 0x06, 0x33, 0xe4, 0x7b, 0x30, 0x5d, 0xf5, 0x1d, 0x47, 0x37, 0xb9, 0x31, 0xfe,
+	0xd6, 0x80, 0x81, 0x9c, 0x6d, 0x33, 0xb8, 0x8f, 0x02, 0xbb, 0x6f, 0x04, 0x2e, 0xfc, 0x6d, 0x60,
+	0x73, 0x0a, 0x4d, 0x50, 0xb3, 0x7c, 0x3e, 0x20, 0x69, 0xea, 0x8f, 0x23, 0x24, 0xb3, 0x69, 0x49,
+	0x06, 0x8a, 0x2d, 0x92, 0x1f, 0xc0, 0x78, 0x84, 0x24, 0x3f, 0x59, 0x6e, 0x24, 0xd5, 0xfb, 0x5e,
+	0x88, 0x0f, 0x96, 0x79, 0x3e, 0xcc, 0x31, 0x9e, 0xb4, 0x83, 0x97, 0x4f, 0x5a, 0x15, 0xc6, 0xc2,
+	0x68, 0x17, 0xbc, 0xd4, 0xbc, 0x09, 0xf9, 0xc8, 0xca, 0xf0, 0x9a, 0x37, 0x16, 0x26, 0x2b, 0x95,
+	0xe0, 0x1a, 0xcd, 0x83, 0x30, 0xce, 0xbe, 0x7a, 0xda, 0x7c, 0x9d, 0xf9, 0x93, 0x00, 0xd7, 0x13,
+	0x04, 0x30, 0x5f, 0x56, 0x81, 0xc4, 0xa2, 0xac, 0x9e, 0xb2, 0x9d, 0x27, 0x5f, 0x29, 0x54, 0x63,
+	0x5a, 0x7d, 0xcc, 0x89, 0xcd, 0x5f, 0x5c, 0x85, 0x41, 0x4a, 0x95, 0xfc, 0x54, 0x80, 0x21, 0xf6,
+	0x2c, 0x44, 0x96, 0x93, 0xc2, 0xd8, 0xfe, 0x12, 0x25, 0xae, 0xa4, 0x92, 0x65, 0xcb, 0x96, 0x16,
+	0x7e, 0xf2, 0xef, 0xff, 0xfd, 0x2a, 0x33, 0x4f, 0x4a, 0xbc, 0xb4, 0xe3, 0x4f, 0x7e, 0xec, 0x25,
+	0x8a, 0x7c, 0x22, 0x00, 0x7d, 0x68, 0x70, 0xc9, 0x52, 0x77, 0xf3, 0xa1, 0x87, 0x2a, 0x71, 0x39,
+	0x8d, 0x28, 0x12, 0x79, 0x8b, 0x12, 0x99, 0x23, 0xd7, 0x13, 0x89, 0x50, 0xf4, 0x9f, 0x09, 0x90,
+	0x0b, 0x14, 0xc9, 0x62, 0x4f, 0xdb, 0x9c, 0xc5, 0x52, 0x0a, 0x49, 0x24, 0x21, 0x53, 0x12, 0x4b,
+	0xe4, 0x66, 0x57, 0x12, 0xf2, 0x19, 0x76, 0x1c, 0xe7, 0xe4, 0xcf, 0xd8, 0x40, 0xe1, 0x51, 0x40,
+	0xe4, 0x9e, 0x58, 0xd1, 0x03, 0x4a, 0x5c, 0x4f, 0xaf, 0x80, 0x1c, 0x6f, 0x51, 0x8e, 0x1b, 0x44,
+	0x4e, 0xc9, 0x51, 0xe6, 0x87, 0xd0, 0xef, 0x04, 0x78, 0xa3, 0x79, 0x05, 0x5f, 0xed, 0x8a, 0x1b,
+	0xbb, 0x80, 0x88, 0x6b, 0x29, 0xa5, 0x91, 0xe2, 0x06, 0xa5, 0xb8, 0x42, 0x96, 0x92, 0x28, 0xf2,
+	0x76, 0x42, 0x3e, 0x63, 0xe4, 0xce, 0xc9, 0xdf, 0x05, 0xc8, 0x47, 0x9e, 0x05, 0xc8, 0x46, 0x57,
+	0xcc, 0x4e, 0xaf, 0x11, 0xe2, 0xe6, 0x45, 0x54, 0x90, 0xeb, 0x1e, 0xe5, 0xfa, 0x75, 0x72, 0x37,
+	0x89, 0x6b, 0xb4, 0x01, 0x92, 0xcf, 0xda, 0xb7, 0xae, 0x73, 0xf2, 0x1b, 0x01, 0x86, 0xf9, 0x1d,
+	0xb1, 0x7b, 0xf9, 0x45, 0x2f, 0xdd, 0xe2, 0x6a, 0x3a, 0x61, 0xe4, 0xba, 0x4e, 0xb9, 0x2e, 0x93,
+	0xc5, 0x24, 0xae, 0x78, 0xf8, 0xb6, 0xdc, 0xfa, 0xd7, 0x58, 0x83, 0x2f, 0xa7, 0xa8, 0x85, 0x08,
+	0xc1, 0xf5, 0xf4, 0x0a, 0x48, 0xf2, 0x1b, 0x94, 0xe4, 0x1d, 0x72, 0x3b, 0x2d, 0xc9, 0xb6, 0xa2,
+	0xfa, 0xa5, 0x00, 0xd9, 0x1d, 0xdb, 0x21, 0x37, 0xbb, 0x62, 0xb7, 0xba, 0x64, 0x71, 0xb1, 0xb7,
+	0x20, 0x92, 0xbb, 0x4d, 0xc9, 0x6d, 0x92, 0xf5, 0x24, 0x72, 0xaa, 0xed, 0x74, 0x0e, 0xf1, 0xa7,
+	0x02, 0x8c, 0x47, 0x3b, 0x20, 0xd2, 0x3d, 0xdd, 0x3a, 0x76, 0x59, 0xe2, 0xd6, 0x85, 0x74, 0xd2,
+	0xb2, 0xc6, 0x6e, 0x44, 0x69, 0x8b, 0xff, 0x3f, 0x04, 0xb8, 0xd2, 0xd6, 0x1d, 0x90, 0xed, 0xae,
+	0x24, 0x92, 0xda, 0x10, 0xf1, 0x2b, 0x17, 0x55, 0x43, 0xfa, 0x77, 0x29, 0xfd, 0x6d, 0xb2, 0x95,
+	0x44, 0xdf, 0xe7, 0xaa, 0x4a, 0xfb, 0xc6, 0xf0, 0x54, 0x80, 0xab, 0x1d, 0x3b, 0x06, 0xf2, 0xd5,
+	0x14, 0xb5, 0xd3, 0xb9, 0x45, 0x11, 0xef, 0x5c, 0x46, 0xf5, 0x62, 0xf9, 0xad, 0xc4, 0xbb, 0x98,
+	0x48, 0x50, 0x26, 0x3b, 0xf4, 0x11, 0xe4, 0x56, 0xf7, 0x34, 0x4e, 0xec, 0x72, 0xc4, 0xdb, 0x17,
+	0x57, 0xc4, 0xc5, 0x6c, 0xd3, 0xc5, 0xc8, 0x64, 0x2d, 0xb1, 0x1e, 0x7c, 0xcf, 0x52, 0xaa, 0xa8,
+	0xad, 0xf0, 0xa3, 0xe4, 0xf7, 0x02, 0x8c, 0x86, 0xda, 0x8c, 0x1e, 0xdb, 0x4a, 0x7b, 0xb3, 0x22,
+	0xae, 0xa7, 0x57, 0x40, 0xa6, 0xab, 0x94, 0xe9, 0x02, 0xb9, 0xd1, 0x63, 0x5b, 0xa1, 0xfd, 0x4d,
+	0xb0, 0x21, 0x8f, 0x34, 0x7b, 0x02, 0xd2, 0xfd, 0xf8, 0x8a, 0x77, 0x27, 0x62, 0x39, 0xad, 0x38,
+	0x52, 0x5b, 0xa6, 0xd4, 0x6e, 0x10, 0x29, 0x89, 0x1a, 0xbb, 0x22, 0x1a, 0x01, 0x95, 0x4f, 0x05,
+	0x28, 0xc4, 0xef, 0xa0, 0xe4, 0xed, 0xae, 0x80, 0x09, 0x77, 0x5a, 0x71, 0xfb, 0x82, 0x5a, 0xc8,
+	0x76, 0x93, 0xb2, 0x5d, 0x25, 0xcb, 0x49, 0x6c, 0xdb, 0xaf, 0xc1, 0xbb, 0xef, 0x7e, 0xf6, 0xa2,
+	0x24, 0x3c, 0x7d, 0x51, 0x12, 0x9e, 0xbf, 0x28, 0x09, 0x4f, 0x5e, 0x96, 0x06, 0x9e, 0xbe, 0x2c,
+	0x0d, 0xfc, 0xe7, 0x65, 0x69, 0xe0, 0xfb, 0x6b, 0xa1, 0xe6, 0xb0, 0xc3, 0x3f, 0x8e, 0x1f, 0x37,
+	0x7f, 0xd1, 0x3e, 0xf1, 0x68, 0x88, 0xde, 0x87, 0xb7, 0xbe, 0x18, 0x00, 0xe3, 0x4c, 0xe7, 0x67,
+	0xa5, 0x1e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns parameters of the farming module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Plans returns all plans.
+	Plans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error)
+	// Plan returns a specific plan.
+	Plan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error)
+	// PlanFarmers returns the farmers in the allowlist of a restricted plan.
+	PlanFarmers(ctx context.Context, in *QueryPlanFarmersRequest, opts ...grpc.CallOption) (*QueryPlanFarmersResponse, error)
+	Stakings(ctx context.Context, in *QueryStakingsRequest, opts ...grpc.CallOption) (*QueryStakingsResponse, error)
+	TotalStakings(ctx context.Context, in *QueryTotalStakingsRequest, opts ...grpc.CallOption) (*QueryTotalStakingsResponse, error)
+	Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error)
+	// PlanRewards returns the rewards of a farmer allocated by a plan.
+	PlanRewards(ctx context.Context, in *QueryPlanRewardsRequest, opts ...grpc.CallOption) (*QueryPlanRewardsResponse, error)
+	// Apr returns the projected rewards per staked unit of a staking coin denom.
+	Apr(ctx context.Context, in *QueryAprRequest, opts ...grpc.CallOption) (*QueryAprResponse, error)
+	// VestingRewards returns locked and unlocked vesting rewards of a farmer.
+	VestingRewards(ctx context.Context, in *QueryVestingRewardsRequest, opts ...grpc.CallOption) (*QueryVestingRewardsResponse, error)
+	// UnbondingStakings returns the unbonding entries of the farmer's unstaked coins.
+	UnbondingStakings(ctx context.Context, in *QueryUnbondingStakingsRequest, opts ...grpc.CallOption) (*QueryUnbondingStakingsResponse, error)
+	// RewardWithdrawAddress returns the address that the rewards of a farmer are sent to.
+	RewardWithdrawAddress(ctx context.Context, in *QueryRewardWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryRewardWithdrawAddressResponse, error)
+	// AutoCompoundFarmers returns the farmers who have turned on auto-compounding of their rewards.
+	AutoCompoundFarmers(ctx context.Context, in *QueryAutoCompoundFarmersRequest, opts ...grpc.CallOption) (*QueryAutoCompoundFarmersResponse, error)
+	// RewardsDust returns the fractional rewards left over from truncation which are not swept yet.
+	RewardsDust(ctx context.Context, in *QueryRewardsDustRequest, opts ...grpc.CallOption) (*QueryRewardsDustResponse, error)
+	// EpochInfo returns the last epoch time, the current epoch duration, the next epoch time
+	// and the current epoch of each staking coin denom.
+	EpochInfo(ctx context.Context, in *QueryEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoResponse, error)
+	// CurrentEpochDays returns current epoch days and duration.
+	CurrentEpochDays(ctx context.Context, in *QueryCurrentEpochDaysRequest, opts ...grpc.CallOption) (*QueryCurrentEpochDaysResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Plans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error) {
+	out := new(QueryPlansResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Plans", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryStakingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Plan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error) {
+	out := new(QueryPlanResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Plan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.StakedCoins) > 0 {
-		for _, e := range m.StakedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) PlanFarmers(ctx context.Context, in *QueryPlanFarmersRequest, opts ...grpc.CallOption) (*QueryPlanFarmersResponse, error) {
+	out := new(QueryPlanFarmersResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/PlanFarmers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.QueuedCoins) > 0 {
-		for _, e := range m.QueuedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) Stakings(ctx context.Context, in *QueryStakingsRequest, opts ...grpc.CallOption) (*QueryStakingsResponse, error) {
+	out := new(QueryStakingsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Stakings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryTotalStakingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) TotalStakings(ctx context.Context, in *QueryTotalStakingsRequest, opts ...grpc.CallOption) (*QueryTotalStakingsResponse, error) {
+	out := new(QueryTotalStakingsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/TotalStakings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error) {
+	out := new(QueryRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Rewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryTotalStakingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PlanRewards(ctx context.Context, in *QueryPlanRewardsRequest, opts ...grpc.CallOption) (*QueryPlanRewardsResponse, error) {
+	out := new(QueryPlanRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/PlanRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Apr(ctx context.Context, in *QueryAprRequest, opts ...grpc.CallOption) (*QueryAprResponse, error) {
+	out := new(QueryAprResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Apr", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) VestingRewards(ctx context.Context, in *QueryVestingRewardsRequest, opts ...grpc.CallOption) (*QueryVestingRewardsResponse, error) {
+	out := new(QueryVestingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/VestingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) UnbondingStakings(ctx context.Context, in *QueryUnbondingStakingsRequest, opts ...grpc.CallOption) (*QueryUnbondingStakingsResponse, error) {
+	out := new(QueryUnbondingStakingsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/UnbondingStakings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) RewardWithdrawAddress(ctx context.Context, in *QueryRewardWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryRewardWithdrawAddressResponse, error) {
+	out := new(QueryRewardWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/RewardWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) AutoCompoundFarmers(ctx context.Context, in *QueryAutoCompoundFarmersRequest, opts ...grpc.CallOption) (*QueryAutoCompoundFarmersResponse, error) {
+	out := new(QueryAutoCompoundFarmersResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/AutoCompoundFarmers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryCurrentEpochDaysRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) RewardsDust(ctx context.Context, in *QueryRewardsDustRequest, opts ...grpc.CallOption) (*QueryRewardsDustResponse, error) {
+	out := new(QueryRewardsDustResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/RewardsDust", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryCurrentEpochDaysResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) EpochInfo(ctx context.Context, in *QueryEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoResponse, error) {
+	out := new(QueryEpochInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/EpochInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.CurrentEpochDays != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentEpochDays))
+	return out, nil
+}
+
+func (c *queryClient) CurrentEpochDays(ctx context.Context, in *QueryCurrentEpochDaysRequest, opts ...grpc.CallOption) (*QueryCurrentEpochDaysResponse, error) {
+	out := new(QueryCurrentEpochDaysResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/CurrentEpochDays", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns parameters of the farming module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Plans returns all plans.
+	Plans(context.Context, *QueryPlansRequest) (*QueryPlansResponse, error)
+	// Plan returns a specific plan.
+	Plan(context.Context, *QueryPlanRequest) (*QueryPlanResponse, error)
+	// PlanFarmers returns the farmers in the allowlist of a restricted plan.
+	PlanFarmers(context.Context, *QueryPlanFarmersRequest) (*QueryPlanFarmersResponse, error)
+	Stakings(context.Context, *QueryStakingsRequest) (*QueryStakingsResponse, error)
+	TotalStakings(context.Context, *QueryTotalStakingsRequest) (*QueryTotalStakingsResponse, error)
+	Rewards(context.Context, *QueryRewardsRequest) (*QueryRewardsResponse, error)
+	// PlanRewards returns the rewards of a farmer allocated by a plan.
+	PlanRewards(context.Context, *QueryPlanRewardsRequest) (*QueryPlanRewardsResponse, error)
+	// Apr returns the projected rewards per staked unit of a staking coin denom.
+	Apr(context.Context, *QueryAprRequest) (*QueryAprResponse, error)
+	// VestingRewards returns locked and unlocked vesting rewards of a farmer.
+	VestingRewards(context.Context, *QueryVestingRewardsRequest) (*QueryVestingRewardsResponse, error)
+	// UnbondingStakings returns the unbonding entries of the farmer's unstaked coins.
+	UnbondingStakings(context.Context, *QueryUnbondingStakingsRequest) (*QueryUnbondingStakingsResponse, error)
+	// RewardWithdrawAddress returns the address that the rewards of a farmer are sent to.
+	RewardWithdrawAddress(context.Context, *QueryRewardWithdrawAddressRequest) (*QueryRewardWithdrawAddressResponse, error)
+	// AutoCompoundFarmers returns the farmers who have turned on auto-compounding of their rewards.
+	AutoCompoundFarmers(context.Context, *QueryAutoCompoundFarmersRequest) (*QueryAutoCompoundFarmersResponse, error)
+	// RewardsDust returns the fractional rewards left over from truncation which are not swept yet.
+	RewardsDust(context.Context, *QueryRewardsDustRequest) (*QueryRewardsDustResponse, error)
+	// EpochInfo returns the last epoch time, the current epoch duration, the next epoch time
+	// and the current epoch of each staking coin denom.
+	EpochInfo(context.Context, *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error)
+	// CurrentEpochDays returns current epoch days and duration.
+	CurrentEpochDays(context.Context, *QueryCurrentEpochDaysRequest) (*QueryCurrentEpochDaysResponse, error)
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Plans(ctx context.Context, req *QueryPlansRequest) (*QueryPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Plans not implemented")
+}
+func (*UnimplementedQueryServer) Plan(ctx context.Context, req *QueryPlanRequest) (*QueryPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Plan not implemented")
+}
+func (*UnimplementedQueryServer) PlanFarmers(ctx context.Context, req *QueryPlanFarmersRequest) (*QueryPlanFarmersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanFarmers not implemented")
+}
+func (*UnimplementedQueryServer) Stakings(ctx context.Context, req *QueryStakingsRequest) (*QueryStakingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stakings not implemented")
+}
+func (*UnimplementedQueryServer) TotalStakings(ctx context.Context, req *QueryTotalStakingsRequest) (*QueryTotalStakingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalStakings not implemented")
+}
+func (*UnimplementedQueryServer) Rewards(ctx context.Context, req *QueryRewardsRequest) (*QueryRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rewards not implemented")
+}
+func (*UnimplementedQueryServer) PlanRewards(ctx context.Context, req *QueryPlanRewardsRequest) (*QueryPlanRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanRewards not implemented")
+}
+func (*UnimplementedQueryServer) Apr(ctx context.Context, req *QueryAprRequest) (*QueryAprResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Apr not implemented")
+}
+func (*UnimplementedQueryServer) VestingRewards(ctx context.Context, req *QueryVestingRewardsRequest) (*QueryVestingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingRewards not implemented")
+}
+func (*UnimplementedQueryServer) UnbondingStakings(ctx context.Context, req *QueryUnbondingStakingsRequest) (*QueryUnbondingStakingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingStakings not implemented")
+}
+func (*UnimplementedQueryServer) RewardWithdrawAddress(ctx context.Context, req *QueryRewardWithdrawAddressRequest) (*QueryRewardWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardWithdrawAddress not implemented")
+}
+func (*UnimplementedQueryServer) AutoCompoundFarmers(ctx context.Context, req *QueryAutoCompoundFarmersRequest) (*QueryAutoCompoundFarmersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompoundFarmers not implemented")
+}
+func (*UnimplementedQueryServer) RewardsDust(ctx context.Context, req *QueryRewardsDustRequest) (*QueryRewardsDustResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsDust not implemented")
+}
+func (*UnimplementedQueryServer) EpochInfo(ctx context.Context, req *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochInfo not implemented")
+}
+func (*UnimplementedQueryServer) CurrentEpochDays(ctx context.Context, req *QueryCurrentEpochDaysRequest) (*QueryCurrentEpochDaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpochDays not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Plans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Plans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Plans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Plans(ctx, req.(*QueryPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Plan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Plan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Plan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Plan(ctx, req.(*QueryPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PlanFarmers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlanFarmersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlanFarmers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/PlanFarmers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlanFarmers(ctx, req.(*QueryPlanFarmersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stakings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Stakings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stakings(ctx, req.(*QueryStakingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalStakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalStakingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalStakings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/TotalStakings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalStakings(ctx, req.(*QueryTotalStakingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Rewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Rewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Rewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Rewards(ctx, req.(*QueryRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PlanRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlanRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlanRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/PlanRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlanRewards(ctx, req.(*QueryPlanRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Apr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAprRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Apr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Apr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Apr(ctx, req.(*QueryAprRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/VestingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingRewards(ctx, req.(*QueryVestingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingStakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingStakingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingStakings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/UnbondingStakings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingStakings(ctx, req.(*QueryUnbondingStakingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardWithdrawAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/RewardWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardWithdrawAddress(ctx, req.(*QueryRewardWithdrawAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoCompoundFarmers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundFarmersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundFarmers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/AutoCompoundFarmers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundFarmers(ctx, req.(*QueryAutoCompoundFarmersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsDust_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsDustRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsDust(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/RewardsDust",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsDust(ctx, req.(*QueryRewardsDustRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/EpochInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochInfo(ctx, req.(*QueryEpochInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentEpochDays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentEpochDaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentEpochDays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/CurrentEpochDays",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentEpochDays(ctx, req.(*QueryCurrentEpochDaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.farming.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Plans",
+			Handler:    _Query_Plans_Handler,
+		},
+		{
+			MethodName: "Plan",
+			Handler:    _Query_Plan_Handler,
+		},
+		{
+			MethodName: "PlanFarmers",
+			Handler:    _Query_PlanFarmers_Handler,
+		},
+		{
+			MethodName: "Stakings",
+			Handler:    _Query_Stakings_Handler,
+		},
+		{
+			MethodName: "TotalStakings",
+			Handler:    _Query_TotalStakings_Handler,
+		},
+		{
+			MethodName: "Rewards",
+			Handler:    _Query_Rewards_Handler,
+		},
+		{
+			MethodName: "PlanRewards",
+			Handler:    _Query_PlanRewards_Handler,
+		},
+		{
+			MethodName: "Apr",
+			Handler:    _Query_Apr_Handler,
+		},
+		{
+			MethodName: "VestingRewards",
+			Handler:    _Query_VestingRewards_Handler,
+		},
+		{
+			MethodName: "UnbondingStakings",
+			Handler:    _Query_UnbondingStakings_Handler,
+		},
+		{
+			MethodName: "RewardWithdrawAddress",
+			Handler:    _Query_RewardWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "AutoCompoundFarmers",
+			Handler:    _Query_AutoCompoundFarmers_Handler,
+		},
+		{
+			MethodName: "RewardsDust",
+			Handler:    _Query_RewardsDust_Handler,
+		},
+		{
+			MethodName: "EpochInfo",
+			Handler:    _Query_EpochInfo_Handler,
+		},
+		{
+			MethodName: "CurrentEpochDays",
+			Handler:    _Query_CurrentEpochDays_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/farming/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlansRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlansRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Terminated) > 0 {
+		i -= len(m.Terminated)
+		copy(dAtA[i:], m.Terminated)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Terminated)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TerminationAddress) > 0 {
+		i -= len(m.TerminationAddress)
+		copy(dAtA[i:], m.TerminationAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TerminationAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FarmingPoolAddress) > 0 {
+		i -= len(m.FarmingPoolAddress)
+		copy(dAtA[i:], m.FarmingPoolAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FarmingPoolAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Plan != nil {
+		{
+			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlanFarmersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanFarmersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanFarmersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlanFarmersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanFarmersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanFarmersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmers) > 0 {
+		for iNdEx := len(m.Farmers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Farmers[iNdEx])
+			copy(dAtA[i:], m.Farmers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueuedCoins) > 0 {
+		for iNdEx := len(m.QueuedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StakedCoins) > 0 {
+		for iNdEx := len(m.StakedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalStakingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalStakingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalStakingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalStakingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalStakingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalStakingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlanRewards) > 0 {
+		for iNdEx := len(m.PlanRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlanRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlanRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlanRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAprRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAprRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAprRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdditionalStakingAmount) > 0 {
+		i -= len(m.AdditionalStakingAmount)
+		copy(dAtA[i:], m.AdditionalStakingAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AdditionalStakingAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAprResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAprResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAprResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AnnualUnitRewards) > 0 {
+		for iNdEx := len(m.AnnualUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnnualUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EpochUnitRewards) > 0 {
+		for iNdEx := len(m.EpochUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.EpochRewards) > 0 {
+		for iNdEx := len(m.EpochRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.TotalStakings.Size()
+		i -= size
+		if _, err := m.TotalStakings.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardVestings) > 0 {
+		for iNdEx := len(m.RewardVestings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardVestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.UnlockedRewards) > 0 {
+		for iNdEx := len(m.UnlockedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnlockedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.LockedRewards) > 0 {
+		for iNdEx := len(m.LockedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingStakingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingStakingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingStakingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingStakingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingStakingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingStakingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardWithdrawAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardWithdrawAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardWithdrawAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundFarmersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundFarmersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundFarmersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundFarmersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundFarmersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundFarmersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmers) > 0 {
+		for iNdEx := len(m.Farmers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Farmers[iNdEx])
+			copy(dAtA[i:], m.Farmers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsDustRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsDustRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsDustRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsDustResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsDustResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsDustResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsDust) > 0 {
+		for iNdEx := len(m.RewardsDust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsDust[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CurrentEpochs) > 0 {
+		for iNdEx := len(m.CurrentEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NextEpochTime != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NextEpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextEpochTime):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintQuery(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CurrentEpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	if m.LastEpochTime != nil {
+		n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastEpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastEpochTime):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintQuery(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrentEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrentEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrentEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochDaysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochDaysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochDaysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochDaysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochDaysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochDaysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CurrentEpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x12
+	if m.CurrentEpochDays != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpochDays))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPlansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FarmingPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TerminationAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Terminated)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	return n
+}
+
+func (m *QueryPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanFarmersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanFarmersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Farmers) > 0 {
+		for _, s := range m.Farmers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StakedCoins) > 0 {
+		for _, e := range m.StakedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.QueuedCoins) > 0 {
+		for _, e := range m.QueuedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalStakingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalStakingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PlanRewards) > 0 {
+		for _, e := range m.PlanRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PlanRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPlanRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAprRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AdditionalStakingAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAprResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalStakings.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.EpochRewards) > 0 {
+		for _, e := range m.EpochRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.EpochUnitRewards) > 0 {
+		for _, e := range m.EpochUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AnnualUnitRewards) > 0 {
+		for _, e := range m.AnnualUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVestingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockedRewards) > 0 {
+		for _, e := range m.LockedRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnlockedRewards) > 0 {
+		for _, e := range m.UnlockedRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RewardVestings) > 0 {
+		for _, e := range m.RewardVestings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnbondingStakingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingStakingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardWithdrawAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundFarmersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundFarmersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Farmers) > 0 {
+		for _, s := range m.Farmers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsDustRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardsDustResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardsDust) > 0 {
+		for _, e := range m.RewardsDust {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEpochInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastEpochTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastEpochTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NextEpochTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextEpochTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CurrentEpochs) > 0 {
+		for _, e := range m.CurrentEpochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CurrentEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	return n
+}
+
+func (m *QueryCurrentEpochDaysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentEpochDaysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentEpochDays != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpochDays))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TerminationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Terminated = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plans = append(m.Plans, &types.Any{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plan == nil {
+				m.Plan = &types.Any{}
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanFarmersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanFarmersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanFarmersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanFarmersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanFarmersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanFarmersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmers = append(m.Farmers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakedCoins = append(m.StakedCoins, types1.Coin{})
+			if err := m.StakedCoins[len(m.StakedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedCoins = append(m.QueuedCoins, types1.Coin{})
+			if err := m.QueuedCoins[len(m.QueuedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalStakingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalStakingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalStakingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalStakingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalStakingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalStakingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types1.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanRewards = append(m.PlanRewards, PlanRewards{})
+			if err := m.PlanRewards[len(m.PlanRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlanRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlanRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlanRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types1.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Rewards = append(m.Rewards, types1.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1892,7 +6346,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAprRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1915,17 +6369,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAprRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAprRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1935,24 +6389,55 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalStakingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.AdditionalStakingAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1975,7 +6460,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAprResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1998,15 +6483,15 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlansRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAprResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAprResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStakings", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2034,13 +6519,15 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalStakings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2050,27 +6537,147 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			m.EpochRewards = append(m.EpochRewards, types1.Coin{})
+			if err := m.EpochRewards[len(m.EpochRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TerminationAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochUnitRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochUnitRewards = append(m.EpochUnitRewards, types1.DecCoin{})
+			if err := m.EpochUnitRewards[len(m.EpochUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualUnitRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnualUnitRewards = append(m.AnnualUnitRewards, types1.DecCoin{})
+			if err := m.AnnualUnitRewards[len(m.AnnualUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2098,13 +6705,63 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TerminationAddress = string(dAtA[iNdEx:postIndex])
+			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2114,29 +6771,31 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			m.LockedRewards = append(m.LockedRewards, types1.Coin{})
+			if err := m.LockedRewards[len(m.LockedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockedRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2146,27 +6805,29 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Terminated = string(dAtA[iNdEx:postIndex])
+			m.UnlockedRewards = append(m.UnlockedRewards, types1.Coin{})
+			if err := m.UnlockedRewards[len(m.UnlockedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVestings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2193,10 +6854,8 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardVestings = append(m.RewardVestings, RewardVesting{})
+			if err := m.RewardVestings[len(m.RewardVestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2221,7 +6880,7 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingStakingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2244,17 +6903,17 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlansResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingStakingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingStakingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2264,29 +6923,77 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Plans = append(m.Plans, &types.Any{})
-			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingStakingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingStakingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingStakingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2313,10 +7020,8 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, UnbondingStakingEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2341,7 +7046,7 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2364,17 +7069,17 @@ func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardWithdrawAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
-			m.PlanId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2384,11 +7089,24 @@ func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PlanId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2410,7 +7128,7 @@ func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2433,17 +7151,17 @@ func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardWithdrawAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2453,27 +7171,23 @@ func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plan == nil {
-				m.Plan = &types.Any{}
-			}
-			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2496,7 +7210,7 @@ func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakingsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoCompoundFarmersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2519,17 +7233,17 @@ func (m *QueryStakingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoCompoundFarmersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoCompoundFarmersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2539,55 +7253,27 @@ func (m *QueryStakingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Farmer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2610,7 +7296,7 @@ func (m *QueryStakingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoCompoundFarmersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2633,17 +7319,17 @@ func (m *QueryStakingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoCompoundFarmersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoCompoundFarmersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2653,29 +7339,27 @@ func (m *QueryStakingsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakedCoins = append(m.StakedCoins, types1.Coin{})
-			if err := m.StakedCoins[len(m.StakedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Farmers = append(m.Farmers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2699,14 +7383,66 @@ func (m *QueryStakingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsDustRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueuedCoins = append(m.QueuedCoins, types1.Coin{})
-			if err := m.QueuedCoins[len(m.QueuedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsDustRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsDustRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2728,7 +7464,7 @@ func (m *QueryStakingsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalStakingsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardsDustResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2751,17 +7487,17 @@ func (m *QueryTotalStakingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalStakingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardsDustResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalStakingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardsDustResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsDust", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2771,23 +7507,25 @@ func (m *QueryTotalStakingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			m.RewardsDust = append(m.RewardsDust, types1.DecCoin{})
+			if err := m.RewardsDust[len(m.RewardsDust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2810,7 +7548,7 @@ func (m *QueryTotalStakingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalStakingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2833,17 +7571,17 @@ func (m *QueryTotalStakingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalStakingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalStakingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2853,23 +7591,25 @@ func (m *QueryTotalStakingsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2894,7 +7634,7 @@ func (m *QueryTotalStakingsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2917,17 +7657,17 @@ func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2937,29 +7677,33 @@ func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Farmer = string(dAtA[iNdEx:postIndex])
+			if m.LastEpochTime == nil {
+				m.LastEpochTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastEpochTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochDuration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2969,23 +7713,130 @@ func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CurrentEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextEpochTime == nil {
+				m.NextEpochTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NextEpochTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentEpochs = append(m.CurrentEpochs, CurrentEpoch{})
+			if err := m.CurrentEpochs[len(m.CurrentEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3008,7 +7859,7 @@ func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *CurrentEpoch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3031,17 +7882,17 @@ func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CurrentEpoch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CurrentEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3051,26 +7902,43 @@ func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types1.Coin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3190,6 +8058,39 @@ func (m *QueryCurrentEpochDaysResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CurrentEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
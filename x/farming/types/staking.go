@@ -1,10 +1,28 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// ReceiptDenomPrefix is the prefix of the receipt denoms minted for receipt-backed stakings.
+const ReceiptDenomPrefix = "farm/"
+
+// ReceiptDenom returns the denom of the receipt coins minted for the staking coin denom.
+func ReceiptDenom(stakingCoinDenom string) string {
+	return ReceiptDenomPrefix + stakingCoinDenom
+}
+
+// IsReceiptDenom returns whether the denom is a receipt denom.
+func IsReceiptDenom(denom string) bool {
+	return strings.HasPrefix(denom, ReceiptDenomPrefix)
+}
+
 func (s Staking) String() string {
 	out, _ := s.MarshalYAML()
 	return out.(string)
@@ -17,3 +35,96 @@ func (s Staking) MarshalYAML() (interface{}, error) {
 	}
 	return string(bz), err
 }
+
+// GetBoostAmount returns the boost amount of the staking.
+// It returns zero for a staking stored without the boost amount.
+func (s Staking) GetBoostAmount() sdk.Int {
+	if s.BoostAmount.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return s.BoostAmount
+}
+
+// BoostedAmount returns the reward weight of the staking, which is the sum of
+// the staked amount and the boost amount given by its locked stakings.
+func (s Staking) BoostedAmount() sdk.Int {
+	return s.Amount.Add(s.GetBoostAmount())
+}
+
+// IsMaturedAt returns whether the queued coins have been staked by an epoch advancement,
+// given the number of epochs advanced so far.
+func (queuedStaking QueuedStaking) IsMaturedAt(epochNumber uint64) bool {
+	return queuedStaking.QueuedEpoch < epochNumber
+}
+
+// IsExpiredAt returns whether the locked staking is expired at given time t.
+func (lock LockedStaking) IsExpiredAt(t time.Time) bool {
+	return !lock.EndTime.After(t)
+}
+
+// BoostAmountOf returns the additional reward weight of amt locked with the multiplier.
+func BoostAmountOf(amt sdk.Int, multiplier sdk.Dec) sdk.Int {
+	return amt.ToDec().Mul(multiplier.Sub(sdk.OneDec())).TruncateInt()
+}
+
+// NewUnbondingStaking creates a new UnbondingStaking object with a single entry.
+func NewUnbondingStaking(farmerAcc sdk.AccAddress, creationHeight int64, completionTime time.Time, balance sdk.Coins) UnbondingStaking {
+	return UnbondingStaking{
+		Farmer: farmerAcc.String(),
+		Entries: []UnbondingStakingEntry{
+			NewUnbondingStakingEntry(creationHeight, completionTime, balance),
+		},
+	}
+}
+
+// NewUnbondingStakingEntry creates a new UnbondingStakingEntry object.
+func NewUnbondingStakingEntry(creationHeight int64, completionTime time.Time, balance sdk.Coins) UnbondingStakingEntry {
+	return UnbondingStakingEntry{
+		CreationHeight: creationHeight,
+		CompletionTime: completionTime,
+		Balance:        balance,
+	}
+}
+
+// IsMature returns whether the unbonding entry is completed at given time t.
+func (e UnbondingStakingEntry) IsMature(t time.Time) bool {
+	return !e.CompletionTime.After(t)
+}
+
+// AddEntry appends a new entry to the unbonding staking.
+func (ubd *UnbondingStaking) AddEntry(creationHeight int64, completionTime time.Time, balance sdk.Coins) {
+	ubd.Entries = append(ubd.Entries, NewUnbondingStakingEntry(creationHeight, completionTime, balance))
+}
+
+// RemoveEntry removes the entry at index i from the unbonding staking.
+func (ubd *UnbondingStaking) RemoveEntry(i int) {
+	ubd.Entries = append(ubd.Entries[:i], ubd.Entries[i+1:]...)
+}
+
+// Balance returns the total unbonding coins of all entries.
+func (ubd UnbondingStaking) Balance() sdk.Coins {
+	balance := sdk.NewCoins()
+	for _, entry := range ubd.Entries {
+		balance = balance.Add(entry.Balance...)
+	}
+	return balance
+}
+
+// Validate checks for errors on the UnbondingStaking fields.
+func (ubd UnbondingStaking) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(ubd.Farmer); err != nil {
+		return err
+	}
+	if len(ubd.Entries) == 0 {
+		return fmt.Errorf("unbonding staking of %s must have at least one entry", ubd.Farmer)
+	}
+	for _, entry := range ubd.Entries {
+		if err := entry.Balance.Validate(); err != nil {
+			return err
+		}
+		if !entry.Balance.IsAllPositive() {
+			return fmt.Errorf("unbonding balance must be positive: %s", entry.Balance)
+		}
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -51,6 +52,9 @@ type MsgCreateFixedAmountPlan struct {
 	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
 	// epoch_amount specifies the distributing amount for each epoch
 	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
+	// reward_vesting_duration specifies the duration over which harvested rewards from the plan
+	// unlock linearly; zero means rewards are not vested
+	RewardVestingDuration time.Duration `protobuf:"bytes,7,opt,name=reward_vesting_duration,json=rewardVestingDuration,proto3,stdduration" json:"reward_vesting_duration" yaml:"reward_vesting_duration"`
 }
 
 func (m *MsgCreateFixedAmountPlan) Reset()         { *m = MsgCreateFixedAmountPlan{} }
@@ -139,6 +143,9 @@ type MsgCreateRatioPlan struct {
 	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
 	// epoch_ratio specifies the distributing amount by ratio
 	EpochRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=epoch_ratio,json=epochRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_ratio" yaml:"epoch_ratio"`
+	// reward_vesting_duration specifies the duration over which harvested rewards from the plan
+	// unlock linearly; zero means rewards are not vested
+	RewardVestingDuration time.Duration `protobuf:"bytes,7,opt,name=reward_vesting_duration,json=rewardVestingDuration,proto3,stdduration" json:"reward_vesting_duration" yaml:"reward_vesting_duration"`
 }
 
 func (m *MsgCreateRatioPlan) Reset()         { *m = MsgCreateRatioPlan{} }
@@ -212,19 +219,212 @@ func (m *MsgCreateRatioPlanResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateRatioPlanResponse proto.InternalMessageInfo
 
+// MsgCreateDecayingPlan defines a SDK message for creating a new decaying
+// farming plan.
+type MsgCreateDecayingPlan struct {
+	// name specifies the name for the plan
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// creator defines the bech32-encoded address of the creator for the private plan, termination address is also set to
+	// this creator.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// staking_coin_weights specifies coins weight for the plan
+	StakingCoinWeights github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=staking_coin_weights,json=stakingCoinWeights,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"staking_coin_weights" yaml:"staking_coin_weights"`
+	// start_time specifies the start time of the plan
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// end_time specifies the end time of the plan
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// epoch_amount specifies the initial distributing amount for each epoch
+	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
+	// decay_rate specifies the factor that the distributing amount is multiplied by
+	// every decay_epochs
+	DecayRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=decay_rate,json=decayRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_rate" yaml:"decay_rate"`
+	// decay_epochs specifies the number of epochs between decays
+	DecayEpochs uint32 `protobuf:"varint,8,opt,name=decay_epochs,json=decayEpochs,proto3" json:"decay_epochs,omitempty" yaml:"decay_epochs"`
+	// reward_vesting_duration specifies the duration over which harvested rewards from the plan
+	// unlock linearly; zero means rewards are not vested
+	RewardVestingDuration time.Duration `protobuf:"bytes,9,opt,name=reward_vesting_duration,json=rewardVestingDuration,proto3,stdduration" json:"reward_vesting_duration" yaml:"reward_vesting_duration"`
+}
+
+func (m *MsgCreateDecayingPlan) Reset()         { *m = MsgCreateDecayingPlan{} }
+func (m *MsgCreateDecayingPlan) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDecayingPlan) ProtoMessage()    {}
+func (*MsgCreateDecayingPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{4}
+}
+func (m *MsgCreateDecayingPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDecayingPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDecayingPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDecayingPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDecayingPlan.Merge(m, src)
+}
+func (m *MsgCreateDecayingPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDecayingPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDecayingPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDecayingPlan proto.InternalMessageInfo
+
+// MsgCreateDecayingPlanResponse defines the Msg/MsgCreateDecayingPlanResponse response type.
+type MsgCreateDecayingPlanResponse struct {
+}
+
+func (m *MsgCreateDecayingPlanResponse) Reset()         { *m = MsgCreateDecayingPlanResponse{} }
+func (m *MsgCreateDecayingPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDecayingPlanResponse) ProtoMessage()    {}
+func (*MsgCreateDecayingPlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{5}
+}
+func (m *MsgCreateDecayingPlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDecayingPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDecayingPlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDecayingPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDecayingPlanResponse.Merge(m, src)
+}
+func (m *MsgCreateDecayingPlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDecayingPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDecayingPlanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDecayingPlanResponse proto.InternalMessageInfo
+
+// MsgCreateSchedulePlan defines a SDK message for creating a new schedule-based
+// farming plan.
+type MsgCreateSchedulePlan struct {
+	// name specifies the name for the plan
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// creator defines the bech32-encoded address of the creator for the private plan, termination address is also set to
+	// this creator.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// staking_coin_weights specifies coins weight for the plan
+	StakingCoinWeights github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=staking_coin_weights,json=stakingCoinWeights,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"staking_coin_weights" yaml:"staking_coin_weights"`
+	// start_time specifies the start time of the plan
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// end_time specifies the end time of the plan
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// phases specifies the ordered, non-overlapping emission phases of the plan
+	Phases []SchedulePhase `protobuf:"bytes,6,rep,name=phases,proto3" json:"phases"`
+	// reward_vesting_duration specifies the duration over which harvested rewards from the plan
+	// unlock linearly; zero means rewards are not vested
+	RewardVestingDuration time.Duration `protobuf:"bytes,7,opt,name=reward_vesting_duration,json=rewardVestingDuration,proto3,stdduration" json:"reward_vesting_duration" yaml:"reward_vesting_duration"`
+}
+
+func (m *MsgCreateSchedulePlan) Reset()         { *m = MsgCreateSchedulePlan{} }
+func (m *MsgCreateSchedulePlan) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSchedulePlan) ProtoMessage()    {}
+func (*MsgCreateSchedulePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{6}
+}
+func (m *MsgCreateSchedulePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSchedulePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSchedulePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateSchedulePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSchedulePlan.Merge(m, src)
+}
+func (m *MsgCreateSchedulePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSchedulePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSchedulePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateSchedulePlan proto.InternalMessageInfo
+
+// MsgCreateSchedulePlanResponse defines the Msg/MsgCreateSchedulePlanResponse response type.
+type MsgCreateSchedulePlanResponse struct {
+}
+
+func (m *MsgCreateSchedulePlanResponse) Reset()         { *m = MsgCreateSchedulePlanResponse{} }
+func (m *MsgCreateSchedulePlanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSchedulePlanResponse) ProtoMessage()    {}
+func (*MsgCreateSchedulePlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{7}
+}
+func (m *MsgCreateSchedulePlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSchedulePlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSchedulePlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateSchedulePlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSchedulePlanResponse.Merge(m, src)
+}
+func (m *MsgCreateSchedulePlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSchedulePlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSchedulePlanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateSchedulePlanResponse proto.InternalMessageInfo
+
 // MsgStake defines a SDK message for staking coins into the farming plan.
 type MsgStake struct {
 	// farmer defines the bech32-encoded address of the farmer
 	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
 	// staking_coins specifies coins to stake
 	StakingCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=staking_coins,json=stakingCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"staking_coins" yaml:"staking_coins"`
+	// lock_duration specifies the duration for which the staking coins are locked;
+	// zero means the coins are not locked
+	LockDuration time.Duration `protobuf:"bytes,3,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration" yaml:"lock_duration"`
+	// mint_receipt specifies whether to mint receipt coins of the staking coins to the farmer;
+	// the receipt-backed staking follows the ownership of the receipt coins
+	MintReceipt bool `protobuf:"varint,4,opt,name=mint_receipt,json=mintReceipt,proto3" json:"mint_receipt,omitempty" yaml:"mint_receipt"`
 }
 
 func (m *MsgStake) Reset()         { *m = MsgStake{} }
 func (m *MsgStake) String() string { return proto.CompactTextString(m) }
 func (*MsgStake) ProtoMessage()    {}
 func (*MsgStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{4}
+	return fileDescriptor_a33d9a3ff13f514a, []int{8}
 }
 func (m *MsgStake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,7 +461,7 @@ func (m *MsgStakeResponse) Reset()         { *m = MsgStakeResponse{} }
 func (m *MsgStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgStakeResponse) ProtoMessage()    {}
 func (*MsgStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{5}
+	return fileDescriptor_a33d9a3ff13f514a, []int{9}
 }
 func (m *MsgStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -303,7 +503,7 @@ func (m *MsgUnstake) Reset()         { *m = MsgUnstake{} }
 func (m *MsgUnstake) String() string { return proto.CompactTextString(m) }
 func (*MsgUnstake) ProtoMessage()    {}
 func (*MsgUnstake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{6}
+	return fileDescriptor_a33d9a3ff13f514a, []int{10}
 }
 func (m *MsgUnstake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,7 +540,7 @@ func (m *MsgUnstakeResponse) Reset()         { *m = MsgUnstakeResponse{} }
 func (m *MsgUnstakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnstakeResponse) ProtoMessage()    {}
 func (*MsgUnstakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{7}
+	return fileDescriptor_a33d9a3ff13f514a, []int{11}
 }
 func (m *MsgUnstakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -369,6 +569,164 @@ func (m *MsgUnstakeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnstakeResponse proto.InternalMessageInfo
 
+// MsgCancelQueuedStaking defines a SDK message for cancelling queued coins
+// which are not staked yet.
+type MsgCancelQueuedStaking struct {
+	// farmer defines the bech32-encoded address of the farmer
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	// queued_coins specifies queued coins to cancel
+	QueuedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=queued_coins,json=queuedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"queued_coins" yaml:"queued_coins"`
+}
+
+func (m *MsgCancelQueuedStaking) Reset()         { *m = MsgCancelQueuedStaking{} }
+func (m *MsgCancelQueuedStaking) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelQueuedStaking) ProtoMessage()    {}
+func (*MsgCancelQueuedStaking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{12}
+}
+func (m *MsgCancelQueuedStaking) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelQueuedStaking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelQueuedStaking.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelQueuedStaking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelQueuedStaking.Merge(m, src)
+}
+func (m *MsgCancelQueuedStaking) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelQueuedStaking) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelQueuedStaking.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelQueuedStaking proto.InternalMessageInfo
+
+// MsgCancelQueuedStakingResponse defines the Msg/MsgCancelQueuedStakingResponse response type.
+type MsgCancelQueuedStakingResponse struct {
+}
+
+func (m *MsgCancelQueuedStakingResponse) Reset()         { *m = MsgCancelQueuedStakingResponse{} }
+func (m *MsgCancelQueuedStakingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelQueuedStakingResponse) ProtoMessage()    {}
+func (*MsgCancelQueuedStakingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{13}
+}
+func (m *MsgCancelQueuedStakingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelQueuedStakingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelQueuedStakingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelQueuedStakingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelQueuedStakingResponse.Merge(m, src)
+}
+func (m *MsgCancelQueuedStakingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelQueuedStakingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelQueuedStakingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelQueuedStakingResponse proto.InternalMessageInfo
+
+// MsgEmergencyUnstake defines a SDK message for withdrawing all staked and queued coins
+// of the staking coin denoms while staking or harvesting is paused, forfeiting the pending rewards.
+type MsgEmergencyUnstake struct {
+	// farmer defines the bech32-encoded address of the farmer
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	// staking_coin_denoms is the set of denoms of staked coins to withdraw
+	StakingCoinDenoms []string `protobuf:"bytes,2,rep,name=staking_coin_denoms,json=stakingCoinDenoms,proto3" json:"staking_coin_denoms,omitempty" yaml:"staking_coin_denoms"`
+}
+
+func (m *MsgEmergencyUnstake) Reset()         { *m = MsgEmergencyUnstake{} }
+func (m *MsgEmergencyUnstake) String() string { return proto.CompactTextString(m) }
+func (*MsgEmergencyUnstake) ProtoMessage()    {}
+func (*MsgEmergencyUnstake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{14}
+}
+func (m *MsgEmergencyUnstake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEmergencyUnstake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEmergencyUnstake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEmergencyUnstake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEmergencyUnstake.Merge(m, src)
+}
+func (m *MsgEmergencyUnstake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEmergencyUnstake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEmergencyUnstake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEmergencyUnstake proto.InternalMessageInfo
+
+// MsgEmergencyUnstakeResponse defines the Msg/MsgEmergencyUnstakeResponse response type.
+type MsgEmergencyUnstakeResponse struct {
+}
+
+func (m *MsgEmergencyUnstakeResponse) Reset()         { *m = MsgEmergencyUnstakeResponse{} }
+func (m *MsgEmergencyUnstakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEmergencyUnstakeResponse) ProtoMessage()    {}
+func (*MsgEmergencyUnstakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{15}
+}
+func (m *MsgEmergencyUnstakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEmergencyUnstakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEmergencyUnstakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEmergencyUnstakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEmergencyUnstakeResponse.Merge(m, src)
+}
+func (m *MsgEmergencyUnstakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEmergencyUnstakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEmergencyUnstakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEmergencyUnstakeResponse proto.InternalMessageInfo
+
 // MsgHarvest defines a SDK message for claiming rewards from the farming plan.
 type MsgHarvest struct {
 	// farmer defines the bech32-encoded address of the farmer
@@ -382,7 +740,7 @@ func (m *MsgHarvest) Reset()         { *m = MsgHarvest{} }
 func (m *MsgHarvest) String() string { return proto.CompactTextString(m) }
 func (*MsgHarvest) ProtoMessage()    {}
 func (*MsgHarvest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{8}
+	return fileDescriptor_a33d9a3ff13f514a, []int{16}
 }
 func (m *MsgHarvest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,7 +777,7 @@ func (m *MsgHarvestResponse) Reset()         { *m = MsgHarvestResponse{} }
 func (m *MsgHarvestResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgHarvestResponse) ProtoMessage()    {}
 func (*MsgHarvestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{9}
+	return fileDescriptor_a33d9a3ff13f514a, []int{17}
 }
 func (m *MsgHarvestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,24 +806,29 @@ func (m *MsgHarvestResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgHarvestResponse proto.InternalMessageInfo
 
-// MsgAdvanceEpoch defines a message to advance epoch by one.
-type MsgAdvanceEpoch struct {
-	// requester defines the bech32-encoded address of the requester
-	Requester string `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+// MsgTransferStaking defines a SDK message for transferring the staked and queued coins
+// of a farmer to another address without unstaking.
+type MsgTransferStaking struct {
+	// farmer defines the bech32-encoded address of the farmer
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	// recipient defines the bech32-encoded address that receives the staking positions
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// staking_coin_denoms is the set of denoms of the staking positions to transfer
+	StakingCoinDenoms []string `protobuf:"bytes,3,rep,name=staking_coin_denoms,json=stakingCoinDenoms,proto3" json:"staking_coin_denoms,omitempty" yaml:"staking_coin_denoms"`
 }
 
-func (m *MsgAdvanceEpoch) Reset()         { *m = MsgAdvanceEpoch{} }
-func (m *MsgAdvanceEpoch) String() string { return proto.CompactTextString(m) }
-func (*MsgAdvanceEpoch) ProtoMessage()    {}
-func (*MsgAdvanceEpoch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{10}
+func (m *MsgTransferStaking) Reset()         { *m = MsgTransferStaking{} }
+func (m *MsgTransferStaking) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferStaking) ProtoMessage()    {}
+func (*MsgTransferStaking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{18}
 }
-func (m *MsgAdvanceEpoch) XXX_Unmarshal(b []byte) error {
+func (m *MsgTransferStaking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAdvanceEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTransferStaking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAdvanceEpoch.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTransferStaking.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -475,34 +838,34 @@ func (m *MsgAdvanceEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgAdvanceEpoch) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAdvanceEpoch.Merge(m, src)
+func (m *MsgTransferStaking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferStaking.Merge(m, src)
 }
-func (m *MsgAdvanceEpoch) XXX_Size() int {
+func (m *MsgTransferStaking) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAdvanceEpoch) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAdvanceEpoch.DiscardUnknown(m)
+func (m *MsgTransferStaking) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferStaking.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAdvanceEpoch proto.InternalMessageInfo
+var xxx_messageInfo_MsgTransferStaking proto.InternalMessageInfo
 
-// MsgAdvanceEpochResponse defines the Msg/AdvanceEpoch response type.
-type MsgAdvanceEpochResponse struct {
+// MsgTransferStakingResponse defines the Msg/MsgTransferStakingResponse response type.
+type MsgTransferStakingResponse struct {
 }
 
-func (m *MsgAdvanceEpochResponse) Reset()         { *m = MsgAdvanceEpochResponse{} }
-func (m *MsgAdvanceEpochResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAdvanceEpochResponse) ProtoMessage()    {}
-func (*MsgAdvanceEpochResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{11}
+func (m *MsgTransferStakingResponse) Reset()         { *m = MsgTransferStakingResponse{} }
+func (m *MsgTransferStakingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferStakingResponse) ProtoMessage()    {}
+func (*MsgTransferStakingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{19}
 }
-func (m *MsgAdvanceEpochResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgTransferStakingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAdvanceEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTransferStakingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAdvanceEpochResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTransferStakingResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -512,1027 +875,6592 @@ func (m *MsgAdvanceEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgAdvanceEpochResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAdvanceEpochResponse.Merge(m, src)
+func (m *MsgTransferStakingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferStakingResponse.Merge(m, src)
 }
-func (m *MsgAdvanceEpochResponse) XXX_Size() int {
+func (m *MsgTransferStakingResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAdvanceEpochResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAdvanceEpochResponse.DiscardUnknown(m)
+func (m *MsgTransferStakingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferStakingResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAdvanceEpochResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgTransferStakingResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateFixedAmountPlan)(nil), "cosmos.farming.v1beta1.MsgCreateFixedAmountPlan")
-	proto.RegisterType((*MsgCreateFixedAmountPlanResponse)(nil), "cosmos.farming.v1beta1.MsgCreateFixedAmountPlanResponse")
-	proto.RegisterType((*MsgCreateRatioPlan)(nil), "cosmos.farming.v1beta1.MsgCreateRatioPlan")
-	proto.RegisterType((*MsgCreateRatioPlanResponse)(nil), "cosmos.farming.v1beta1.MsgCreateRatioPlanResponse")
-	proto.RegisterType((*MsgStake)(nil), "cosmos.farming.v1beta1.MsgStake")
-	proto.RegisterType((*MsgStakeResponse)(nil), "cosmos.farming.v1beta1.MsgStakeResponse")
-	proto.RegisterType((*MsgUnstake)(nil), "cosmos.farming.v1beta1.MsgUnstake")
-	proto.RegisterType((*MsgUnstakeResponse)(nil), "cosmos.farming.v1beta1.MsgUnstakeResponse")
-	proto.RegisterType((*MsgHarvest)(nil), "cosmos.farming.v1beta1.MsgHarvest")
-	proto.RegisterType((*MsgHarvestResponse)(nil), "cosmos.farming.v1beta1.MsgHarvestResponse")
-	proto.RegisterType((*MsgAdvanceEpoch)(nil), "cosmos.farming.v1beta1.MsgAdvanceEpoch")
-	proto.RegisterType((*MsgAdvanceEpochResponse)(nil), "cosmos.farming.v1beta1.MsgAdvanceEpochResponse")
+// MsgSyncReceiptStaking defines a SDK message for syncing the receipt-backed staking of a farmer
+// with the receipt coins the farmer holds.
+type MsgSyncReceiptStaking struct {
+	// farmer defines the bech32-encoded address of the farmer
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	// staking_coin_denoms is the set of denoms of the staking coins whose receipts to sync
+	StakingCoinDenoms []string `protobuf:"bytes,2,rep,name=staking_coin_denoms,json=stakingCoinDenoms,proto3" json:"staking_coin_denoms,omitempty" yaml:"staking_coin_denoms"`
 }
 
-func init() {
-	proto.RegisterFile("tendermint/farming/v1beta1/tx.proto", fileDescriptor_a33d9a3ff13f514a)
+func (m *MsgSyncReceiptStaking) Reset()         { *m = MsgSyncReceiptStaking{} }
+func (m *MsgSyncReceiptStaking) String() string { return proto.CompactTextString(m) }
+func (*MsgSyncReceiptStaking) ProtoMessage()    {}
+func (*MsgSyncReceiptStaking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{20}
 }
-
-var fileDescriptor_a33d9a3ff13f514a = []byte{
-	// 842 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x4f, 0x1b, 0x47,
-	0x14, 0xf7, 0x82, 0x31, 0x78, 0xa0, 0x75, 0x19, 0x5c, 0xba, 0x2c, 0x74, 0xd7, 0xda, 0x4a, 0xad,
-	0x45, 0xc5, 0x6e, 0x71, 0x55, 0xa9, 0xe2, 0x86, 0xa1, 0x05, 0x55, 0x72, 0x55, 0x2d, 0xad, 0x9a,
-	0xe4, 0x62, 0xad, 0xed, 0x61, 0xbd, 0x82, 0xdd, 0x31, 0x3b, 0x63, 0x02, 0x39, 0x26, 0x8a, 0xc4,
-	0x29, 0xe2, 0x23, 0x44, 0xb9, 0x25, 0xd7, 0x1c, 0xf3, 0x05, 0x38, 0x72, 0x8c, 0x72, 0x30, 0x11,
-	0x7c, 0x03, 0xf2, 0x05, 0xa2, 0x9d, 0x99, 0xdd, 0x2c, 0xc6, 0xf8, 0xcf, 0x2d, 0x87, 0x9c, 0xbc,
-	0x33, 0xfb, 0x7b, 0xbf, 0x79, 0xef, 0xf7, 0x7e, 0x6f, 0xc7, 0xe0, 0x07, 0x8a, 0xfc, 0x06, 0x0a,
-	0x3c, 0xd7, 0xa7, 0xe6, 0xae, 0x1d, 0xfe, 0x3a, 0xe6, 0xe1, 0x6a, 0x0d, 0x51, 0x7b, 0xd5, 0xa4,
-	0x47, 0x46, 0x2b, 0xc0, 0x14, 0xc3, 0xf9, 0x3a, 0x26, 0x1e, 0x26, 0x86, 0x00, 0x18, 0x02, 0xa0,
-	0xe4, 0x1d, 0xec, 0x60, 0x06, 0x31, 0xc3, 0x27, 0x8e, 0x56, 0x16, 0x38, 0xba, 0xca, 0x5f, 0x88,
-	0x50, 0xfe, 0x4a, 0xe5, 0x2b, 0xb3, 0x66, 0x13, 0x14, 0x1f, 0x53, 0xc7, 0xae, 0x2f, 0xde, 0x6b,
-	0x0e, 0xc6, 0xce, 0x3e, 0x32, 0xd9, 0xaa, 0xd6, 0xde, 0x35, 0xa9, 0xeb, 0x21, 0x42, 0x6d, 0xaf,
-	0xc5, 0x01, 0xfa, 0xcb, 0x34, 0x90, 0x2b, 0xc4, 0xd9, 0x08, 0x90, 0x4d, 0xd1, 0x9f, 0xee, 0x11,
-	0x6a, 0xac, 0x7b, 0xb8, 0xed, 0xd3, 0x7f, 0xf6, 0x6d, 0x1f, 0x42, 0x90, 0xf6, 0x6d, 0x0f, 0xc9,
-	0x52, 0x41, 0x2a, 0x66, 0x2d, 0xf6, 0x0c, 0x65, 0x30, 0x59, 0x0f, 0xc1, 0x38, 0x90, 0xc7, 0xd8,
-	0x76, 0xb4, 0x84, 0x2f, 0x24, 0x90, 0x27, 0xd4, 0xde, 0x73, 0x7d, 0xa7, 0x1a, 0xa6, 0x50, 0x7d,
-	0x88, 0x5c, 0xa7, 0x49, 0x89, 0x3c, 0x5e, 0x18, 0x2f, 0x4e, 0x97, 0x96, 0x0c, 0x91, 0x79, 0x98,
-	0x6b, 0x54, 0xb1, 0xb1, 0x89, 0xea, 0x1b, 0xd8, 0xf5, 0xcb, 0xd6, 0x59, 0x47, 0x4b, 0x5d, 0x77,
-	0xb4, 0xc5, 0x63, 0xdb, 0xdb, 0x5f, 0xd3, 0x7b, 0xf1, 0xe8, 0xaf, 0x2e, 0xb4, 0x9f, 0x1d, 0x97,
-	0x36, 0xdb, 0x35, 0xa3, 0x8e, 0x3d, 0x21, 0x84, 0xf8, 0x59, 0x21, 0x8d, 0x3d, 0x93, 0x1e, 0xb7,
-	0x10, 0x89, 0x28, 0x89, 0x05, 0x05, 0x4b, 0xb8, 0xfa, 0x9f, 0x73, 0xc0, 0x7b, 0x00, 0x10, 0x6a,
-	0x07, 0xb4, 0x1a, 0x0a, 0x21, 0xa7, 0x0b, 0x52, 0x71, 0xba, 0xa4, 0x18, 0x5c, 0x25, 0x23, 0x52,
-	0xc9, 0xf8, 0x37, 0x52, 0xa9, 0xfc, 0xbd, 0xc8, 0x6b, 0x36, 0xce, 0x4b, 0xc4, 0xea, 0xa7, 0x17,
-	0x9a, 0x64, 0x65, 0xd9, 0x46, 0x08, 0x87, 0x16, 0x98, 0x42, 0x7e, 0x83, 0xf3, 0x4e, 0x0c, 0xe4,
-	0x5d, 0x14, 0xbc, 0x39, 0xce, 0x1b, 0x45, 0x72, 0xd6, 0x49, 0xe4, 0x37, 0x18, 0xe7, 0x53, 0x09,
-	0xcc, 0xa0, 0x16, 0xae, 0x37, 0xab, 0x36, 0xeb, 0x8a, 0x9c, 0x61, 0x52, 0x2e, 0xf4, 0x94, 0x92,
-	0xe9, 0xb8, 0x25, 0x78, 0xe7, 0x04, 0x6f, 0x22, 0x38, 0xd4, 0xaf, 0x38, 0x84, 0x7e, 0x5c, 0xbc,
-	0x69, 0x16, 0xca, 0xcd, 0xb0, 0x96, 0x3e, 0x79, 0xae, 0xa5, 0x74, 0x1d, 0x14, 0xee, 0xb2, 0x8a,
-	0x85, 0x48, 0x0b, 0xfb, 0x04, 0xe9, 0x8f, 0xd3, 0x00, 0xc6, 0x20, 0xcb, 0xa6, 0x2e, 0xfe, 0xe2,
-	0xa4, 0xcf, 0xc1, 0x49, 0x08, 0xf0, 0x86, 0x56, 0x83, 0xb0, 0x27, 0x72, 0x26, 0x14, 0xbc, 0xbc,
-	0x19, 0x86, 0xbe, 0xeb, 0x68, 0x3f, 0x0e, 0xa7, 0xc5, 0x75, 0x47, 0x83, 0x49, 0x5b, 0x31, 0x2a,
-	0xdd, 0x02, 0x6c, 0xc5, 0x7a, 0x2d, 0x8c, 0xb2, 0x04, 0x94, 0xdb, 0x1e, 0x88, 0x2d, 0xf2, 0x5a,
-	0x02, 0x53, 0x15, 0xe2, 0xec, 0x50, 0x7b, 0x0f, 0xc1, 0x79, 0x90, 0x09, 0x3f, 0x82, 0x28, 0x10,
-	0xd6, 0x10, 0x2b, 0x78, 0x22, 0x81, 0xaf, 0x92, 0xad, 0x23, 0xf2, 0xd8, 0x20, 0xeb, 0x6f, 0x0b,
-	0x21, 0xf2, 0xb7, 0x1b, 0x4f, 0x46, 0xf3, 0xfe, 0x4c, 0xa2, 0xdd, 0x44, 0xd4, 0x04, 0xc1, 0x37,
-	0x51, 0xd2, 0x71, 0x25, 0x6f, 0x24, 0x00, 0x2a, 0xc4, 0xf9, 0xcf, 0x27, 0x7d, 0x6b, 0x79, 0x26,
-	0x81, 0x5c, 0xdb, 0x1f, 0xb1, 0x9a, 0xbf, 0x44, 0x35, 0xf3, 0xbc, 0x9a, 0xae, 0xf8, 0xd1, 0xea,
-	0xf9, 0x3a, 0x8e, 0x4e, 0x56, 0x94, 0x67, 0x93, 0x2a, 0x92, 0x8f, 0x6b, 0x7a, 0xc4, 0x4a, 0xda,
-	0xb6, 0x83, 0x43, 0x44, 0xe8, 0x9d, 0x25, 0xfd, 0x0d, 0xe6, 0x6e, 0x0c, 0x56, 0x03, 0xf9, 0xd8,
-	0xe3, 0x55, 0x65, 0xcb, 0xea, 0x75, 0x47, 0x53, 0x7a, 0x4c, 0x1f, 0x07, 0xe9, 0xd6, 0x6c, 0x22,
-	0x99, 0x4d, 0xb6, 0x77, 0x23, 0x23, 0x71, 0x76, 0x9c, 0xd1, 0x6f, 0x20, 0x57, 0x21, 0xce, 0x7a,
-	0xe3, 0xd0, 0xf6, 0xeb, 0xe8, 0x8f, 0xd0, 0x6b, 0x70, 0x09, 0x64, 0x03, 0x74, 0xd0, 0x46, 0x84,
-	0xc6, 0x99, 0x7d, 0xda, 0x10, 0x64, 0x0b, 0xe0, 0xbb, 0xae, 0xb0, 0x88, 0xb1, 0xf4, 0x21, 0x0d,
-	0xc6, 0x2b, 0xc4, 0x81, 0x4f, 0x24, 0xf0, 0x6d, 0xef, 0x9b, 0xef, 0x17, 0xa3, 0xf7, 0x0d, 0x6d,
-	0xdc, 0xf5, 0x01, 0x54, 0x7e, 0x1f, 0x35, 0x22, 0xca, 0x06, 0x1e, 0x80, 0x5c, 0xf7, 0xe7, 0x72,
-	0x79, 0x20, 0x59, 0x8c, 0x55, 0x4a, 0xc3, 0x63, 0xe3, 0x23, 0x77, 0xc0, 0x04, 0x1f, 0xbf, 0x42,
-	0x9f, 0x60, 0x86, 0x50, 0x8a, 0x83, 0x10, 0x31, 0xe9, 0x7d, 0x30, 0x19, 0x4d, 0x82, 0xde, 0x27,
-	0x48, 0x60, 0x94, 0xe5, 0xc1, 0x98, 0x24, 0x75, 0xe4, 0xc8, 0x7e, 0xd4, 0x02, 0xd3, 0x97, 0xba,
-	0xcb, 0x5d, 0xb0, 0x09, 0x66, 0x6e, 0x58, 0xeb, 0xa7, 0x3e, 0xb1, 0x49, 0xa0, 0x62, 0x0e, 0x09,
-	0x8c, 0x4e, 0x2a, 0x6f, 0x9d, 0x5d, 0xaa, 0xd2, 0xf9, 0xa5, 0x2a, 0xbd, 0xbf, 0x54, 0xa5, 0xd3,
-	0x2b, 0x35, 0x75, 0x7e, 0xa5, 0xa6, 0xde, 0x5e, 0xa9, 0xa9, 0x07, 0x2b, 0x89, 0x49, 0xee, 0xf1,
-	0xf7, 0xf1, 0x28, 0x7e, 0x62, 0x43, 0x5d, 0xcb, 0xb0, 0x5b, 0xe0, 0xd7, 0x8f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x08, 0x76, 0x43, 0x79, 0x6b, 0x0a, 0x00, 0x00,
+func (m *MsgSyncReceiptStaking) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSyncReceiptStaking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSyncReceiptStaking.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSyncReceiptStaking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSyncReceiptStaking.Merge(m, src)
+}
+func (m *MsgSyncReceiptStaking) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSyncReceiptStaking) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSyncReceiptStaking.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSyncReceiptStaking proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateFixedAmountPlan defines a method for creating a new fixed amount
-	// farming plan
-	CreateFixedAmountPlan(ctx context.Context, in *MsgCreateFixedAmountPlan, opts ...grpc.CallOption) (*MsgCreateFixedAmountPlanResponse, error)
-	// CreateRatioPlan defines a method for creating a new ratio farming plan
-	CreateRatioPlan(ctx context.Context, in *MsgCreateRatioPlan, opts ...grpc.CallOption) (*MsgCreateRatioPlanResponse, error)
-	// Stake defines a method for staking coins into the farming plan
-	Stake(ctx context.Context, in *MsgStake, opts ...grpc.CallOption) (*MsgStakeResponse, error)
-	// Unstake defines a method for unstaking coins from the farming plan
-	Unstake(ctx context.Context, in *MsgUnstake, opts ...grpc.CallOption) (*MsgUnstakeResponse, error)
-	// Harvest defines a method for claiming farming rewards
-	Harvest(ctx context.Context, in *MsgHarvest, opts ...grpc.CallOption) (*MsgHarvestResponse, error)
-	// AdvanceEpoch defines a method for advancing epoch by one, just for testing purpose
-	// and shouldn't be used in real world
-	AdvanceEpoch(ctx context.Context, in *MsgAdvanceEpoch, opts ...grpc.CallOption) (*MsgAdvanceEpochResponse, error)
+// MsgSyncReceiptStakingResponse defines the Msg/MsgSyncReceiptStakingResponse response type.
+type MsgSyncReceiptStakingResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSyncReceiptStakingResponse) Reset()         { *m = MsgSyncReceiptStakingResponse{} }
+func (m *MsgSyncReceiptStakingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSyncReceiptStakingResponse) ProtoMessage()    {}
+func (*MsgSyncReceiptStakingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{21}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSyncReceiptStakingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) CreateFixedAmountPlan(ctx context.Context, in *MsgCreateFixedAmountPlan, opts ...grpc.CallOption) (*MsgCreateFixedAmountPlanResponse, error) {
-	out := new(MsgCreateFixedAmountPlanResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/CreateFixedAmountPlan", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSyncReceiptStakingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSyncReceiptStakingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSyncReceiptStakingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSyncReceiptStakingResponse.Merge(m, src)
+}
+func (m *MsgSyncReceiptStakingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSyncReceiptStakingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSyncReceiptStakingResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateRatioPlan(ctx context.Context, in *MsgCreateRatioPlan, opts ...grpc.CallOption) (*MsgCreateRatioPlanResponse, error) {
-	out := new(MsgCreateRatioPlanResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/CreateRatioPlan", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSyncReceiptStakingResponse proto.InternalMessageInfo
+
+// MsgTerminatePrivatePlan defines a SDK message for terminating a private plan
+// before its end time.
+type MsgTerminatePrivatePlan struct {
+	// creator defines the bech32-encoded address of the creator of the private plan,
+	// it must be the same as the plan's termination address
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// plan_id specifies index of the farming plan to terminate
+	PlanId uint64 `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
 }
 
-func (c *msgClient) Stake(ctx context.Context, in *MsgStake, opts ...grpc.CallOption) (*MsgStakeResponse, error) {
-	out := new(MsgStakeResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/Stake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTerminatePrivatePlan) Reset()         { *m = MsgTerminatePrivatePlan{} }
+func (m *MsgTerminatePrivatePlan) String() string { return proto.CompactTextString(m) }
+func (*MsgTerminatePrivatePlan) ProtoMessage()    {}
+func (*MsgTerminatePrivatePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{22}
+}
+func (m *MsgTerminatePrivatePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTerminatePrivatePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTerminatePrivatePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgTerminatePrivatePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTerminatePrivatePlan.Merge(m, src)
+}
+func (m *MsgTerminatePrivatePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTerminatePrivatePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTerminatePrivatePlan.DiscardUnknown(m)
 }
 
-func (c *msgClient) Unstake(ctx context.Context, in *MsgUnstake, opts ...grpc.CallOption) (*MsgUnstakeResponse, error) {
-	out := new(MsgUnstakeResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/Unstake", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgTerminatePrivatePlan proto.InternalMessageInfo
+
+// MsgTerminatePrivatePlanResponse defines the Msg/MsgTerminatePrivatePlanResponse response type.
+type MsgTerminatePrivatePlanResponse struct {
 }
 
-func (c *msgClient) Harvest(ctx context.Context, in *MsgHarvest, opts ...grpc.CallOption) (*MsgHarvestResponse, error) {
-	out := new(MsgHarvestResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/Harvest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTerminatePrivatePlanResponse) Reset()         { *m = MsgTerminatePrivatePlanResponse{} }
+func (m *MsgTerminatePrivatePlanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTerminatePrivatePlanResponse) ProtoMessage()    {}
+func (*MsgTerminatePrivatePlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{23}
+}
+func (m *MsgTerminatePrivatePlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTerminatePrivatePlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTerminatePrivatePlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgTerminatePrivatePlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTerminatePrivatePlanResponse.Merge(m, src)
+}
+func (m *MsgTerminatePrivatePlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTerminatePrivatePlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTerminatePrivatePlanResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) AdvanceEpoch(ctx context.Context, in *MsgAdvanceEpoch, opts ...grpc.CallOption) (*MsgAdvanceEpochResponse, error) {
-	out := new(MsgAdvanceEpochResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/AdvanceEpoch", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgTerminatePrivatePlanResponse proto.InternalMessageInfo
+
+// MsgUpdatePrivatePlan defines a SDK message for updating a private plan
+// that is not terminated yet.
+type MsgUpdatePrivatePlan struct {
+	// creator defines the bech32-encoded address of the creator of the private plan,
+	// it must be the same as the plan's termination address
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// plan_id specifies index of the farming plan to update
+	PlanId uint64 `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// name specifies the plan name for diplay, the name is not changed if it is empty
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// staking_coin_weights specifies coin weights for the plan
+	StakingCoinWeights github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=staking_coin_weights,json=stakingCoinWeights,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"staking_coin_weights" yaml:"staking_coin_weights"`
+	// end_time specifies the end time of the plan, the end time is not changed if it is nil
+	EndTime *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// epoch_amount specifies the distributing amount for each epoch
+	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
+	// epoch_ratio specifies the distributing amount by ratio
+	EpochRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=epoch_ratio,json=epochRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_ratio" yaml:"epoch_ratio"`
+	// decay_rate specifies the factor that the distributing amount is multiplied by
+	// every decay_epochs; the plan becomes a decaying plan when it is provided along with epoch_amount
+	DecayRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=decay_rate,json=decayRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_rate" yaml:"decay_rate"`
+	// decay_epochs specifies the number of epochs between decays
+	DecayEpochs uint32 `protobuf:"varint,9,opt,name=decay_epochs,json=decayEpochs,proto3" json:"decay_epochs,omitempty" yaml:"decay_epochs"`
+	// phases specifies the emission phases replacing those of a schedule plan; the plan becomes
+	// a schedule plan when they are provided, and they must be provided to update a schedule plan
+	Phases []SchedulePhase `protobuf:"bytes,10,rep,name=phases,proto3" json:"phases"`
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateFixedAmountPlan defines a method for creating a new fixed amount
-	// farming plan
-	CreateFixedAmountPlan(context.Context, *MsgCreateFixedAmountPlan) (*MsgCreateFixedAmountPlanResponse, error)
-	// CreateRatioPlan defines a method for creating a new ratio farming plan
-	CreateRatioPlan(context.Context, *MsgCreateRatioPlan) (*MsgCreateRatioPlanResponse, error)
-	// Stake defines a method for staking coins into the farming plan
-	Stake(context.Context, *MsgStake) (*MsgStakeResponse, error)
-	// Unstake defines a method for unstaking coins from the farming plan
-	Unstake(context.Context, *MsgUnstake) (*MsgUnstakeResponse, error)
-	// Harvest defines a method for claiming farming rewards
-	Harvest(context.Context, *MsgHarvest) (*MsgHarvestResponse, error)
-	// AdvanceEpoch defines a method for advancing epoch by one, just for testing purpose
-	// and shouldn't be used in real world
-	AdvanceEpoch(context.Context, *MsgAdvanceEpoch) (*MsgAdvanceEpochResponse, error)
+func (m *MsgUpdatePrivatePlan) Reset()         { *m = MsgUpdatePrivatePlan{} }
+func (m *MsgUpdatePrivatePlan) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePrivatePlan) ProtoMessage()    {}
+func (*MsgUpdatePrivatePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{24}
+}
+func (m *MsgUpdatePrivatePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePrivatePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePrivatePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePrivatePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePrivatePlan.Merge(m, src)
+}
+func (m *MsgUpdatePrivatePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePrivatePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePrivatePlan.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgUpdatePrivatePlan proto.InternalMessageInfo
+
+// MsgUpdatePrivatePlanResponse defines the Msg/MsgUpdatePrivatePlanResponse response type.
+type MsgUpdatePrivatePlanResponse struct {
 }
 
-func (*UnimplementedMsgServer) CreateFixedAmountPlan(ctx context.Context, req *MsgCreateFixedAmountPlan) (*MsgCreateFixedAmountPlanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateFixedAmountPlan not implemented")
+func (m *MsgUpdatePrivatePlanResponse) Reset()         { *m = MsgUpdatePrivatePlanResponse{} }
+func (m *MsgUpdatePrivatePlanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePrivatePlanResponse) ProtoMessage()    {}
+func (*MsgUpdatePrivatePlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{25}
 }
-func (*UnimplementedMsgServer) CreateRatioPlan(ctx context.Context, req *MsgCreateRatioPlan) (*MsgCreateRatioPlanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRatioPlan not implemented")
+func (m *MsgUpdatePrivatePlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Stake(ctx context.Context, req *MsgStake) (*MsgStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Stake not implemented")
+func (m *MsgUpdatePrivatePlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePrivatePlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Unstake(ctx context.Context, req *MsgUnstake) (*MsgUnstakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unstake not implemented")
+func (m *MsgUpdatePrivatePlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePrivatePlanResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) Harvest(ctx context.Context, req *MsgHarvest) (*MsgHarvestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Harvest not implemented")
+func (m *MsgUpdatePrivatePlanResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) AdvanceEpoch(ctx context.Context, req *MsgAdvanceEpoch) (*MsgAdvanceEpochResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AdvanceEpoch not implemented")
+func (m *MsgUpdatePrivatePlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePrivatePlanResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUpdatePrivatePlanResponse proto.InternalMessageInfo
+
+// MsgClaimVestedRewards defines a SDK message for claiming unlocked vesting rewards.
+type MsgClaimVestedRewards struct {
+	// farmer defines the bech32-encoded address of the farmer
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
 }
 
-func _Msg_CreateFixedAmountPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateFixedAmountPlan)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateFixedAmountPlan(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Msg/CreateFixedAmountPlan",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateFixedAmountPlan(ctx, req.(*MsgCreateFixedAmountPlan))
+func (m *MsgClaimVestedRewards) Reset()         { *m = MsgClaimVestedRewards{} }
+func (m *MsgClaimVestedRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVestedRewards) ProtoMessage()    {}
+func (*MsgClaimVestedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{26}
+}
+func (m *MsgClaimVestedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimVestedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVestedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgClaimVestedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVestedRewards.Merge(m, src)
+}
+func (m *MsgClaimVestedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimVestedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVestedRewards.DiscardUnknown(m)
 }
 
-func _Msg_CreateRatioPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateRatioPlan)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateRatioPlan(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Msg/CreateRatioPlan",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateRatioPlan(ctx, req.(*MsgCreateRatioPlan))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgClaimVestedRewards proto.InternalMessageInfo
+
+// MsgClaimVestedRewardsResponse defines the Msg/MsgClaimVestedRewardsResponse response type.
+type MsgClaimVestedRewardsResponse struct {
 }
 
-func _Msg_Stake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Stake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Msg/Stake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Stake(ctx, req.(*MsgStake))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgClaimVestedRewardsResponse) Reset()         { *m = MsgClaimVestedRewardsResponse{} }
+func (m *MsgClaimVestedRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVestedRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimVestedRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{27}
 }
-
-func _Msg_Unstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnstake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Unstake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Msg/Unstake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Unstake(ctx, req.(*MsgUnstake))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgClaimVestedRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_Harvest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgHarvest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Harvest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Msg/Harvest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Harvest(ctx, req.(*MsgHarvest))
+func (m *MsgClaimVestedRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVestedRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_AdvanceEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAdvanceEpoch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AdvanceEpoch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Msg/AdvanceEpoch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AdvanceEpoch(ctx, req.(*MsgAdvanceEpoch))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgClaimVestedRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVestedRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimVestedRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimVestedRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVestedRewardsResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.farming.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateFixedAmountPlan",
-			Handler:    _Msg_CreateFixedAmountPlan_Handler,
-		},
-		{
-			MethodName: "CreateRatioPlan",
-			Handler:    _Msg_CreateRatioPlan_Handler,
-		},
-		{
-			MethodName: "Stake",
-			Handler:    _Msg_Stake_Handler,
-		},
-		{
-			MethodName: "Unstake",
-			Handler:    _Msg_Unstake_Handler,
-		},
-		{
-			MethodName: "Harvest",
-			Handler:    _Msg_Harvest_Handler,
-		},
-		{
-			MethodName: "AdvanceEpoch",
-			Handler:    _Msg_AdvanceEpoch_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tendermint/farming/v1beta1/tx.proto",
+var xxx_messageInfo_MsgClaimVestedRewardsResponse proto.InternalMessageInfo
+
+// MsgAddPlanFarmers defines a SDK message for adding farmers to the allowlist
+// of a private plan. The plan becomes restricted once farmers are added.
+type MsgAddPlanFarmers struct {
+	// creator defines the bech32-encoded address of the creator of the private plan,
+	// it must be the same as the plan's termination address
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// plan_id specifies index of the farming plan
+	PlanId uint64 `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// farmers specifies the bech32-encoded addresses of the farmers to add
+	Farmers []string `protobuf:"bytes,3,rep,name=farmers,proto3" json:"farmers,omitempty"`
 }
 
-func (m *MsgCreateFixedAmountPlan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAddPlanFarmers) Reset()         { *m = MsgAddPlanFarmers{} }
+func (m *MsgAddPlanFarmers) String() string { return proto.CompactTextString(m) }
+func (*MsgAddPlanFarmers) ProtoMessage()    {}
+func (*MsgAddPlanFarmers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{28}
+}
+func (m *MsgAddPlanFarmers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddPlanFarmers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddPlanFarmers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAddPlanFarmers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddPlanFarmers.Merge(m, src)
+}
+func (m *MsgAddPlanFarmers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddPlanFarmers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddPlanFarmers.DiscardUnknown(m)
 }
 
-func (m *MsgCreateFixedAmountPlan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAddPlanFarmers proto.InternalMessageInfo
+
+// MsgAddPlanFarmersResponse defines the Msg/MsgAddPlanFarmersResponse response type.
+type MsgAddPlanFarmersResponse struct {
 }
 
-func (m *MsgCreateFixedAmountPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EpochAmount) > 0 {
-		for iNdEx := len(m.EpochAmount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EpochAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x2a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintTx(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x22
-	if len(m.StakingCoinWeights) > 0 {
-		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StakingCoinWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgAddPlanFarmersResponse) Reset()         { *m = MsgAddPlanFarmersResponse{} }
+func (m *MsgAddPlanFarmersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddPlanFarmersResponse) ProtoMessage()    {}
+func (*MsgAddPlanFarmersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{29}
+}
+func (m *MsgAddPlanFarmersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddPlanFarmersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddPlanFarmersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateFixedAmountPlanResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgAddPlanFarmersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddPlanFarmersResponse.Merge(m, src)
 }
-
-func (m *MsgCreateFixedAmountPlanResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAddPlanFarmersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddPlanFarmersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddPlanFarmersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateFixedAmountPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgAddPlanFarmersResponse proto.InternalMessageInfo
+
+// MsgRemovePlanFarmers defines a SDK message for removing farmers from the allowlist
+// of a private plan.
+type MsgRemovePlanFarmers struct {
+	// creator defines the bech32-encoded address of the creator of the private plan,
+	// it must be the same as the plan's termination address
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// plan_id specifies index of the farming plan
+	PlanId uint64 `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// farmers specifies the bech32-encoded addresses of the farmers to remove
+	Farmers []string `protobuf:"bytes,3,rep,name=farmers,proto3" json:"farmers,omitempty"`
 }
 
-func (m *MsgCreateRatioPlan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemovePlanFarmers) Reset()         { *m = MsgRemovePlanFarmers{} }
+func (m *MsgRemovePlanFarmers) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePlanFarmers) ProtoMessage()    {}
+func (*MsgRemovePlanFarmers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{30}
+}
+func (m *MsgRemovePlanFarmers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePlanFarmers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePlanFarmers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemovePlanFarmers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePlanFarmers.Merge(m, src)
+}
+func (m *MsgRemovePlanFarmers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemovePlanFarmers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePlanFarmers.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRatioPlan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemovePlanFarmers proto.InternalMessageInfo
+
+// MsgRemovePlanFarmersResponse defines the Msg/MsgRemovePlanFarmersResponse response type.
+type MsgRemovePlanFarmersResponse struct {
 }
 
-func (m *MsgCreateRatioPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.EpochRatio.Size()
-		i -= size
-		if _, err := m.EpochRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x2a
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintTx(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x22
-	if len(m.StakingCoinWeights) > 0 {
-		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StakingCoinWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgRemovePlanFarmersResponse) Reset()         { *m = MsgRemovePlanFarmersResponse{} }
+func (m *MsgRemovePlanFarmersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePlanFarmersResponse) ProtoMessage()    {}
+func (*MsgRemovePlanFarmersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{31}
+}
+func (m *MsgRemovePlanFarmersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePlanFarmersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePlanFarmersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateRatioPlanResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRemovePlanFarmersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePlanFarmersResponse.Merge(m, src)
 }
-
-func (m *MsgCreateRatioPlanResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemovePlanFarmersResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgCreateRatioPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemovePlanFarmersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePlanFarmersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgRemovePlanFarmersResponse proto.InternalMessageInfo
 
-func (m *MsgStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetRewardWithdrawAddress defines a SDK message for changing the address
+// that the rewards of a farmer are sent to.
+type MsgSetRewardWithdrawAddress struct {
+	// farmer defines the bech32-encoded address of the farmer
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	// withdraw_address defines the bech32-encoded address that the rewards are sent to
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty" yaml:"withdraw_address"`
 }
 
-func (m *MsgStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingCoins) > 0 {
-		for iNdEx := len(m.StakingCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StakingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgSetRewardWithdrawAddress) Reset()         { *m = MsgSetRewardWithdrawAddress{} }
+func (m *MsgSetRewardWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardWithdrawAddress) ProtoMessage()    {}
+func (*MsgSetRewardWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{32}
+}
+func (m *MsgSetRewardWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Farmer) > 0 {
-		i -= len(m.Farmer)
-		copy(dAtA[i:], m.Farmer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetRewardWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardWithdrawAddress.Merge(m, src)
 }
-
-func (m *MsgStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetRewardWithdrawAddress) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetRewardWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardWithdrawAddress.DiscardUnknown(m)
 }
 
-func (m *MsgUnstake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgSetRewardWithdrawAddress proto.InternalMessageInfo
 
-func (m *MsgUnstake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetRewardWithdrawAddressResponse defines the Msg/MsgSetRewardWithdrawAddressResponse response type.
+type MsgSetRewardWithdrawAddressResponse struct {
 }
 
-func (m *MsgUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UnstakingCoins) > 0 {
-		for iNdEx := len(m.UnstakingCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnstakingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgSetRewardWithdrawAddressResponse) Reset()         { *m = MsgSetRewardWithdrawAddressResponse{} }
+func (m *MsgSetRewardWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardWithdrawAddressResponse) ProtoMessage()    {}
+func (*MsgSetRewardWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{33}
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Farmer) > 0 {
-		i -= len(m.Farmer)
-		copy(dAtA[i:], m.Farmer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUnstakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardWithdrawAddressResponse.Merge(m, src)
 }
-
-func (m *MsgUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardWithdrawAddressResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetRewardWithdrawAddressResponse proto.InternalMessageInfo
+
+// MsgSetAutoCompound defines a SDK message for turning on or off auto-compounding
+// of the rewards of a farmer.
+type MsgSetAutoCompound struct {
+	// farmer defines the bech32-encoded address of the farmer
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	// enabled specifies whether the rewards of the farmer are auto-compounded
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (m *MsgHarvest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{34}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
 }
 
-func (m *MsgHarvest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+// MsgSetAutoCompoundResponse defines the Msg/MsgSetAutoCompoundResponse response type.
+type MsgSetAutoCompoundResponse struct {
 }
 
-func (m *MsgHarvest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingCoinDenoms) > 0 {
-		for iNdEx := len(m.StakingCoinDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.StakingCoinDenoms[iNdEx])
-			copy(dAtA[i:], m.StakingCoinDenoms[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.StakingCoinDenoms[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{35}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Farmer) > 0 {
-		i -= len(m.Farmer)
-		copy(dAtA[i:], m.Farmer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
+// MsgAdvanceEpoch defines a message to advance epoch by one.
+type MsgAdvanceEpoch struct {
+	// requester defines the bech32-encoded address of the requester
+	Requester string `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+}
+
+func (m *MsgAdvanceEpoch) Reset()         { *m = MsgAdvanceEpoch{} }
+func (m *MsgAdvanceEpoch) String() string { return proto.CompactTextString(m) }
+func (*MsgAdvanceEpoch) ProtoMessage()    {}
+func (*MsgAdvanceEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{36}
+}
+func (m *MsgAdvanceEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAdvanceEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAdvanceEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAdvanceEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAdvanceEpoch.Merge(m, src)
+}
+func (m *MsgAdvanceEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAdvanceEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAdvanceEpoch.DiscardUnknown(m)
 }
 
-func (m *MsgHarvestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAdvanceEpoch proto.InternalMessageInfo
+
+// MsgAdvanceEpochResponse defines the Msg/AdvanceEpoch response type.
+type MsgAdvanceEpochResponse struct {
+}
+
+func (m *MsgAdvanceEpochResponse) Reset()         { *m = MsgAdvanceEpochResponse{} }
+func (m *MsgAdvanceEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAdvanceEpochResponse) ProtoMessage()    {}
+func (*MsgAdvanceEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{37}
+}
+func (m *MsgAdvanceEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAdvanceEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAdvanceEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAdvanceEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAdvanceEpochResponse.Merge(m, src)
+}
+func (m *MsgAdvanceEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAdvanceEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAdvanceEpochResponse.DiscardUnknown(m)
 }
 
-func (m *MsgHarvestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAdvanceEpochResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateFixedAmountPlan)(nil), "cosmos.farming.v1beta1.MsgCreateFixedAmountPlan")
+	proto.RegisterType((*MsgCreateFixedAmountPlanResponse)(nil), "cosmos.farming.v1beta1.MsgCreateFixedAmountPlanResponse")
+	proto.RegisterType((*MsgCreateRatioPlan)(nil), "cosmos.farming.v1beta1.MsgCreateRatioPlan")
+	proto.RegisterType((*MsgCreateRatioPlanResponse)(nil), "cosmos.farming.v1beta1.MsgCreateRatioPlanResponse")
+	proto.RegisterType((*MsgCreateDecayingPlan)(nil), "cosmos.farming.v1beta1.MsgCreateDecayingPlan")
+	proto.RegisterType((*MsgCreateDecayingPlanResponse)(nil), "cosmos.farming.v1beta1.MsgCreateDecayingPlanResponse")
+	proto.RegisterType((*MsgCreateSchedulePlan)(nil), "cosmos.farming.v1beta1.MsgCreateSchedulePlan")
+	proto.RegisterType((*MsgCreateSchedulePlanResponse)(nil), "cosmos.farming.v1beta1.MsgCreateSchedulePlanResponse")
+	proto.RegisterType((*MsgStake)(nil), "cosmos.farming.v1beta1.MsgStake")
+	proto.RegisterType((*MsgStakeResponse)(nil), "cosmos.farming.v1beta1.MsgStakeResponse")
+	proto.RegisterType((*MsgUnstake)(nil), "cosmos.farming.v1beta1.MsgUnstake")
+	proto.RegisterType((*MsgUnstakeResponse)(nil), "cosmos.farming.v1beta1.MsgUnstakeResponse")
+	proto.RegisterType((*MsgCancelQueuedStaking)(nil), "cosmos.farming.v1beta1.MsgCancelQueuedStaking")
+	proto.RegisterType((*MsgCancelQueuedStakingResponse)(nil), "cosmos.farming.v1beta1.MsgCancelQueuedStakingResponse")
+	proto.RegisterType((*MsgEmergencyUnstake)(nil), "cosmos.farming.v1beta1.MsgEmergencyUnstake")
+	proto.RegisterType((*MsgEmergencyUnstakeResponse)(nil), "cosmos.farming.v1beta1.MsgEmergencyUnstakeResponse")
+	proto.RegisterType((*MsgHarvest)(nil), "cosmos.farming.v1beta1.MsgHarvest")
+	proto.RegisterType((*MsgHarvestResponse)(nil), "cosmos.farming.v1beta1.MsgHarvestResponse")
+	proto.RegisterType((*MsgTransferStaking)(nil), "cosmos.farming.v1beta1.MsgTransferStaking")
+	proto.RegisterType((*MsgTransferStakingResponse)(nil), "cosmos.farming.v1beta1.MsgTransferStakingResponse")
+	proto.RegisterType((*MsgSyncReceiptStaking)(nil), "cosmos.farming.v1beta1.MsgSyncReceiptStaking")
+	proto.RegisterType((*MsgSyncReceiptStakingResponse)(nil), "cosmos.farming.v1beta1.MsgSyncReceiptStakingResponse")
+	proto.RegisterType((*MsgTerminatePrivatePlan)(nil), "cosmos.farming.v1beta1.MsgTerminatePrivatePlan")
+	proto.RegisterType((*MsgTerminatePrivatePlanResponse)(nil), "cosmos.farming.v1beta1.MsgTerminatePrivatePlanResponse")
+	proto.RegisterType((*MsgUpdatePrivatePlan)(nil), "cosmos.farming.v1beta1.MsgUpdatePrivatePlan")
+	proto.RegisterType((*MsgUpdatePrivatePlanResponse)(nil), "cosmos.farming.v1beta1.MsgUpdatePrivatePlanResponse")
+	proto.RegisterType((*MsgClaimVestedRewards)(nil), "cosmos.farming.v1beta1.MsgClaimVestedRewards")
+	proto.RegisterType((*MsgClaimVestedRewardsResponse)(nil), "cosmos.farming.v1beta1.MsgClaimVestedRewardsResponse")
+	proto.RegisterType((*MsgAddPlanFarmers)(nil), "cosmos.farming.v1beta1.MsgAddPlanFarmers")
+	proto.RegisterType((*MsgAddPlanFarmersResponse)(nil), "cosmos.farming.v1beta1.MsgAddPlanFarmersResponse")
+	proto.RegisterType((*MsgRemovePlanFarmers)(nil), "cosmos.farming.v1beta1.MsgRemovePlanFarmers")
+	proto.RegisterType((*MsgRemovePlanFarmersResponse)(nil), "cosmos.farming.v1beta1.MsgRemovePlanFarmersResponse")
+	proto.RegisterType((*MsgSetRewardWithdrawAddress)(nil), "cosmos.farming.v1beta1.MsgSetRewardWithdrawAddress")
+	proto.RegisterType((*MsgSetRewardWithdrawAddressResponse)(nil), "cosmos.farming.v1beta1.MsgSetRewardWithdrawAddressResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "cosmos.farming.v1beta1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "cosmos.farming.v1beta1.MsgSetAutoCompoundResponse")
+	proto.RegisterType((*MsgAdvanceEpoch)(nil), "cosmos.farming.v1beta1.MsgAdvanceEpoch")
+	proto.RegisterType((*MsgAdvanceEpochResponse)(nil), "cosmos.farming.v1beta1.MsgAdvanceEpochResponse")
 }
 
-func (m *MsgHarvestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("tendermint/farming/v1beta1/tx.proto", fileDescriptor_a33d9a3ff13f514a)
 }
 
-func (m *MsgAdvanceEpoch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_a33d9a3ff13f514a = []byte{
+	// 1707 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xdd, 0x6f, 0xdb, 0x54,
+	0x14, 0xaf, 0xd7, 0x36, 0x69, 0x4e, 0xbb, 0x75, 0x75, 0xbb, 0x36, 0x75, 0xbb, 0x24, 0x64, 0x1a,
+	0x94, 0x8e, 0x25, 0xac, 0x5b, 0x01, 0x8d, 0xa7, 0xa6, 0xdd, 0x07, 0x88, 0xa2, 0xe1, 0x0e, 0x06,
+	0xbc, 0x04, 0x37, 0xbe, 0x75, 0xad, 0x26, 0x76, 0xe6, 0xeb, 0xb4, 0xeb, 0xa4, 0x49, 0xa0, 0x69,
+	0xd2, 0x1e, 0x10, 0xda, 0x23, 0x8f, 0x13, 0x4f, 0x88, 0x27, 0xc4, 0x2b, 0x12, 0x6f, 0x48, 0x7b,
+	0x1c, 0x6f, 0x88, 0x87, 0x0e, 0x75, 0xff, 0x41, 0xff, 0x02, 0x74, 0x3f, 0x7c, 0xe3, 0xc4, 0x8e,
+	0x13, 0x6f, 0xda, 0x54, 0xa4, 0x3e, 0x35, 0xd7, 0xf7, 0x77, 0xbe, 0x7e, 0x3e, 0xe7, 0xdc, 0x73,
+	0xad, 0xc2, 0x19, 0x17, 0x59, 0x3a, 0x72, 0x6a, 0xa6, 0xe5, 0x16, 0x37, 0x34, 0xf2, 0xd7, 0x28,
+	0x6e, 0x5f, 0x58, 0x47, 0xae, 0x76, 0xa1, 0xe8, 0xde, 0x29, 0xd4, 0x1d, 0xdb, 0xb5, 0xe5, 0xc9,
+	0x8a, 0x8d, 0x6b, 0x36, 0x2e, 0x70, 0x40, 0x81, 0x03, 0x94, 0x09, 0xc3, 0x36, 0x6c, 0x0a, 0x29,
+	0x92, 0x5f, 0x0c, 0xad, 0x4c, 0x33, 0x74, 0x99, 0x6d, 0x70, 0x51, 0xb6, 0x95, 0x61, 0xab, 0xe2,
+	0xba, 0x86, 0x91, 0x30, 0x53, 0xb1, 0x4d, 0x8b, 0xef, 0x67, 0x0d, 0xdb, 0x36, 0xaa, 0xa8, 0x48,
+	0x57, 0xeb, 0x8d, 0x8d, 0xa2, 0x6b, 0xd6, 0x10, 0x76, 0xb5, 0x5a, 0xdd, 0x53, 0xd0, 0x0e, 0xd0,
+	0x1b, 0x8e, 0xe6, 0x9a, 0xb6, 0xa7, 0x60, 0x2e, 0x22, 0x1c, 0xcf, 0x7b, 0x8a, 0xcc, 0xff, 0x3c,
+	0x08, 0xe9, 0x55, 0x6c, 0x2c, 0x3b, 0x48, 0x73, 0xd1, 0x55, 0xf3, 0x0e, 0xd2, 0x97, 0x6a, 0x76,
+	0xc3, 0x72, 0x6f, 0x54, 0x35, 0x4b, 0x96, 0x61, 0xc0, 0xd2, 0x6a, 0x28, 0x2d, 0xe5, 0xa4, 0xb9,
+	0x94, 0x4a, 0x7f, 0xcb, 0x69, 0x48, 0x56, 0x08, 0xd8, 0x76, 0xd2, 0xc7, 0xe8, 0x63, 0x6f, 0x29,
+	0xff, 0x24, 0xc1, 0x04, 0x76, 0xb5, 0x2d, 0xd3, 0x32, 0xca, 0x24, 0x98, 0xf2, 0x0e, 0x32, 0x8d,
+	0x4d, 0x17, 0xa7, 0xfb, 0x73, 0xfd, 0x73, 0xc3, 0x0b, 0xb3, 0x05, 0xce, 0x01, 0x89, 0xda, 0xe3,
+	0xae, 0xb0, 0x82, 0x2a, 0xcb, 0xb6, 0x69, 0x95, 0xd4, 0x27, 0x7b, 0xd9, 0xbe, 0x83, 0xbd, 0xec,
+	0xcc, 0xae, 0x56, 0xab, 0x5e, 0xce, 0x87, 0xe9, 0xc9, 0xff, 0xf2, 0x2c, 0x7b, 0xce, 0x30, 0xdd,
+	0xcd, 0xc6, 0x7a, 0xa1, 0x62, 0xd7, 0x38, 0xa5, 0xfc, 0xcf, 0x79, 0xac, 0x6f, 0x15, 0xdd, 0xdd,
+	0x3a, 0xc2, 0x9e, 0x4a, 0xac, 0xca, 0x5c, 0x0b, 0x59, 0xdd, 0x62, 0x3a, 0xe4, 0x2f, 0x01, 0xb0,
+	0xab, 0x39, 0x6e, 0x99, 0x50, 0x9a, 0x1e, 0xc8, 0x49, 0x73, 0xc3, 0x0b, 0x4a, 0x81, 0xd1, 0x59,
+	0xf0, 0xe8, 0x2c, 0xdc, 0xf4, 0xf8, 0x2e, 0x9d, 0xe6, 0x7e, 0x8d, 0x09, 0xbf, 0xb8, 0x6c, 0xfe,
+	0xd1, 0xb3, 0xac, 0xa4, 0xa6, 0xe8, 0x03, 0x02, 0x97, 0x55, 0x18, 0x42, 0x96, 0xce, 0xf4, 0x0e,
+	0x76, 0xd5, 0x3b, 0xc3, 0xf5, 0x8e, 0x32, 0xbd, 0x9e, 0x24, 0xd3, 0x9a, 0x44, 0x96, 0x4e, 0x75,
+	0x3e, 0x90, 0x60, 0x04, 0xd5, 0xed, 0xca, 0x66, 0x59, 0xa3, 0x6f, 0x25, 0x9d, 0xa0, 0x54, 0x4e,
+	0x87, 0x52, 0x49, 0x79, 0xbc, 0xc6, 0xf5, 0x8e, 0x73, 0xbd, 0x3e, 0x61, 0xc2, 0xdf, 0x5c, 0x0f,
+	0xfc, 0x31, 0xf2, 0x86, 0xa9, 0x28, 0x4b, 0x06, 0xf9, 0x1e, 0x4c, 0x39, 0x68, 0x47, 0x73, 0xf4,
+	0xf2, 0x36, 0xc2, 0x2e, 0x79, 0x31, 0x5e, 0xc2, 0xa5, 0x93, 0x34, 0xd4, 0xe9, 0x40, 0xa8, 0x2b,
+	0x1c, 0x50, 0x9a, 0xe7, 0x1e, 0x65, 0x98, 0x47, 0x1d, 0xf4, 0xe4, 0x7f, 0x24, 0x81, 0x9f, 0x62,
+	0xbb, 0x5f, 0xb0, 0x4d, 0x4f, 0xc5, 0xe5, 0x81, 0x87, 0x8f, 0xb3, 0x7d, 0xf9, 0x3c, 0xe4, 0x3a,
+	0x65, 0xaa, 0x8a, 0x70, 0xdd, 0xb6, 0x30, 0xca, 0x7f, 0x37, 0x08, 0xb2, 0x00, 0xa9, 0x44, 0xfa,
+	0x28, 0x91, 0x0f, 0x43, 0x22, 0x23, 0x60, 0xf9, 0x54, 0xa6, 0x6f, 0x34, 0x9d, 0x20, 0x84, 0x97,
+	0x56, 0x88, 0xe8, 0x3f, 0x7b, 0xd9, 0x37, 0x7b, 0xe3, 0xe2, 0x60, 0x2f, 0x2b, 0xfb, 0xb3, 0x9a,
+	0xaa, 0xca, 0xab, 0x40, 0x57, 0xf4, 0x5d, 0x1f, 0x8e, 0x3c, 0x9d, 0x05, 0x25, 0x98, 0x82, 0x22,
+	0x43, 0xff, 0x4c, 0xc0, 0x29, 0xb1, 0xbd, 0x82, 0x2a, 0xda, 0xae, 0x69, 0x19, 0x47, 0x49, 0x7a,
+	0xd4, 0x6d, 0x9b, 0xdd, 0x76, 0x1d, 0x40, 0x27, 0x89, 0x41, 0x32, 0x1c, 0xd1, 0xc4, 0x4d, 0x95,
+	0x96, 0x63, 0xd7, 0x0a, 0xe7, 0xb0, 0xa9, 0x29, 0xaf, 0xa6, 0xe8, 0x42, 0xd5, 0x5c, 0x24, 0x5f,
+	0x86, 0x11, 0xb6, 0x43, 0x0d, 0xe3, 0xf4, 0x50, 0x4e, 0x9a, 0x3b, 0x5e, 0x9a, 0x6a, 0xc6, 0xe2,
+	0xdf, 0xcd, 0xab, 0xc3, 0x74, 0x79, 0x85, 0xae, 0xa2, 0xaa, 0x2c, 0xf5, 0xda, 0xaa, 0x2c, 0x0b,
+	0xa7, 0x43, 0xcb, 0x48, 0x14, 0xda, 0xfe, 0x80, 0xaf, 0xd0, 0xd6, 0x2a, 0x9b, 0x48, 0x6f, 0x54,
+	0xd1, 0x51, 0xa1, 0x1d, 0x86, 0x42, 0x5b, 0x86, 0x44, 0x7d, 0x53, 0xc3, 0x08, 0xf3, 0x0a, 0x3b,
+	0x5b, 0x08, 0x9f, 0xac, 0x0b, 0xe2, 0xb5, 0x11, 0x74, 0x69, 0x80, 0x28, 0x57, 0xb9, 0xe8, 0xe1,
+	0xe8, 0xf5, 0xfe, 0x2c, 0xf4, 0xe7, 0x98, 0xc8, 0xc2, 0xbf, 0x8e, 0xc1, 0xd0, 0x2a, 0x36, 0xd6,
+	0x5c, 0x6d, 0x0b, 0xc9, 0x93, 0x90, 0x20, 0x11, 0x22, 0x87, 0xa7, 0x1e, 0x5f, 0xc9, 0x0f, 0x25,
+	0x38, 0xee, 0x4f, 0x0d, 0x9c, 0x3e, 0xd6, 0xad, 0xf3, 0x5c, 0xe7, 0x11, 0x4c, 0x04, 0x13, 0x0b,
+	0xc7, 0x6b, 0x3d, 0x23, 0xbe, 0x74, 0xc2, 0xf2, 0x37, 0x70, 0xbc, 0x6a, 0x57, 0xb6, 0x9a, 0x5c,
+	0xf6, 0x77, 0xe3, 0x32, 0xd7, 0xea, 0x49, 0x8b, 0x34, 0x63, 0x70, 0x84, 0x3c, 0xf3, 0xf0, 0xa4,
+	0xf3, 0x90, 0x7b, 0x49, 0xd9, 0x41, 0x15, 0x64, 0xd6, 0x5d, 0x9a, 0xac, 0x43, 0xfe, 0xce, 0xe3,
+	0xdf, 0xcd, 0xab, 0xc3, 0x64, 0xa9, 0xb2, 0x15, 0x27, 0x5d, 0x86, 0x93, 0x1e, 0xa5, 0x82, 0xe7,
+	0xdf, 0x25, 0x80, 0x55, 0x6c, 0x7c, 0x6e, 0xe1, 0x48, 0xa6, 0x7f, 0x90, 0x60, 0xb4, 0x61, 0xc5,
+	0xe4, 0xfa, 0x63, 0x1e, 0xe1, 0x24, 0xf3, 0xaf, 0x61, 0xbd, 0x04, 0xdb, 0x27, 0x84, 0x34, 0x5d,
+	0xf3, 0x88, 0x26, 0x40, 0x6e, 0x3a, 0x2f, 0x62, 0xfa, 0x43, 0x82, 0x49, 0x92, 0x5d, 0x9a, 0x55,
+	0x41, 0xd5, 0xcf, 0x1a, 0xa8, 0x81, 0xf4, 0x35, 0x26, 0xdb, 0x31, 0x3e, 0x72, 0x84, 0xdd, 0xa6,
+	0xc8, 0x5e, 0x83, 0x6b, 0x3b, 0xc2, 0xfc, 0xc2, 0x31, 0x8f, 0x30, 0x26, 0xea, 0x0f, 0x2b, 0x07,
+	0x99, 0x70, 0xff, 0x45, 0x88, 0xf7, 0x25, 0x18, 0x5f, 0xc5, 0xc6, 0x95, 0x1a, 0x72, 0x0c, 0x64,
+	0x55, 0x76, 0xbb, 0xbd, 0xbf, 0x4f, 0x61, 0xbc, 0xa5, 0x87, 0xea, 0xc8, 0xb2, 0x6b, 0x2c, 0xca,
+	0x54, 0x29, 0x73, 0xb0, 0x97, 0x55, 0x42, 0x1a, 0x2d, 0x03, 0xe5, 0xd5, 0x31, 0x1f, 0xf3, 0x2b,
+	0xf4, 0x19, 0xf7, 0xf3, 0x34, 0xcc, 0x84, 0x38, 0x21, 0x9c, 0xbc, 0x4b, 0x53, 0xeb, 0xba, 0xe6,
+	0x90, 0x06, 0xf1, 0x9a, 0x5d, 0x63, 0x99, 0xc1, 0x6d, 0x0b, 0x8f, 0x1e, 0x4b, 0xf4, 0xf1, 0x4d,
+	0x47, 0xb3, 0xf0, 0x06, 0x72, 0xba, 0x65, 0xc5, 0x2c, 0xa4, 0x1c, 0x54, 0x31, 0xeb, 0x26, 0xb2,
+	0x5c, 0x7e, 0xbc, 0x35, 0x1f, 0x74, 0x72, 0xbc, 0xff, 0xe5, 0x1c, 0x67, 0x53, 0x70, 0x9b, 0x87,
+	0x22, 0x80, 0x07, 0x12, 0x3d, 0x9c, 0xd7, 0x76, 0xad, 0x0a, 0xaf, 0xed, 0x6e, 0x31, 0xbc, 0x1a,
+	0x7a, 0x59, 0xff, 0x0e, 0xba, 0x21, 0x1c, 0x55, 0x61, 0x8a, 0x84, 0x41, 0xbf, 0xa5, 0x68, 0x2e,
+	0xba, 0xe1, 0x98, 0xdb, 0x9a, 0xcb, 0xc6, 0x08, 0xdf, 0xc8, 0x20, 0xb5, 0x8e, 0x0c, 0x53, 0x90,
+	0xac, 0x57, 0x35, 0xab, 0x6c, 0xea, 0x94, 0xed, 0x01, 0x35, 0x41, 0x96, 0x1f, 0xe9, 0xdc, 0xe8,
+	0x1b, 0x90, 0xed, 0xa0, 0x53, 0x98, 0xfd, 0x35, 0x01, 0x13, 0xa4, 0x23, 0xd4, 0xf5, 0x97, 0x36,
+	0x2a, 0xc6, 0x9d, 0x7e, 0xdf, 0xb8, 0xd3, 0x71, 0xa8, 0x19, 0x38, 0x44, 0x43, 0x4d, 0xfc, 0xd1,
+	0x43, 0xfa, 0xdf, 0xcc, 0xf8, 0x6d, 0x17, 0xe2, 0xe4, 0x2b, 0xba, 0x10, 0xb7, 0x5e, 0x25, 0x86,
+	0x5e, 0xcb, 0x55, 0x22, 0x15, 0xe3, 0x2a, 0xd1, 0x9c, 0x04, 0xe1, 0x85, 0x27, 0x41, 0x5e, 0x55,
+	0x19, 0x98, 0x0d, 0xab, 0x18, 0x51, 0x52, 0x8b, 0xec, 0x3a, 0x50, 0xd5, 0xcc, 0x1a, 0x99, 0xe5,
+	0x90, 0xae, 0xd2, 0xb9, 0x0e, 0x77, 0xea, 0x38, 0xad, 0x13, 0x5e, 0x40, 0x4c, 0xe8, 0xdd, 0x80,
+	0xb1, 0x55, 0x6c, 0x2c, 0xe9, 0x3a, 0xb1, 0x76, 0x95, 0x8a, 0xe2, 0x17, 0x29, 0xd3, 0x34, 0x24,
+	0x99, 0x61, 0xde, 0x7a, 0x55, 0x6f, 0xc9, 0x1d, 0x99, 0x81, 0xe9, 0x80, 0x1d, 0xe1, 0x84, 0x49,
+	0xdb, 0x85, 0x8a, 0x6a, 0xf6, 0x36, 0x7a, 0xc5, 0x7e, 0x30, 0x9e, 0x03, 0xa6, 0xfc, 0x47, 0x3a,
+	0x39, 0x4d, 0xd7, 0x90, 0xcb, 0x98, 0xba, 0x65, 0xba, 0x9b, 0xba, 0xa3, 0xed, 0x2c, 0xe9, 0xba,
+	0x83, 0x70, 0x47, 0xba, 0xe5, 0xab, 0x70, 0x72, 0x87, 0x43, 0xcb, 0x1a, 0xc3, 0xb2, 0xb3, 0xaa,
+	0x34, 0x73, 0xb0, 0x97, 0x9d, 0x62, 0xa9, 0xd4, 0x8e, 0xc8, 0xab, 0xa3, 0x3b, 0xad, 0xfa, 0xb9,
+	0x97, 0x67, 0xe1, 0x4c, 0x84, 0x13, 0xc2, 0xd9, 0x4f, 0xe8, 0x39, 0xba, 0x86, 0xdc, 0xa5, 0x86,
+	0x6b, 0x2f, 0xdb, 0xb5, 0xba, 0xdd, 0xb0, 0xf4, 0x8e, 0x2e, 0xa6, 0x21, 0x89, 0x2c, 0x6d, 0xbd,
+	0x8a, 0x18, 0x67, 0x43, 0xaa, 0xb7, 0x6c, 0x39, 0xf3, 0xda, 0xb4, 0xf9, 0x12, 0x70, 0x94, 0xbe,
+	0xc0, 0x6d, 0xcd, 0xaa, 0x20, 0x9a, 0xff, 0xec, 0x60, 0xbe, 0xdd, 0x20, 0x79, 0xe5, 0xd9, 0x6a,
+	0x3e, 0xe0, 0x4a, 0xa7, 0x61, 0xaa, 0x4d, 0xcc, 0xd3, 0xb8, 0xf0, 0xdb, 0x18, 0xf4, 0xaf, 0x62,
+	0x43, 0xbe, 0x2f, 0xc1, 0xa9, 0xf0, 0x2f, 0xf8, 0xef, 0x76, 0xaa, 0xa7, 0x4e, 0x5f, 0x52, 0x95,
+	0x0f, 0xe2, 0x4a, 0x78, 0xde, 0xc8, 0xb7, 0x61, 0xb4, 0xfd, 0xbb, 0xeb, 0x7c, 0x57, 0x65, 0x02,
+	0xab, 0x2c, 0xf4, 0x8e, 0x15, 0x26, 0xef, 0x82, 0x1c, 0xf2, 0x21, 0xed, 0x7c, 0x57, 0x4d, 0x7e,
+	0xb8, 0xb2, 0x18, 0x0b, 0x1e, 0xb4, 0xdd, 0xf2, 0x6d, 0xa1, 0xbb, 0x6d, 0x3f, 0x5c, 0x59, 0x8c,
+	0x05, 0x17, 0xb6, 0xd7, 0x60, 0x90, 0xdd, 0x28, 0x73, 0x11, 0xf2, 0x14, 0xa1, 0xcc, 0x75, 0x43,
+	0x08, 0xa5, 0x5f, 0x41, 0xd2, 0x1b, 0xbf, 0xf3, 0x11, 0x42, 0x1c, 0xa3, 0xcc, 0x77, 0xc7, 0x08,
+	0xd5, 0xf7, 0x60, 0x3c, 0xec, 0x16, 0x53, 0x88, 0x8a, 0x3e, 0x88, 0x57, 0xde, 0x8b, 0x87, 0x17,
+	0xe6, 0x5d, 0x38, 0x19, 0xb8, 0x61, 0x9c, 0x8b, 0xd0, 0xd5, 0x0e, 0x56, 0x2e, 0xc6, 0x00, 0xfb,
+	0xf9, 0xf4, 0xee, 0x0c, 0x51, 0x7c, 0x72, 0x8c, 0x32, 0xdf, 0x1d, 0xe3, 0x2f, 0xb5, 0xf6, 0xd9,
+	0x3f, 0x4a, 0xbc, 0x0d, 0xab, 0x2c, 0xf4, 0x8e, 0xf5, 0xa7, 0x7b, 0xc8, 0xb4, 0x1e, 0x95, 0xee,
+	0x41, 0xb8, 0xb2, 0x18, 0x0b, 0x2e, 0x6c, 0x7f, 0x2b, 0xc1, 0x44, 0xe8, 0x08, 0x5e, 0x8c, 0x0a,
+	0x24, 0x44, 0x40, 0x79, 0x3f, 0xa6, 0x80, 0x70, 0x61, 0x07, 0xc6, 0x82, 0xc3, 0xf8, 0x3b, 0x51,
+	0x25, 0xd0, 0x8e, 0x56, 0x2e, 0xc5, 0x41, 0xb7, 0xb4, 0x99, 0xe0, 0xcc, 0x12, 0xd9, 0x66, 0x02,
+	0x70, 0x65, 0x31, 0x16, 0x5c, 0xd8, 0xb6, 0xe0, 0x44, 0xdb, 0x5c, 0xf3, 0x76, 0x84, 0xa2, 0x56,
+	0xa8, 0x72, 0xa1, 0x67, 0xa8, 0x9f, 0xe4, 0xe0, 0x08, 0x13, 0x45, 0x72, 0x00, 0xad, 0x5c, 0x8a,
+	0x83, 0x16, 0x86, 0xbf, 0x97, 0x20, 0xdd, 0x71, 0x60, 0x89, 0x2a, 0xfe, 0x4e, 0x42, 0xca, 0x87,
+	0x2f, 0x20, 0xe4, 0x2f, 0xef, 0xf6, 0x91, 0x64, 0x3e, 0x5a, 0x9f, 0x1f, 0xab, 0x2c, 0xf4, 0x8e,
+	0x15, 0x26, 0x37, 0x61, 0xa4, 0x65, 0x32, 0x79, 0x2b, 0xf2, 0xed, 0x35, 0x81, 0x4a, 0xb1, 0x47,
+	0xa0, 0x67, 0xa9, 0x74, 0xed, 0xc9, 0x7e, 0x46, 0x7a, 0xba, 0x9f, 0x91, 0xfe, 0xdd, 0xcf, 0x48,
+	0x8f, 0x9e, 0x67, 0xfa, 0x9e, 0x3e, 0xcf, 0xf4, 0xfd, 0xfd, 0x3c, 0xd3, 0xf7, 0xf5, 0x79, 0xdf,
+	0x85, 0x24, 0xe4, 0x1f, 0x18, 0xee, 0x88, 0x5f, 0xf4, 0x6e, 0xb2, 0x9e, 0xa0, 0x97, 0xc0, 0x8b,
+	0xff, 0x0d, 0x00, 0xea, 0x3c, 0x93, 0x7b, 0xbc, 0x21, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateFixedAmountPlan defines a method for creating a new fixed amount
+	// farming plan
+	CreateFixedAmountPlan(ctx context.Context, in *MsgCreateFixedAmountPlan, opts ...grpc.CallOption) (*MsgCreateFixedAmountPlanResponse, error)
+	// CreateRatioPlan defines a method for creating a new ratio farming plan
+	CreateRatioPlan(ctx context.Context, in *MsgCreateRatioPlan, opts ...grpc.CallOption) (*MsgCreateRatioPlanResponse, error)
+	// CreateDecayingPlan defines a method for creating a new decaying farming plan
+	CreateDecayingPlan(ctx context.Context, in *MsgCreateDecayingPlan, opts ...grpc.CallOption) (*MsgCreateDecayingPlanResponse, error)
+	// CreateSchedulePlan defines a method for creating a new schedule-based farming plan
+	CreateSchedulePlan(ctx context.Context, in *MsgCreateSchedulePlan, opts ...grpc.CallOption) (*MsgCreateSchedulePlanResponse, error)
+	// Stake defines a method for staking coins into the farming plan
+	Stake(ctx context.Context, in *MsgStake, opts ...grpc.CallOption) (*MsgStakeResponse, error)
+	// Unstake defines a method for unstaking coins from the farming plan
+	Unstake(ctx context.Context, in *MsgUnstake, opts ...grpc.CallOption) (*MsgUnstakeResponse, error)
+	// CancelQueuedStaking defines a method for cancelling queued coins which are not staked yet
+	CancelQueuedStaking(ctx context.Context, in *MsgCancelQueuedStaking, opts ...grpc.CallOption) (*MsgCancelQueuedStakingResponse, error)
+	// EmergencyUnstake defines a method for withdrawing staked and queued coins while
+	// operations are paused, forfeiting the pending rewards
+	EmergencyUnstake(ctx context.Context, in *MsgEmergencyUnstake, opts ...grpc.CallOption) (*MsgEmergencyUnstakeResponse, error)
+	// Harvest defines a method for claiming farming rewards
+	Harvest(ctx context.Context, in *MsgHarvest, opts ...grpc.CallOption) (*MsgHarvestResponse, error)
+	// TransferStaking defines a method for transferring staking positions to another address
+	// without unstaking
+	TransferStaking(ctx context.Context, in *MsgTransferStaking, opts ...grpc.CallOption) (*MsgTransferStakingResponse, error)
+	// SyncReceiptStaking defines a method for syncing the receipt-backed staking of a farmer
+	// with the receipt coins the farmer holds
+	SyncReceiptStaking(ctx context.Context, in *MsgSyncReceiptStaking, opts ...grpc.CallOption) (*MsgSyncReceiptStakingResponse, error)
+	// TerminatePrivatePlan defines a method for terminating a private plan
+	// before its end time by the plan creator
+	TerminatePrivatePlan(ctx context.Context, in *MsgTerminatePrivatePlan, opts ...grpc.CallOption) (*MsgTerminatePrivatePlanResponse, error)
+	// UpdatePrivatePlan defines a method for updating a private plan by the plan creator
+	UpdatePrivatePlan(ctx context.Context, in *MsgUpdatePrivatePlan, opts ...grpc.CallOption) (*MsgUpdatePrivatePlanResponse, error)
+	// ClaimVestedRewards defines a method for claiming unlocked vesting rewards
+	ClaimVestedRewards(ctx context.Context, in *MsgClaimVestedRewards, opts ...grpc.CallOption) (*MsgClaimVestedRewardsResponse, error)
+	// AddPlanFarmers defines a method for adding farmers to the allowlist of a private plan
+	// by the plan creator
+	AddPlanFarmers(ctx context.Context, in *MsgAddPlanFarmers, opts ...grpc.CallOption) (*MsgAddPlanFarmersResponse, error)
+	// RemovePlanFarmers defines a method for removing farmers from the allowlist of a private plan
+	// by the plan creator
+	RemovePlanFarmers(ctx context.Context, in *MsgRemovePlanFarmers, opts ...grpc.CallOption) (*MsgRemovePlanFarmersResponse, error)
+	// SetRewardWithdrawAddress defines a method for changing the address
+	// that the rewards of a farmer are sent to
+	SetRewardWithdrawAddress(ctx context.Context, in *MsgSetRewardWithdrawAddress, opts ...grpc.CallOption) (*MsgSetRewardWithdrawAddressResponse, error)
+	// SetAutoCompound defines a method for turning on or off auto-compounding of the rewards of a farmer
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
+	// AdvanceEpoch defines a method for advancing epoch by one, just for testing purpose
+	// and shouldn't be used in real world
+	AdvanceEpoch(ctx context.Context, in *MsgAdvanceEpoch, opts ...grpc.CallOption) (*MsgAdvanceEpochResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateFixedAmountPlan(ctx context.Context, in *MsgCreateFixedAmountPlan, opts ...grpc.CallOption) (*MsgCreateFixedAmountPlanResponse, error) {
+	out := new(MsgCreateFixedAmountPlanResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/CreateFixedAmountPlan", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAdvanceEpoch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateRatioPlan(ctx context.Context, in *MsgCreateRatioPlan, opts ...grpc.CallOption) (*MsgCreateRatioPlanResponse, error) {
+	out := new(MsgCreateRatioPlanResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/CreateRatioPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAdvanceEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Requester) > 0 {
-		i -= len(m.Requester)
-		copy(dAtA[i:], m.Requester)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CreateDecayingPlan(ctx context.Context, in *MsgCreateDecayingPlan, opts ...grpc.CallOption) (*MsgCreateDecayingPlanResponse, error) {
+	out := new(MsgCreateDecayingPlanResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/CreateDecayingPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAdvanceEpochResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateSchedulePlan(ctx context.Context, in *MsgCreateSchedulePlan, opts ...grpc.CallOption) (*MsgCreateSchedulePlanResponse, error) {
+	out := new(MsgCreateSchedulePlanResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/CreateSchedulePlan", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAdvanceEpochResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Stake(ctx context.Context, in *MsgStake, opts ...grpc.CallOption) (*MsgStakeResponse, error) {
+	out := new(MsgStakeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/Stake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAdvanceEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) Unstake(ctx context.Context, in *MsgUnstake, opts ...grpc.CallOption) (*MsgUnstakeResponse, error) {
+	out := new(MsgUnstakeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/Unstake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) CancelQueuedStaking(ctx context.Context, in *MsgCancelQueuedStaking, opts ...grpc.CallOption) (*MsgCancelQueuedStakingResponse, error) {
+	out := new(MsgCancelQueuedStakingResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/CancelQueuedStaking", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgCreateFixedAmountPlan) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) EmergencyUnstake(ctx context.Context, in *MsgEmergencyUnstake, opts ...grpc.CallOption) (*MsgEmergencyUnstakeResponse, error) {
+	out := new(MsgEmergencyUnstakeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/EmergencyUnstake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.StakingCoinWeights) > 0 {
-		for _, e := range m.StakingCoinWeights {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.EpochAmount) > 0 {
-		for _, e := range m.EpochAmount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) Harvest(ctx context.Context, in *MsgHarvest, opts ...grpc.CallOption) (*MsgHarvestResponse, error) {
+	out := new(MsgHarvestResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/Harvest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateFixedAmountPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) TransferStaking(ctx context.Context, in *MsgTransferStaking, opts ...grpc.CallOption) (*MsgTransferStakingResponse, error) {
+	out := new(MsgTransferStakingResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/TransferStaking", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateRatioPlan) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SyncReceiptStaking(ctx context.Context, in *MsgSyncReceiptStaking, opts ...grpc.CallOption) (*MsgSyncReceiptStakingResponse, error) {
+	out := new(MsgSyncReceiptStakingResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/SyncReceiptStaking", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) TerminatePrivatePlan(ctx context.Context, in *MsgTerminatePrivatePlan, opts ...grpc.CallOption) (*MsgTerminatePrivatePlanResponse, error) {
+	out := new(MsgTerminatePrivatePlanResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/TerminatePrivatePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdatePrivatePlan(ctx context.Context, in *MsgUpdatePrivatePlan, opts ...grpc.CallOption) (*MsgUpdatePrivatePlanResponse, error) {
+	out := new(MsgUpdatePrivatePlanResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/UpdatePrivatePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.StakingCoinWeights) > 0 {
-		for _, e := range m.StakingCoinWeights {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) ClaimVestedRewards(ctx context.Context, in *MsgClaimVestedRewards, opts ...grpc.CallOption) (*MsgClaimVestedRewardsResponse, error) {
+	out := new(MsgClaimVestedRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/ClaimVestedRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = m.EpochRatio.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateRatioPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AddPlanFarmers(ctx context.Context, in *MsgAddPlanFarmers, opts ...grpc.CallOption) (*MsgAddPlanFarmersResponse, error) {
+	out := new(MsgAddPlanFarmersResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/AddPlanFarmers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgStake) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RemovePlanFarmers(ctx context.Context, in *MsgRemovePlanFarmers, opts ...grpc.CallOption) (*MsgRemovePlanFarmersResponse, error) {
+	out := new(MsgRemovePlanFarmersResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/RemovePlanFarmers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetRewardWithdrawAddress(ctx context.Context, in *MsgSetRewardWithdrawAddress, opts ...grpc.CallOption) (*MsgSetRewardWithdrawAddressResponse, error) {
+	out := new(MsgSetRewardWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/SetRewardWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.StakingCoins) > 0 {
-		for _, e := range m.StakingCoins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AdvanceEpoch(ctx context.Context, in *MsgAdvanceEpoch, opts ...grpc.CallOption) (*MsgAdvanceEpochResponse, error) {
+	out := new(MsgAdvanceEpochResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/AdvanceEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUnstake) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateFixedAmountPlan defines a method for creating a new fixed amount
+	// farming plan
+	CreateFixedAmountPlan(context.Context, *MsgCreateFixedAmountPlan) (*MsgCreateFixedAmountPlanResponse, error)
+	// CreateRatioPlan defines a method for creating a new ratio farming plan
+	CreateRatioPlan(context.Context, *MsgCreateRatioPlan) (*MsgCreateRatioPlanResponse, error)
+	// CreateDecayingPlan defines a method for creating a new decaying farming plan
+	CreateDecayingPlan(context.Context, *MsgCreateDecayingPlan) (*MsgCreateDecayingPlanResponse, error)
+	// CreateSchedulePlan defines a method for creating a new schedule-based farming plan
+	CreateSchedulePlan(context.Context, *MsgCreateSchedulePlan) (*MsgCreateSchedulePlanResponse, error)
+	// Stake defines a method for staking coins into the farming plan
+	Stake(context.Context, *MsgStake) (*MsgStakeResponse, error)
+	// Unstake defines a method for unstaking coins from the farming plan
+	Unstake(context.Context, *MsgUnstake) (*MsgUnstakeResponse, error)
+	// CancelQueuedStaking defines a method for cancelling queued coins which are not staked yet
+	CancelQueuedStaking(context.Context, *MsgCancelQueuedStaking) (*MsgCancelQueuedStakingResponse, error)
+	// EmergencyUnstake defines a method for withdrawing staked and queued coins while
+	// operations are paused, forfeiting the pending rewards
+	EmergencyUnstake(context.Context, *MsgEmergencyUnstake) (*MsgEmergencyUnstakeResponse, error)
+	// Harvest defines a method for claiming farming rewards
+	Harvest(context.Context, *MsgHarvest) (*MsgHarvestResponse, error)
+	// TransferStaking defines a method for transferring staking positions to another address
+	// without unstaking
+	TransferStaking(context.Context, *MsgTransferStaking) (*MsgTransferStakingResponse, error)
+	// SyncReceiptStaking defines a method for syncing the receipt-backed staking of a farmer
+	// with the receipt coins the farmer holds
+	SyncReceiptStaking(context.Context, *MsgSyncReceiptStaking) (*MsgSyncReceiptStakingResponse, error)
+	// TerminatePrivatePlan defines a method for terminating a private plan
+	// before its end time by the plan creator
+	TerminatePrivatePlan(context.Context, *MsgTerminatePrivatePlan) (*MsgTerminatePrivatePlanResponse, error)
+	// UpdatePrivatePlan defines a method for updating a private plan by the plan creator
+	UpdatePrivatePlan(context.Context, *MsgUpdatePrivatePlan) (*MsgUpdatePrivatePlanResponse, error)
+	// ClaimVestedRewards defines a method for claiming unlocked vesting rewards
+	ClaimVestedRewards(context.Context, *MsgClaimVestedRewards) (*MsgClaimVestedRewardsResponse, error)
+	// AddPlanFarmers defines a method for adding farmers to the allowlist of a private plan
+	// by the plan creator
+	AddPlanFarmers(context.Context, *MsgAddPlanFarmers) (*MsgAddPlanFarmersResponse, error)
+	// RemovePlanFarmers defines a method for removing farmers from the allowlist of a private plan
+	// by the plan creator
+	RemovePlanFarmers(context.Context, *MsgRemovePlanFarmers) (*MsgRemovePlanFarmersResponse, error)
+	// SetRewardWithdrawAddress defines a method for changing the address
+	// that the rewards of a farmer are sent to
+	SetRewardWithdrawAddress(context.Context, *MsgSetRewardWithdrawAddress) (*MsgSetRewardWithdrawAddressResponse, error)
+	// SetAutoCompound defines a method for turning on or off auto-compounding of the rewards of a farmer
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
+	// AdvanceEpoch defines a method for advancing epoch by one, just for testing purpose
+	// and shouldn't be used in real world
+	AdvanceEpoch(context.Context, *MsgAdvanceEpoch) (*MsgAdvanceEpochResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateFixedAmountPlan(ctx context.Context, req *MsgCreateFixedAmountPlan) (*MsgCreateFixedAmountPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFixedAmountPlan not implemented")
+}
+func (*UnimplementedMsgServer) CreateRatioPlan(ctx context.Context, req *MsgCreateRatioPlan) (*MsgCreateRatioPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRatioPlan not implemented")
+}
+func (*UnimplementedMsgServer) CreateDecayingPlan(ctx context.Context, req *MsgCreateDecayingPlan) (*MsgCreateDecayingPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDecayingPlan not implemented")
+}
+func (*UnimplementedMsgServer) CreateSchedulePlan(ctx context.Context, req *MsgCreateSchedulePlan) (*MsgCreateSchedulePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedulePlan not implemented")
+}
+func (*UnimplementedMsgServer) Stake(ctx context.Context, req *MsgStake) (*MsgStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stake not implemented")
+}
+func (*UnimplementedMsgServer) Unstake(ctx context.Context, req *MsgUnstake) (*MsgUnstakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unstake not implemented")
+}
+func (*UnimplementedMsgServer) CancelQueuedStaking(ctx context.Context, req *MsgCancelQueuedStaking) (*MsgCancelQueuedStakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQueuedStaking not implemented")
+}
+func (*UnimplementedMsgServer) EmergencyUnstake(ctx context.Context, req *MsgEmergencyUnstake) (*MsgEmergencyUnstakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmergencyUnstake not implemented")
+}
+func (*UnimplementedMsgServer) Harvest(ctx context.Context, req *MsgHarvest) (*MsgHarvestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Harvest not implemented")
+}
+func (*UnimplementedMsgServer) TransferStaking(ctx context.Context, req *MsgTransferStaking) (*MsgTransferStakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferStaking not implemented")
+}
+func (*UnimplementedMsgServer) SyncReceiptStaking(ctx context.Context, req *MsgSyncReceiptStaking) (*MsgSyncReceiptStakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncReceiptStaking not implemented")
+}
+func (*UnimplementedMsgServer) TerminatePrivatePlan(ctx context.Context, req *MsgTerminatePrivatePlan) (*MsgTerminatePrivatePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminatePrivatePlan not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePrivatePlan(ctx context.Context, req *MsgUpdatePrivatePlan) (*MsgUpdatePrivatePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrivatePlan not implemented")
+}
+func (*UnimplementedMsgServer) ClaimVestedRewards(ctx context.Context, req *MsgClaimVestedRewards) (*MsgClaimVestedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimVestedRewards not implemented")
+}
+func (*UnimplementedMsgServer) AddPlanFarmers(ctx context.Context, req *MsgAddPlanFarmers) (*MsgAddPlanFarmersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPlanFarmers not implemented")
+}
+func (*UnimplementedMsgServer) RemovePlanFarmers(ctx context.Context, req *MsgRemovePlanFarmers) (*MsgRemovePlanFarmersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePlanFarmers not implemented")
+}
+func (*UnimplementedMsgServer) SetRewardWithdrawAddress(ctx context.Context, req *MsgSetRewardWithdrawAddress) (*MsgSetRewardWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardWithdrawAddress not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
+func (*UnimplementedMsgServer) AdvanceEpoch(ctx context.Context, req *MsgAdvanceEpoch) (*MsgAdvanceEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdvanceEpoch not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateFixedAmountPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFixedAmountPlan)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFixedAmountPlan(ctx, in)
 	}
-	if len(m.UnstakingCoins) > 0 {
-		for _, e := range m.UnstakingCoins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/CreateFixedAmountPlan",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFixedAmountPlan(ctx, req.(*MsgCreateFixedAmountPlan))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUnstakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateRatioPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRatioPlan)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRatioPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/CreateRatioPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRatioPlan(ctx, req.(*MsgCreateRatioPlan))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgHarvest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateDecayingPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDecayingPlan)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDecayingPlan(ctx, in)
 	}
-	if len(m.StakingCoinDenoms) > 0 {
-		for _, s := range m.StakingCoinDenoms {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/CreateDecayingPlan",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDecayingPlan(ctx, req.(*MsgCreateDecayingPlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSchedulePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSchedulePlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSchedulePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/CreateSchedulePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSchedulePlan(ctx, req.(*MsgCreateSchedulePlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Stake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Stake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/Stake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Stake(ctx, req.(*MsgStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnstake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unstake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/Unstake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unstake(ctx, req.(*MsgUnstake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelQueuedStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelQueuedStaking)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelQueuedStaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/CancelQueuedStaking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelQueuedStaking(ctx, req.(*MsgCancelQueuedStaking))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EmergencyUnstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEmergencyUnstake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EmergencyUnstake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/EmergencyUnstake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EmergencyUnstake(ctx, req.(*MsgEmergencyUnstake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Harvest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgHarvest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Harvest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/Harvest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Harvest(ctx, req.(*MsgHarvest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferStaking)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferStaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/TransferStaking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferStaking(ctx, req.(*MsgTransferStaking))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SyncReceiptStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSyncReceiptStaking)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SyncReceiptStaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/SyncReceiptStaking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SyncReceiptStaking(ctx, req.(*MsgSyncReceiptStaking))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TerminatePrivatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTerminatePrivatePlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TerminatePrivatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/TerminatePrivatePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TerminatePrivatePlan(ctx, req.(*MsgTerminatePrivatePlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePrivatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePrivatePlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePrivatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/UpdatePrivatePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePrivatePlan(ctx, req.(*MsgUpdatePrivatePlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimVestedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimVestedRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimVestedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/ClaimVestedRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimVestedRewards(ctx, req.(*MsgClaimVestedRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddPlanFarmers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddPlanFarmers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddPlanFarmers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/AddPlanFarmers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddPlanFarmers(ctx, req.(*MsgAddPlanFarmers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemovePlanFarmers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemovePlanFarmers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemovePlanFarmers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/RemovePlanFarmers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemovePlanFarmers(ctx, req.(*MsgRemovePlanFarmers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRewardWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/SetRewardWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardWithdrawAddress(ctx, req.(*MsgSetRewardWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AdvanceEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAdvanceEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AdvanceEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/AdvanceEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AdvanceEpoch(ctx, req.(*MsgAdvanceEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.farming.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFixedAmountPlan",
+			Handler:    _Msg_CreateFixedAmountPlan_Handler,
+		},
+		{
+			MethodName: "CreateRatioPlan",
+			Handler:    _Msg_CreateRatioPlan_Handler,
+		},
+		{
+			MethodName: "CreateDecayingPlan",
+			Handler:    _Msg_CreateDecayingPlan_Handler,
+		},
+		{
+			MethodName: "CreateSchedulePlan",
+			Handler:    _Msg_CreateSchedulePlan_Handler,
+		},
+		{
+			MethodName: "Stake",
+			Handler:    _Msg_Stake_Handler,
+		},
+		{
+			MethodName: "Unstake",
+			Handler:    _Msg_Unstake_Handler,
+		},
+		{
+			MethodName: "CancelQueuedStaking",
+			Handler:    _Msg_CancelQueuedStaking_Handler,
+		},
+		{
+			MethodName: "EmergencyUnstake",
+			Handler:    _Msg_EmergencyUnstake_Handler,
+		},
+		{
+			MethodName: "Harvest",
+			Handler:    _Msg_Harvest_Handler,
+		},
+		{
+			MethodName: "TransferStaking",
+			Handler:    _Msg_TransferStaking_Handler,
+		},
+		{
+			MethodName: "SyncReceiptStaking",
+			Handler:    _Msg_SyncReceiptStaking_Handler,
+		},
+		{
+			MethodName: "TerminatePrivatePlan",
+			Handler:    _Msg_TerminatePrivatePlan_Handler,
+		},
+		{
+			MethodName: "UpdatePrivatePlan",
+			Handler:    _Msg_UpdatePrivatePlan_Handler,
+		},
+		{
+			MethodName: "ClaimVestedRewards",
+			Handler:    _Msg_ClaimVestedRewards_Handler,
+		},
+		{
+			MethodName: "AddPlanFarmers",
+			Handler:    _Msg_AddPlanFarmers_Handler,
+		},
+		{
+			MethodName: "RemovePlanFarmers",
+			Handler:    _Msg_RemovePlanFarmers_Handler,
+		},
+		{
+			MethodName: "SetRewardWithdrawAddress",
+			Handler:    _Msg_SetRewardWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
+		{
+			MethodName: "AdvanceEpoch",
+			Handler:    _Msg_AdvanceEpoch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/farming/v1beta1/tx.proto",
+}
+
+func (m *MsgCreateFixedAmountPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFixedAmountPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFixedAmountPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardVestingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardVestingDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.EpochAmount) > 0 {
+		for iNdEx := len(m.EpochAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.StakingCoinWeights) > 0 {
+		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingCoinWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateFixedAmountPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFixedAmountPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFixedAmountPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRatioPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRatioPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRatioPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardVestingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardVestingDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.EpochRatio.Size()
+		i -= size
+		if _, err := m.EpochRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if len(m.StakingCoinWeights) > 0 {
+		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingCoinWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRatioPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRatioPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRatioPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDecayingPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDecayingPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDecayingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardVestingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardVestingDuration):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x4a
+	if m.DecayEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DecayEpochs))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.DecayRate.Size()
+		i -= size
+		if _, err := m.DecayRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.EpochAmount) > 0 {
+		for iNdEx := len(m.EpochAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTx(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x2a
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if len(m.StakingCoinWeights) > 0 {
+		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingCoinWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDecayingPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDecayingPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDecayingPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSchedulePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSchedulePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSchedulePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardVestingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardVestingDuration):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTx(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Phases) > 0 {
+		for iNdEx := len(m.Phases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Phases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTx(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x2a
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTx(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	if len(m.StakingCoinWeights) > 0 {
+		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingCoinWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSchedulePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSchedulePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSchedulePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MintReceipt {
+		i--
+		if m.MintReceipt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintTx(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.StakingCoins) > 0 {
+		for iNdEx := len(m.StakingCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnstakingCoins) > 0 {
+		for iNdEx := len(m.UnstakingCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnstakingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelQueuedStaking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelQueuedStaking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelQueuedStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueuedCoins) > 0 {
+		for iNdEx := len(m.QueuedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelQueuedStakingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelQueuedStakingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelQueuedStakingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEmergencyUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEmergencyUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEmergencyUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenoms) > 0 {
+		for iNdEx := len(m.StakingCoinDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StakingCoinDenoms[iNdEx])
+			copy(dAtA[i:], m.StakingCoinDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.StakingCoinDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEmergencyUnstakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEmergencyUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEmergencyUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgHarvest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHarvest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHarvest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenoms) > 0 {
+		for iNdEx := len(m.StakingCoinDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StakingCoinDenoms[iNdEx])
+			copy(dAtA[i:], m.StakingCoinDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.StakingCoinDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgHarvestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHarvestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHarvestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferStaking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferStaking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenoms) > 0 {
+		for iNdEx := len(m.StakingCoinDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StakingCoinDenoms[iNdEx])
+			copy(dAtA[i:], m.StakingCoinDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.StakingCoinDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferStakingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferStakingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferStakingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSyncReceiptStaking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSyncReceiptStaking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSyncReceiptStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenoms) > 0 {
+		for iNdEx := len(m.StakingCoinDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StakingCoinDenoms[iNdEx])
+			copy(dAtA[i:], m.StakingCoinDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.StakingCoinDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSyncReceiptStakingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSyncReceiptStakingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSyncReceiptStakingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTerminatePrivatePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTerminatePrivatePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTerminatePrivatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTerminatePrivatePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTerminatePrivatePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTerminatePrivatePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePrivatePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePrivatePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePrivatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Phases) > 0 {
+		for iNdEx := len(m.Phases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Phases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.DecayEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DecayEpochs))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.DecayRate.Size()
+		i -= size
+		if _, err := m.DecayRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.EpochRatio.Size()
+		i -= size
+		if _, err := m.EpochRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.EpochAmount) > 0 {
+		for iNdEx := len(m.EpochAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.EndTime != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintTx(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingCoinWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePrivatePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePrivatePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePrivatePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVestedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVestedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVestedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVestedRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVestedRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVestedRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddPlanFarmers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddPlanFarmers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddPlanFarmers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Farmers) > 0 {
+		for iNdEx := len(m.Farmers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Farmers[iNdEx])
+			copy(dAtA[i:], m.Farmers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Farmers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddPlanFarmersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddPlanFarmersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddPlanFarmersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePlanFarmers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePlanFarmers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePlanFarmers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Farmers) > 0 {
+		for iNdEx := len(m.Farmers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Farmers[iNdEx])
+			copy(dAtA[i:], m.Farmers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Farmers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePlanFarmersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePlanFarmersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePlanFarmersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdvanceEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdvanceEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdvanceEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdvanceEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdvanceEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdvanceEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateFixedAmountPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for _, e := range m.StakingCoinWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.EpochAmount) > 0 {
+		for _, e := range m.EpochAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardVestingDuration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateFixedAmountPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateRatioPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for _, e := range m.StakingCoinWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.EpochRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardVestingDuration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateRatioPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateDecayingPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for _, e := range m.StakingCoinWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.EpochAmount) > 0 {
+		for _, e := range m.EpochAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.DecayRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DecayEpochs != 0 {
+		n += 1 + sovTx(uint64(m.DecayEpochs))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardVestingDuration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateDecayingPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateSchedulePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for _, e := range m.StakingCoinWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Phases) > 0 {
+		for _, e := range m.Phases {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardVestingDuration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateSchedulePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoins) > 0 {
+		for _, e := range m.StakingCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovTx(uint64(l))
+	if m.MintReceipt {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.UnstakingCoins) > 0 {
+		for _, e := range m.UnstakingCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelQueuedStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.QueuedCoins) > 0 {
+		for _, e := range m.QueuedCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelQueuedStakingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEmergencyUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinDenoms) > 0 {
+		for _, s := range m.StakingCoinDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgEmergencyUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgHarvest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinDenoms) > 0 {
+		for _, s := range m.StakingCoinDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
 }
 
 func (m *MsgHarvestResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinDenoms) > 0 {
+		for _, s := range m.StakingCoinDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTransferStakingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSyncReceiptStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinDenoms) > 0 {
+		for _, s := range m.StakingCoinDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSyncReceiptStakingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTerminatePrivatePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PlanId != 0 {
+		n += 1 + sovTx(uint64(m.PlanId))
+	}
+	return n
+}
+
+func (m *MsgTerminatePrivatePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePrivatePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PlanId != 0 {
+		n += 1 + sovTx(uint64(m.PlanId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for _, e := range m.StakingCoinWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.EpochAmount) > 0 {
+		for _, e := range m.EpochAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.EpochRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.DecayRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DecayEpochs != 0 {
+		n += 1 + sovTx(uint64(m.DecayEpochs))
+	}
+	if len(m.Phases) > 0 {
+		for _, e := range m.Phases {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdatePrivatePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimVestedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimVestedRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddPlanFarmers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PlanId != 0 {
+		n += 1 + sovTx(uint64(m.PlanId))
+	}
+	if len(m.Farmers) > 0 {
+		for _, s := range m.Farmers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddPlanFarmersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemovePlanFarmers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PlanId != 0 {
+		n += 1 + sovTx(uint64(m.PlanId))
+	}
+	if len(m.Farmers) > 0 {
+		for _, s := range m.Farmers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemovePlanFarmersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRewardWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAdvanceEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAdvanceEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFixedAmountPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFixedAmountPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
+			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochAmount = append(m.EpochAmount, types.Coin{})
+			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardVestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFixedAmountPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFixedAmountPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFixedAmountPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRatioPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRatioPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
+			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardVestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRatioPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRatioPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRatioPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDecayingPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDecayingPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDecayingPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
+			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochAmount = append(m.EpochAmount, types.Coin{})
+			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayEpochs", wireType)
+			}
+			m.DecayEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardVestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDecayingPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDecayingPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDecayingPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSchedulePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSchedulePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSchedulePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
+			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phases = append(m.Phases, SchedulePhase{})
+			if err := m.Phases[len(m.Phases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardVestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSchedulePlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSchedulePlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSchedulePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoins = append(m.StakingCoins, types.Coin{})
+			if err := m.StakingCoins[len(m.StakingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintReceipt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MintReceipt = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakingCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnstakingCoins = append(m.UnstakingCoins, types.Coin{})
+			if err := m.UnstakingCoins[len(m.UnstakingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelQueuedStaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelQueuedStaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelQueuedStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedCoins = append(m.QueuedCoins, types.Coin{})
+			if err := m.QueuedCoins[len(m.QueuedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelQueuedStakingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelQueuedStakingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelQueuedStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEmergencyUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEmergencyUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEmergencyUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenoms = append(m.StakingCoinDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEmergencyUnstakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEmergencyUnstakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEmergencyUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHarvest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHarvest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenoms = append(m.StakingCoinDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgHarvestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHarvestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHarvestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferStaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferStaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenoms = append(m.StakingCoinDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferStakingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferStakingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSyncReceiptStaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSyncReceiptStaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSyncReceiptStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenoms = append(m.StakingCoinDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *MsgSyncReceiptStakingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSyncReceiptStakingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSyncReceiptStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgAdvanceEpoch) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = len(m.Requester)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgTerminatePrivatePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTerminatePrivatePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTerminatePrivatePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTerminatePrivatePlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTerminatePrivatePlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTerminatePrivatePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgAdvanceEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePrivatePlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1555,15 +7483,15 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFixedAmountPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePrivatePlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFixedAmountPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePrivatePlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1591,11 +7519,30 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1623,9 +7570,9 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
 			}
@@ -1659,9 +7606,9 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1688,13 +7635,137 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochAmount = append(m.EpochAmount, types.Coin{})
+			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayEpochs", wireType)
+			}
+			m.DecayEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1718,18 +7789,119 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phases = append(m.Phases, SchedulePhase{})
+			if err := m.Phases[len(m.Phases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePrivatePlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePrivatePlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePrivatePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimVestedRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimVestedRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimVestedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1739,25 +7911,23 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochAmount = append(m.EpochAmount, types.Coin{})
-			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1780,7 +7950,7 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateFixedAmountPlanResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimVestedRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1803,10 +7973,10 @@ func (m *MsgCreateFixedAmountPlanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFixedAmountPlanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimVestedRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFixedAmountPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimVestedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1830,7 +8000,7 @@ func (m *MsgCreateFixedAmountPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
+func (m *MsgAddPlanFarmers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1853,45 +8023,13 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRatioPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddPlanFarmers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRatioPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddPlanFarmers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
@@ -1923,78 +8061,11 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
-			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var msglen int
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2004,28 +8075,14 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2053,9 +8110,7 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Farmers = append(m.Farmers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2078,7 +8133,7 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRatioPlanResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddPlanFarmersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2101,10 +8156,10 @@ func (m *MsgCreateRatioPlanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRatioPlanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddPlanFarmersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRatioPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddPlanFarmersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2128,7 +8183,7 @@ func (m *MsgCreateRatioPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStake) Unmarshal(dAtA []byte) error {
+func (m *MsgRemovePlanFarmers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2151,15 +8206,15 @@ func (m *MsgStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemovePlanFarmers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemovePlanFarmers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2187,13 +8242,32 @@ func (m *MsgStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Farmer = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2203,25 +8277,23 @@ func (m *MsgStake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingCoins = append(m.StakingCoins, types.Coin{})
-			if err := m.StakingCoins[len(m.StakingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Farmers = append(m.Farmers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2244,7 +8316,7 @@ func (m *MsgStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemovePlanFarmersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2267,10 +8339,10 @@ func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemovePlanFarmersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemovePlanFarmersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2294,7 +8366,7 @@ func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRewardWithdrawAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2317,10 +8389,10 @@ func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRewardWithdrawAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRewardWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2357,9 +8429,9 @@ func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnstakingCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2369,25 +8441,23 @@ func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnstakingCoins = append(m.UnstakingCoins, types.Coin{})
-			if err := m.UnstakingCoins[len(m.UnstakingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2410,7 +8480,7 @@ func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRewardWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2433,10 +8503,10 @@ func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRewardWithdrawAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRewardWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2460,7 +8530,7 @@ func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2483,10 +8553,10 @@ func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgHarvest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgHarvest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2522,10 +8592,10 @@ func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
 			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenoms", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2535,24 +8605,12 @@ func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingCoinDenoms = append(m.StakingCoinDenoms, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2574,7 +8632,7 @@ func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgHarvestResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2597,10 +8655,10 @@ func (m *MsgHarvestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgHarvestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgHarvestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewRewardVesting creates a new RewardVesting object.
+func NewRewardVesting(startTime time.Time, vestingDuration time.Duration, totalRewards sdk.Coins) RewardVesting {
+	return RewardVesting{
+		StartTime:       startTime,
+		VestingDuration: vestingDuration,
+		TotalRewards:    totalRewards,
+		ClaimedRewards:  sdk.NewCoins(),
+	}
+}
+
+// EndTime returns the time when the rewards are fully unlocked.
+func (v RewardVesting) EndTime() time.Time {
+	return v.StartTime.Add(v.VestingDuration)
+}
+
+// UnlockedRewards returns the amount of rewards unlocked at given time t,
+// including the rewards already claimed.
+// The rewards unlock linearly from the start time over the vesting duration.
+func (v RewardVesting) UnlockedRewards(t time.Time) sdk.Coins {
+	if !t.Before(v.EndTime()) {
+		return v.TotalRewards
+	}
+	if !t.After(v.StartTime) {
+		return sdk.NewCoins()
+	}
+	elapsed := sdk.NewInt(int64(t.Sub(v.StartTime)))
+	duration := sdk.NewInt(int64(v.VestingDuration))
+	unlocked := sdk.NewCoins()
+	for _, coin := range v.TotalRewards {
+		unlocked = unlocked.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(elapsed).Quo(duration)))
+	}
+	return unlocked
+}
+
+// LockedRewards returns the amount of rewards still locked at given time t.
+func (v RewardVesting) LockedRewards(t time.Time) sdk.Coins {
+	return v.TotalRewards.Sub(v.UnlockedRewards(t))
+}
+
+// ClaimableRewards returns the amount of unlocked rewards that are not claimed yet at given time t.
+func (v RewardVesting) ClaimableRewards(t time.Time) sdk.Coins {
+	return v.UnlockedRewards(t).Sub(v.ClaimedRewards)
+}
+
+// IsFullyClaimed returns if all the rewards of the vesting are claimed.
+func (v RewardVesting) IsFullyClaimed() bool {
+	return v.ClaimedRewards.IsEqual(v.TotalRewards)
+}
+
+// Validate checks for errors on the RewardVesting fields
+func (v RewardVesting) Validate() error {
+	if v.VestingDuration <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidRewardVestingDuration, "vesting duration must be positive: %s", v.VestingDuration)
+	}
+	if err := v.TotalRewards.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid total rewards: %v", err)
+	}
+	if err := v.ClaimedRewards.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid claimed rewards: %v", err)
+	}
+	if !v.TotalRewards.IsAllGTE(v.ClaimedRewards) {
+		return fmt.Errorf("claimed rewards %s must not exceed total rewards %s", v.ClaimedRewards, v.TotalRewards)
+	}
+	return nil
+}
+
+// ValidateRewardVestingDuration validates the reward vesting duration of a plan.
+func ValidateRewardVestingDuration(d time.Duration) error {
+	if d < 0 {
+		return sdkerrors.Wrapf(ErrInvalidRewardVestingDuration, "reward vesting duration must not be negative: %s", d)
+	}
+	return nil
+}
+
+// AddVestingUnitRewards returns the cumulative vesting unit rewards with the given
+// unit rewards added to the entry of the vesting duration.
+// The entries are kept sorted by the vesting duration.
+func AddVestingUnitRewards(rewards []VestingUnitRewards, vestingDuration time.Duration, unitRewards sdk.DecCoins) []VestingUnitRewards {
+	result := make([]VestingUnitRewards, 0, len(rewards)+1)
+	added := false
+	for _, r := range rewards {
+		if !added && r.VestingDuration == vestingDuration {
+			r.CumulativeUnitRewards = r.CumulativeUnitRewards.Add(unitRewards...)
+			added = true
+		} else if !added && r.VestingDuration > vestingDuration {
+			result = append(result, VestingUnitRewards{VestingDuration: vestingDuration, CumulativeUnitRewards: unitRewards})
+			added = true
+		}
+		result = append(result, r)
+	}
+	if !added {
+		result = append(result, VestingUnitRewards{VestingDuration: vestingDuration, CumulativeUnitRewards: unitRewards})
+	}
+	return result
+}
+
+// VestingUnitRewardsOf returns the cumulative unit rewards of the vesting duration.
+func VestingUnitRewardsOf(rewards []VestingUnitRewards, vestingDuration time.Duration) sdk.DecCoins {
+	for _, r := range rewards {
+		if r.VestingDuration == vestingDuration {
+			return r.CumulativeUnitRewards
+		}
+	}
+	return sdk.DecCoins{}
+}
+
+// VestingRewards defines rewards of a farmer that vest over the vesting duration.
+type VestingRewards struct {
+	VestingDuration time.Duration
+	Rewards         sdk.DecCoins
+}
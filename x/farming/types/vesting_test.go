@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+func TestRewardVesting(t *testing.T) {
+	startTime := types.ParseTime("2021-08-01T00:00:00Z")
+	vesting := types.NewRewardVesting(startTime, 10*24*time.Hour, sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000), sdk.NewInt64Coin("denom2", 3)))
+	require.NoError(t, vesting.Validate())
+	require.Equal(t, types.ParseTime("2021-08-11T00:00:00Z"), vesting.EndTime())
+
+	for _, tc := range []struct {
+		t                time.Time
+		expectedUnlocked sdk.Coins
+	}{
+		{types.ParseTime("2021-07-01T00:00:00Z"), sdk.NewCoins()},
+		{startTime, sdk.NewCoins()},
+		{types.ParseTime("2021-08-02T00:00:00Z"), sdk.NewCoins(sdk.NewInt64Coin("denom1", 100))},
+		{types.ParseTime("2021-08-06T00:00:00Z"), sdk.NewCoins(sdk.NewInt64Coin("denom1", 500), sdk.NewInt64Coin("denom2", 1))},
+		{types.ParseTime("2021-08-11T00:00:00Z"), vesting.TotalRewards},
+		{types.ParseTime("2021-09-01T00:00:00Z"), vesting.TotalRewards},
+	} {
+		t.Run("", func(t *testing.T) {
+			unlocked := vesting.UnlockedRewards(tc.t)
+			require.True(t, tc.expectedUnlocked.IsEqual(unlocked), "expected %s, got %s", tc.expectedUnlocked, unlocked)
+			require.True(t, vesting.TotalRewards.IsEqual(unlocked.Add(vesting.LockedRewards(tc.t)...)))
+		})
+	}
+
+	vesting.ClaimedRewards = sdk.NewCoins(sdk.NewInt64Coin("denom1", 500))
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin("denom2", 1)).IsEqual(vesting.ClaimableRewards(types.ParseTime("2021-08-06T00:00:00Z"))))
+	require.False(t, vesting.IsFullyClaimed())
+
+	vesting.ClaimedRewards = sdk.NewCoins(sdk.NewInt64Coin("denom1", 1001))
+	require.EqualError(t, vesting.Validate(), "claimed rewards 1001denom1 must not exceed total rewards 1000denom1,3denom2")
+
+	vesting = types.NewRewardVesting(startTime, 0, sdk.NewCoins())
+	require.EqualError(t, vesting.Validate(), "vesting duration must be positive: 0s: invalid reward vesting duration")
+}
+
+func TestAddVestingUnitRewards(t *testing.T) {
+	unitRewards := sdk.NewDecCoins(sdk.NewInt64DecCoin("denom1", 1))
+
+	var rewards []types.VestingUnitRewards
+	rewards = types.AddVestingUnitRewards(rewards, 2*time.Hour, unitRewards)
+	rewards = types.AddVestingUnitRewards(rewards, time.Hour, unitRewards)
+	rewards = types.AddVestingUnitRewards(rewards, 3*time.Hour, unitRewards)
+	rewards = types.AddVestingUnitRewards(rewards, 2*time.Hour, unitRewards)
+
+	require.Len(t, rewards, 3)
+	for i, d := range []time.Duration{time.Hour, 2 * time.Hour, 3 * time.Hour} {
+		require.Equal(t, d, rewards[i].VestingDuration)
+	}
+	require.True(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("denom1", 2)).IsEqual(types.VestingUnitRewardsOf(rewards, 2*time.Hour)))
+	require.True(t, types.VestingUnitRewardsOf(rewards, 4*time.Hour).IsZero())
+}